@@ -25,6 +25,23 @@ type GetExperimentActivityRequest struct {
 	ID int32 `params:"id"`
 }
 
+// GetExperimentTagFacetsRequest is a request object for `GET /aim/experiments/:id/tag-facets/` endpoint.
+type GetExperimentTagFacetsRequest struct {
+	ID    int32 `params:"id"`
+	Limit int   `query:"limit"`
+}
+
+// GetExperimentMetricContextsRequest is a request object for `GET /aim/experiments/:id/metric-contexts/` endpoint.
+type GetExperimentMetricContextsRequest struct {
+	ID  int32  `params:"id"`
+	Key string `query:"key"`
+}
+
+// GetExperimentMetricSummaryRequest is a request object for `GET /aim/experiments/:id/metric-summary/` endpoint.
+type GetExperimentMetricSummaryRequest struct {
+	ID int32 `params:"id"`
+}
+
 // DeleteExperimentRequest is a request object for `DELETE /aim/experiments/:id` endpoint.
 type DeleteExperimentRequest struct {
 	ID int32 `params:"id"`
@@ -22,3 +22,17 @@ type AlignedMetricTraceRequest struct {
 	Name    string    `json:"name"`
 	Slice   []int     `json:"slice"`
 }
+
+// SearchMetricPairsRequest is a request object for `POST /runs/search/metric/pairs` endpoint.
+type SearchMetricPairsRequest struct {
+	RunIDs  []string `json:"run_ids"`
+	XMetric string   `json:"x_metric"`
+	YMetric string   `json:"y_metric"`
+}
+
+// SearchLatestMetricsRequest is a request object for `POST /runs/search/metric/latest` endpoint.
+type SearchLatestMetricsRequest struct {
+	RunIDs  []string  `json:"run_ids"`
+	Name    string    `json:"name"`
+	Context fiber.Map `json:"context"`
+}
@@ -1,6 +1,7 @@
 package response
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -90,6 +91,69 @@ func NewGetExperimentActivityResponse(activity *models.ExperimentActivity) *Expe
 	}
 }
 
+// ExperimentTagFacets represents the response object to hold distinct run tag values grouped by tag key.
+type ExperimentTagFacets struct {
+	Tags map[string][]string `json:"tags"`
+}
+
+// NewGetExperimentTagFacetsResponse creates new response object for `GET /experiments/:id/tag-facets` endpoint.
+func NewGetExperimentTagFacetsResponse(facets models.ExperimentTagFacets) *ExperimentTagFacets {
+	return &ExperimentTagFacets{
+		Tags: facets,
+	}
+}
+
+// ExperimentMetricContexts represents the response object to hold distinct contexts of the metric.
+type ExperimentMetricContexts struct {
+	Contexts []json.RawMessage `json:"contexts"`
+}
+
+// NewGetExperimentMetricContextsResponse creates new response object
+// for `GET /experiments/:id/metric-contexts` endpoint.
+func NewGetExperimentMetricContextsResponse(contexts []models.Context) *ExperimentMetricContexts {
+	resp := ExperimentMetricContexts{
+		Contexts: make([]json.RawMessage, len(contexts)),
+	}
+	for i, context := range contexts {
+		resp.Contexts[i] = json.RawMessage(context.Json)
+	}
+	return &resp
+}
+
+// ExperimentMetricSummaryPartial represents aggregates of the latest values of the metric logged with the context.
+type ExperimentMetricSummaryPartial struct {
+	Name    string          `json:"name"`
+	Context json.RawMessage `json:"context"`
+	Count   int64           `json:"count"`
+	Min     float64         `json:"min"`
+	Max     float64         `json:"max"`
+	Mean    float64         `json:"mean"`
+}
+
+// ExperimentMetricSummary represents the response object to hold the summary of the experiment metrics.
+type ExperimentMetricSummary struct {
+	Metrics []ExperimentMetricSummaryPartial `json:"metrics"`
+}
+
+// NewGetExperimentMetricSummaryResponse creates new response object
+// for `GET /experiments/:id/metric-summary` endpoint.
+func NewGetExperimentMetricSummaryResponse(summaries []models.ExperimentMetricSummary) *ExperimentMetricSummary {
+	resp := ExperimentMetricSummary{
+		Metrics: make([]ExperimentMetricSummaryPartial, len(summaries)),
+	}
+	for i, summary := range summaries {
+		resp.Metrics[i] = ExperimentMetricSummaryPartial{
+			Name:    summary.Key,
+			Context: json.RawMessage(summary.Context),
+			Count:   summary.Count,
+			Min:     summary.Min,
+			Max:     summary.Max,
+			Mean:    summary.Mean,
+		}
+	}
+	return &resp
+}
+
 // UpdateExperimentResponse is a response object to hold response data for `PUT experiments/:id` endpoint.
 type UpdateExperimentResponse struct {
 	ID     string `json:"ID"`
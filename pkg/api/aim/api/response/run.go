@@ -205,6 +205,70 @@ func NewGetRunMetricsResponse(metrics []models.Metric, metricKeysMap models.Metr
 	return resp
 }
 
+// LatestMetricResponse is a response object to hold the latest metric value of one run for
+// `POST /runs/search/metric/latest` endpoint. Values are null if the run lacks the metric.
+type LatestMetricResponse struct {
+	RunID     string   `json:"run_id"`
+	Value     *float64 `json:"value"`
+	Step      *int64   `json:"step"`
+	Timestamp *int64   `json:"timestamp"`
+}
+
+// NewSearchLatestMetricsResponse creates a new response object for `POST /runs/search/metric/latest` endpoint.
+func NewSearchLatestMetricsResponse(metrics []models.RunLatestMetric) []LatestMetricResponse {
+	resp := make([]LatestMetricResponse, len(metrics))
+	for i, metric := range metrics {
+		resp[i].RunID = metric.RunID
+		if metric.Value.Valid && !metric.IsNan.Bool {
+			resp[i].Value = common.GetPointer(metric.Value.Float64)
+		}
+		if metric.Step.Valid {
+			resp[i].Step = common.GetPointer(metric.Step.Int64)
+		}
+		if metric.Timestamp.Valid {
+			resp[i].Timestamp = common.GetPointer(metric.Timestamp.Int64)
+		}
+	}
+	return resp
+}
+
+// MetricPairsResponse is a response object to hold the paired values of one run and context for
+// `POST /runs/search/metric/pairs` endpoint.
+type MetricPairsResponse struct {
+	RunID   string          `json:"run_id"`
+	Context json.RawMessage `json:"context"`
+	Steps   []int64         `json:"steps"`
+	X       []*float64      `json:"x"`
+	Y       []*float64      `json:"y"`
+}
+
+// NewSearchMetricPairsResponse creates a new response object for `POST /runs/search/metric/pairs` endpoint.
+func NewSearchMetricPairsResponse(pairs []models.MetricPair) []MetricPairsResponse {
+	resp := make([]MetricPairsResponse, 0)
+	for _, pair := range pairs {
+		if len(resp) == 0 ||
+			resp[len(resp)-1].RunID != pair.RunID ||
+			string(resp[len(resp)-1].Context) != string(pair.Context) {
+			resp = append(resp, MetricPairsResponse{
+				RunID:   pair.RunID,
+				Context: json.RawMessage(pair.Context),
+			})
+		}
+		x, y := common.GetPointer(pair.XValue), common.GetPointer(pair.YValue)
+		if pair.XIsNan {
+			x = nil
+		}
+		if pair.YIsNan {
+			y = nil
+		}
+		item := &resp[len(resp)-1]
+		item.Steps = append(item.Steps, pair.Step)
+		item.X = append(item.X, x)
+		item.Y = append(item.Y, y)
+	}
+	return resp
+}
+
 // SearchAlignedMetricsResponse  is a response object to hold response data for
 // `GET /runs/search/metric/align` endpoint.
 type SearchAlignedMetricsResponse struct {
@@ -852,6 +916,10 @@ func NewRunsSearchCSVResponse(ctx *fiber.Ctx, runs []models.Run, excludeTraces,
 	})
 }
 
+// NextPageTokenHeader is a response header with the `offset` of the next page of searched runs.
+// It is not set on the last page.
+const NextPageTokenHeader = "X-Fasttrackml-Next-Page-Token"
+
 // NewRunsSearchStreamResponse formats and sends Runs search response as a stream.
 //
 //nolint:gocyclo
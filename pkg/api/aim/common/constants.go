@@ -7,6 +7,9 @@ const (
 	NANNegativeInfinity = "-Infinity"
 )
 
+// DefaultTagFacetValuesLimit is the default maximum number of distinct values returned per tag key.
+const DefaultTagFacetValuesLimit = 100
+
 // Constants for experiment tags keys.
 const (
 	DescriptionTagKey = "mlflow.note.content"
@@ -112,6 +112,86 @@ func (c Controller) GetExperimentActivity(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// GetExperimentTagFacets handles `GET /experiments/:id/tag-facets` endpoint.
+func (c Controller) GetExperimentTagFacets(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentTagFacets namespace: %s", ns.Code)
+
+	req := request.GetExperimentTagFacetsRequest{}
+	if err = ctx.QueryParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err = ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	facets, err := c.experimentService.GetExperimentTagFacets(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetExperimentTagFacetsResponse(facets)
+	log.Debugf("getExperimentTagFacets response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// GetExperimentMetricContexts handles `GET /experiments/:id/metric-contexts` endpoint.
+func (c Controller) GetExperimentMetricContexts(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentMetricContexts namespace: %s", ns.Code)
+
+	req := request.GetExperimentMetricContextsRequest{}
+	if err = ctx.QueryParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if err = ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	contexts, err := c.experimentService.GetExperimentMetricContexts(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetExperimentMetricContextsResponse(contexts)
+	log.Debugf("getExperimentMetricContexts response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// GetExperimentMetricSummary handles `GET /experiments/:id/metric-summary` endpoint.
+func (c Controller) GetExperimentMetricSummary(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentMetricSummary namespace: %s", ns.Code)
+
+	req := request.GetExperimentMetricSummaryRequest{}
+	if err = ctx.ParamsParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	summaries, err := c.experimentService.GetExperimentMetricSummary(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetExperimentMetricSummaryResponse(summaries)
+	log.Debugf("getExperimentMetricSummary response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // DeleteExperiment handles `DELETE /experiments/:id` endpoint.
 func (c Controller) DeleteExperiment(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
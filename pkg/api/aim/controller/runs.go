@@ -166,11 +166,14 @@ func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 	}
 
 	// Search runs
-	runs, total, err := c.runService.SearchRuns(ctx.Context(), ns.ID, tzOffset, req)
+	runs, total, nextOffset, err := c.runService.SearchRuns(ctx.Context(), ns.ID, tzOffset, req)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 	log.Debugf("found %d runs", len(runs))
+	if nextOffset != "" {
+		ctx.Set(response.NextPageTokenHeader, nextOffset)
+	}
 
 	// Choose response
 	switch req.Action {
@@ -241,6 +244,52 @@ func (c Controller) SearchAlignedMetrics(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// SearchLatestMetrics handles `POST /runs/search/metric/latest` endpoint.
+func (c Controller) SearchLatestMetrics(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchLatestMetrics namespace: %s", ns.Code)
+
+	req := request.SearchLatestMetricsRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	metrics, err := c.runService.SearchLatestMetrics(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewSearchLatestMetricsResponse(metrics)
+	log.Debugf("searchLatestMetrics response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// SearchMetricPairs handles `POST /runs/search/metric/pairs` endpoint.
+func (c Controller) SearchMetricPairs(ctx *fiber.Ctx) error {
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchMetricPairs namespace: %s", ns.Code)
+
+	req := request.SearchMetricPairsRequest{}
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	pairs, err := c.runService.SearchMetricPairs(ctx.Context(), ns.ID, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewSearchMetricPairsResponse(pairs)
+	log.Debugf("searchMetricPairs response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
 // SearchMetrics handles `POST /runs/search/image` endpoint.
 func (c Controller) SearchImages(ctx *fiber.Ctx) error {
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
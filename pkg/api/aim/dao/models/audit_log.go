@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// AuditLogEntityType represents the type of entity an AuditLog entry refers to.
+type AuditLogEntityType string
+
+// Supported list of audited entity types.
+const (
+	AuditLogEntityTypeRun        AuditLogEntityType = "run"
+	AuditLogEntityTypeExperiment AuditLogEntityType = "experiment"
+)
+
+// AuditLogAction represents the action recorded by an AuditLog entry.
+type AuditLogAction string
+
+// Supported list of audited actions.
+const (
+	AuditLogActionDelete  AuditLogAction = "delete"
+	AuditLogActionRestore AuditLogAction = "restore"
+	AuditLogActionPurge   AuditLogAction = "purge"
+)
+
+// AuditLog represents model to work with `audit_logs` table.
+type AuditLog struct {
+	ID          uint `gorm:"primaryKey;autoIncrement"`
+	NamespaceID uint
+	Actor       string
+	EntityType  AuditLogEntityType
+	EntityID    string
+	Action      AuditLogAction
+	CreatedAt   time.Time
+}
+
+// NewAuditLogs creates AuditLog entries of the action the actor made on the entities of the namespace.
+func NewAuditLogs(
+	namespaceID uint, actor string, entityType AuditLogEntityType, action AuditLogAction, entityIDs ...string,
+) []AuditLog {
+	now := time.Now().UTC()
+	auditLogs := make([]AuditLog, len(entityIDs))
+	for i, entityID := range entityIDs {
+		auditLogs[i] = AuditLog{
+			NamespaceID: namespaceID,
+			Actor:       actor,
+			EntityType:  entityType,
+			EntityID:    entityID,
+			Action:      action,
+			CreatedAt:   now,
+		}
+	}
+	return auditLogs
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"database/sql"
+
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 )
 
 // Experiment represents model to work with `experiments` table.
@@ -12,6 +14,7 @@ type Experiment struct {
 	LifecycleStage   LifecycleStage `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
 	CreationTime     sql.NullInt64  `gorm:"type:bigint"`
 	LastUpdateTime   sql.NullInt64  `gorm:"type:bigint"`
+	DeletedTime      sql.NullInt64  `gorm:"type:bigint"`
 	NamespaceID      uint           `gorm:"index:,unique,composite:name"`
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
@@ -42,6 +45,20 @@ func (a ExperimentExtended) TableName() string {
 	return "experiments"
 }
 
+// ExperimentTagFacets represents distinct values of run tags grouped by tag key.
+type ExperimentTagFacets map[string][]string
+
+// ExperimentMetricSummary represents aggregates of the latest values of the metric
+// logged with the same context in the experiment runs.
+type ExperimentMetricSummary struct {
+	Key     string
+	Context types.JSONB
+	Count   int64
+	Min     float64
+	Max     float64
+	Mean    float64
+}
+
 // ExperimentActivity represents model to hold experiment activity information.
 type ExperimentActivity struct {
 	NumRuns         int            `json:"num_runs"`
@@ -2,6 +2,7 @@ package models
 
 import (
 	"crypto/sha256"
+	"database/sql"
 	"fmt"
 
 	"gorm.io/datatypes"
@@ -9,6 +10,15 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 )
 
+// MetricKind represents the kind of metric.
+type MetricKind string
+
+// Supported list of metric kinds.
+const (
+	MetricKindUser   MetricKind = "user"
+	MetricKindSystem MetricKind = "system"
+)
+
 // Metric represents model to work with `metrics` table.
 type Metric struct {
 	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
@@ -20,6 +30,7 @@ type Metric struct {
 	Iter      int64   `gorm:"index"`
 	ContextID uint    `gorm:"not null;primaryKey"`
 	Context   Context
+	Kind      MetricKind `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 // UniqueKey is a compound unique key for this metric series.
@@ -38,6 +49,27 @@ func (m AlignedMetric) TableName() string {
 	return "metrics"
 }
 
+// MetricPair represents the values of two metrics logged at the same step of a run.
+type MetricPair struct {
+	RunID     string         `gorm:"column:run_uuid"`
+	Step      int64          `gorm:"column:step"`
+	ContextID uint           `gorm:"column:context_id"`
+	Context   datatypes.JSON `gorm:"column:context_json"`
+	XValue    float64        `gorm:"column:x_value"`
+	XIsNan    bool           `gorm:"column:x_is_nan"`
+	YValue    float64        `gorm:"column:y_value"`
+	YIsNan    bool           `gorm:"column:y_is_nan"`
+}
+
+// RunLatestMetric represents the latest value of a metric of a run, values are NULL if the run lacks the metric.
+type RunLatestMetric struct {
+	RunID     string          `gorm:"column:run_uuid"`
+	Value     sql.NullFloat64 `gorm:"column:value"`
+	IsNan     sql.NullBool    `gorm:"column:is_nan"`
+	Step      sql.NullInt64   `gorm:"column:step"`
+	Timestamp sql.NullInt64   `gorm:"column:timestamp"`
+}
+
 // LatestMetric represents model to work with `last_metrics` table.
 type LatestMetric struct {
 	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
@@ -49,6 +81,7 @@ type LatestMetric struct {
 	LastIter  int64
 	ContextID uint `gorm:"not null;primaryKey"`
 	Context   Context
+	Kind      MetricKind `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 // UniqueKey is a compound unique key for this metric series.
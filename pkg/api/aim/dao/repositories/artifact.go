@@ -15,6 +15,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 // ArtifactSearchStepInfo is a search summary for a Run Step.
@@ -67,6 +68,9 @@ type ArtifactRepositoryProvider interface {
 		ctx context.Context,
 		namespaceID uint,
 		timeZoneOffset int,
+		maxQueryJoins int,
+		timezone string,
+		filter repositories.SearchRunsFilter,
 		req request.SearchArtifactsRequest,
 	) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error)
 	GetArtifactNamesByExperiments(
@@ -91,8 +95,19 @@ func (r ArtifactRepository) Search(
 	ctx context.Context,
 	namespaceID uint,
 	timeZoneOffset int,
+	maxQueryJoins int,
+	timezone string,
+	filter repositories.SearchRunsFilter,
 	req request.SearchArtifactsRequest,
 ) (*sql.Rows, map[string]models.Run, ArtifactSearchSummary, error) {
+	jsonColumnType, err := query.GetJsonColumnType(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defaultContextID, err := query.GetDefaultContextID(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	qp := query.QueryParser{
 		Default: query.DefaultExpression{
 			Contains:   "run.archived",
@@ -103,23 +118,29 @@ func (r ArtifactRepository) Search(
 			"experiments": "experiments",
 			"artifacts":   "artifacts",
 		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
+		TzOffset:         timeZoneOffset,
+		Dialector:        r.GetDB().Dialector.Name(),
+		MaxJoins:         maxQueryJoins,
+		Timezone:         timezone,
+		JsonColumnType:   jsonColumnType,
+		DefaultContextID: defaultContextID,
 	}
-	pq, err := qp.Parse(req.Query)
+	pq, err := qp.ParseWithContext(ctx, req.Query)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	db := database.WithSearchReplicas(r.GetDB())
+
 	runIDs := []string{}
 	runs := []models.Run{}
-	if tx := pq.Filter(r.GetDB().WithContext(ctx).
+	if tx := pq.Filter(filter.Filter(ctx, db.WithContext(ctx).
 		Table("runs").
 		Joins(`INNER JOIN experiments
                         ON experiments.experiment_id = runs.experiment_id
                         AND experiments.namespace_id = ?`,
 			namespaceID,
-		)).
+		))).
 		Preload("Experiment").
 		Find(&runs); tx.Error != nil {
 		return nil, nil, nil, eris.Wrap(err, "error finding runs for artifact search")
@@ -135,7 +156,7 @@ func (r ArtifactRepository) Search(
 
 	// collect some summary data for progress indicator
 	stepInfo := []ArtifactSearchStepInfo{}
-	if tx := r.GetDB().WithContext(ctx).
+	if tx := db.WithContext(ctx).
 		Raw(`SELECT run_uuid, name, step, count(id) as img_count, max("index") as max_index
 			FROM artifacts
 			WHERE run_uuid IN (?)
@@ -162,7 +183,7 @@ func (r ArtifactRepository) Search(
 	}
 
 	// get a cursor for the artifacts
-	tx := r.GetDB().WithContext(ctx).
+	tx := db.WithContext(ctx).
 		Raw(`
                     SELECT artifacts.*, rows.row_num
                     FROM artifacts
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+)
+
+// createAuditLogs creates []models.AuditLog entities in scope of the transaction, which makes the audited change,
+// so the change is never made without its audit log entries and vice versa.
+func createAuditLogs(tx *gorm.DB, auditLogs []models.AuditLog) error {
+	if len(auditLogs) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(&auditLogs, 100).Error; err != nil {
+		return eris.Wrap(err, "error creating audit log entries")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/rotisserie/eris"
@@ -18,10 +19,11 @@ import (
 
 // ExperimentRepositoryProvider provides an interface to work with `experiment` entity.
 type ExperimentRepositoryProvider interface {
-	// Update updates existing experiment.
-	Update(ctx context.Context, experiment *models.Experiment) error
-	// Delete deletes existing experiment.
-	Delete(ctx context.Context, experiment *models.Experiment) error
+	// Update updates existing experiment. Archiving or restoring of the experiment
+	// is recorded in the audit log on behalf of the actor.
+	Update(ctx context.Context, experiment *models.Experiment, actor string) error
+	// Delete deletes existing experiment and records it in the audit log on behalf of the actor.
+	Delete(ctx context.Context, experiment *models.Experiment, actor string) error
 	// GetExperiments returns list of experiments.
 	GetExperiments(ctx context.Context, namespaceID uint) ([]models.ExperimentExtended, error)
 	// GetExperimentRuns returns list of runs which belong to experiment.
@@ -30,6 +32,18 @@ type ExperimentRepositoryProvider interface {
 	GetExperimentActivity(
 		ctx context.Context, namespaceID uint, experimentID int32, tzOffset int,
 	) (*models.ExperimentActivity, error)
+	// GetExperimentTagFacets returns distinct run tag values grouped by tag key.
+	GetExperimentTagFacets(
+		ctx context.Context, namespaceID uint, experimentID int32, limit int,
+	) (models.ExperimentTagFacets, error)
+	// GetExperimentMetricContexts returns distinct contexts of the metric logged in the experiment.
+	GetExperimentMetricContexts(
+		ctx context.Context, namespaceID uint, experimentID int32, key string,
+	) ([]models.Context, error)
+	// GetExperimentMetricSummary returns aggregates of the latest metric values grouped by metric key and context.
+	GetExperimentMetricSummary(
+		ctx context.Context, namespaceID uint, experimentID int32,
+	) ([]models.ExperimentMetricSummary, error)
 	// GetExperimentByNamespaceIDAndExperimentID returns experiment by Namespace ID and Experiment ID.
 	GetExperimentByNamespaceIDAndExperimentID(
 		ctx context.Context, namespaceID uint, experimentID int32,
@@ -54,14 +68,38 @@ func NewExperimentRepository(db *gorm.DB) *ExperimentRepository {
 	}
 }
 
-// Update updates existing experiment.
-func (r ExperimentRepository) Update(ctx context.Context, experiment *models.Experiment) error {
+// Update updates existing experiment. Archiving or restoring of the experiment
+// is recorded in the audit log on behalf of the actor.
+func (r ExperimentRepository) Update(ctx context.Context, experiment *models.Experiment, actor string) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
+		var lifecycleStage models.LifecycleStage
+		if err := tx.WithContext(ctx).Model(
+			&models.Experiment{},
+		).Where(
+			"experiment_id = ?", *experiment.ID,
+		).Pluck("lifecycle_stage", &lifecycleStage).Error; err != nil {
+			return eris.Wrapf(err, "error getting lifecycle stage of experiment with id: %d", *experiment.ID)
+		}
+
 		if err := tx.WithContext(ctx).Model(&experiment).Updates(experiment).Error; err != nil {
 			return eris.Wrapf(err, "error updating experiment with id: %d", *experiment.ID)
 		}
 
-		// also archive experiment runs if experiment is being archived
+		// deletion time is only recorded when the experiment is archived, it is reset when it is restored.
+		if experiment.LifecycleStage != lifecycleStage {
+			experiment.DeletedTime = sql.NullInt64{}
+			if experiment.LifecycleStage == models.LifecycleStageDeleted {
+				experiment.DeletedTime = sql.NullInt64{Int64: time.Now().UTC().UnixMilli(), Valid: true}
+			}
+			if err := tx.WithContext(ctx).Model(&experiment).UpdateColumn(
+				"DeletedTime", experiment.DeletedTime,
+			).Error; err != nil {
+				return eris.Wrapf(err, "error updating deletion time of experiment with id: %d", *experiment.ID)
+			}
+		}
+
+		// also archive active experiment runs if experiment is being archived, the runs are marked,
+		// so only they are restored together with the experiment.
 		if experiment.LifecycleStage == models.LifecycleStageDeleted {
 			if err := tx.WithContext(
 				ctx,
@@ -69,19 +107,36 @@ func (r ExperimentRepository) Update(ctx context.Context, experiment *models.Exp
 				&models.Run{},
 			).Where(
 				"experiment_id = ?", experiment.ID,
+			).Where(
+				"lifecycle_stage = ?", models.LifecycleStageActive,
 			).Updates(&models.Run{
-				LifecycleStage: experiment.LifecycleStage,
-				DeletedTime:    experiment.LastUpdateTime,
+				LifecycleStage:        experiment.LifecycleStage,
+				DeletedTime:           experiment.DeletedTime,
+				DeletedWithExperiment: true,
 			}).Error; err != nil {
 				return eris.Wrapf(err, "error updating existing runs with experiment id: %d", *experiment.ID)
 			}
 		}
-		return nil
+
+		if experiment.LifecycleStage == lifecycleStage {
+			return nil
+		}
+		action := models.AuditLogActionRestore
+		if experiment.LifecycleStage == models.LifecycleStageDeleted {
+			action = models.AuditLogActionDelete
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			experiment.NamespaceID,
+			actor,
+			models.AuditLogEntityTypeExperiment,
+			action,
+			fmt.Sprintf("%d", *experiment.ID),
+		))
 	})
 }
 
-// Delete deletes existing experiment.
-func (r ExperimentRepository) Delete(ctx context.Context, experiment *models.Experiment) error {
+// Delete deletes existing experiment and records it in the audit log on behalf of the actor.
+func (r ExperimentRepository) Delete(ctx context.Context, experiment *models.Experiment, actor string) error {
 	if err := r.db.Transaction(func(tx *gorm.DB) error {
 		// finding all the related runs
 		var minRowNum sql.NullInt64
@@ -134,7 +189,13 @@ func (r ExperimentRepository) Delete(ctx context.Context, experiment *models.Exp
 			}
 		}
 
-		return nil
+		return createAuditLogs(tx, models.NewAuditLogs(
+			experiment.NamespaceID,
+			actor,
+			models.AuditLogEntityTypeExperiment,
+			models.AuditLogActionPurge,
+			fmt.Sprintf("%d", *experiment.ID),
+		))
 	}); err != nil {
 		return eris.Wrapf(err, "error deleting experiment with id: %d", *experiment.ID)
 	}
@@ -248,6 +309,110 @@ func (r ExperimentRepository) GetExperimentActivity(
 	return &activity, nil
 }
 
+// GetExperimentTagFacets returns distinct run tag values grouped by tag key.
+// No more than `limit` values, in alphabetical order, are returned for each key.
+func (r ExperimentRepository) GetExperimentTagFacets(
+	ctx context.Context, namespaceID uint, experimentID int32, limit int,
+) (models.ExperimentTagFacets, error) {
+	var rows []models.Tag
+	if err := r.db.WithContext(ctx).Raw(
+		`SELECT key, value
+		 FROM (
+		   SELECT key, value, ROW_NUMBER() OVER (PARTITION BY key ORDER BY value) AS value_num
+		   FROM (
+		     SELECT DISTINCT tags.key, tags.value
+		     FROM tags
+		     INNER JOIN runs ON runs.run_uuid = tags.run_uuid
+		     INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id
+		     WHERE experiments.namespace_id = ? AND experiments.experiment_id = ?
+		   ) AS distinct_tags
+		 ) AS numbered_tags
+		 WHERE value_num <= ?
+		 ORDER BY key, value`,
+		namespaceID,
+		experimentID,
+		limit,
+	).Scan(&rows).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting tag facets of experiment: %d", experimentID)
+	}
+
+	facets := models.ExperimentTagFacets{}
+	for _, row := range rows {
+		facets[row.Key] = append(facets[row.Key], row.Value)
+	}
+	return facets, nil
+}
+
+// GetExperimentMetricContexts returns distinct contexts of the metric logged in the experiment.
+func (r ExperimentRepository) GetExperimentMetricContexts(
+	ctx context.Context, namespaceID uint, experimentID int32, key string,
+) ([]models.Context, error) {
+	var contexts []models.Context
+	if err := r.db.WithContext(ctx).Model(
+		&models.Context{},
+	).Distinct(
+		"contexts.id", "contexts.json",
+	).Joins(
+		"INNER JOIN latest_metrics ON latest_metrics.context_id = contexts.id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = latest_metrics.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+	).Where(
+		"experiments.namespace_id = ?", namespaceID,
+	).Where(
+		"experiments.experiment_id = ?", experimentID,
+	).Where(
+		"latest_metrics.key = ?", key,
+	).Order(
+		"contexts.id",
+	).Find(&contexts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting metric contexts of experiment: %d", experimentID)
+	}
+	return contexts, nil
+}
+
+// GetExperimentMetricSummary returns aggregates of the latest metric values grouped by metric key and context.
+// NaN values can't be aggregated, so they are skipped.
+func (r ExperimentRepository) GetExperimentMetricSummary(
+	ctx context.Context, namespaceID uint, experimentID int32,
+) ([]models.ExperimentMetricSummary, error) {
+	var summaries []models.ExperimentMetricSummary
+	if err := r.db.WithContext(ctx).Model(
+		&models.LatestMetric{},
+	).Select(
+		"latest_metrics.key",
+		"contexts.json AS context",
+		"COUNT(*) AS count",
+		"MIN(latest_metrics.value) AS min",
+		"MAX(latest_metrics.value) AS max",
+		"AVG(latest_metrics.value) AS mean",
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = latest_metrics.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = latest_metrics.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+	).Where(
+		"experiments.namespace_id = ?", namespaceID,
+	).Where(
+		"experiments.experiment_id = ?", experimentID,
+	).Where(
+		"latest_metrics.is_nan = ?", false,
+	).Group(
+		"latest_metrics.key",
+	).Group(
+		"contexts.id",
+	).Order(
+		"latest_metrics.key",
+	).Order(
+		"contexts.id",
+	).Scan(&summaries).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting metric summary of experiment: %d", experimentID)
+	}
+	return summaries, nil
+}
+
 // GetExperimentByNamespaceIDAndExperimentID returns experiment by Namespace ID and Experiment ID.
 func (r ExperimentRepository) GetExperimentByNamespaceIDAndExperimentID(
 	ctx context.Context, namespaceID uint, experimentID int32,
@@ -17,6 +17,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 // SearchResult is a helper for reporting result progress.
@@ -37,12 +38,25 @@ type MetricRepositoryProvider interface {
 	) ([]models.LatestMetric, error)
 	// SearchMetrics returns a sql.Rows cursor for streaming the metrics matching the request.
 	SearchMetrics(
-		ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchMetricsRequest,
+		ctx context.Context,
+		namespaceID uint,
+		timeZoneOffset, maxQueryJoins int,
+		timezone string,
+		filter repositories.SearchRunsFilter,
+		req request.SearchMetricsRequest,
 	) (*sql.Rows, int64, SearchResultMap, error)
 	// GetContextListByContextObjects returns list of context by provided map of contexts.
 	GetContextListByContextObjects(
 		ctx context.Context, contextsMap map[string]types.JSONB,
 	) ([]models.Context, error)
+	// GetLatestMetrics returns the latest value of the metric with provided key and context for the requested runs.
+	GetLatestMetrics(
+		ctx context.Context, namespaceID uint, runIDs []string, key string, metricContext types.JSONB,
+	) ([]models.RunLatestMetric, error)
+	// GetMetricPairs returns values of two metrics joined on step for the requested runs.
+	GetMetricPairs(
+		ctx context.Context, namespaceID uint, runIDs []string, xMetric, yMetric string,
+	) ([]models.MetricPair, error)
 }
 
 // MetricRepository repository to work with models.Metric entity.
@@ -87,8 +101,21 @@ func (r MetricRepository) GetMetricKeysAndContextsByExperiments(
 
 // SearchMetrics returns a metrics cursor according to the SearchMetricsRequest.
 func (r MetricRepository) SearchMetrics(
-	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchMetricsRequest,
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset, maxQueryJoins int,
+	timezone string,
+	filter repositories.SearchRunsFilter,
+	req request.SearchMetricsRequest,
 ) (*sql.Rows, int64, SearchResultMap, error) {
+	jsonColumnType, err := query.GetJsonColumnType(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	defaultContextID, err := query.GetDefaultContextID(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, 0, nil, err
+	}
 	qp := query.QueryParser{
 		Default: query.DefaultExpression{
 			Contains:   "run.archived",
@@ -99,10 +126,14 @@ func (r MetricRepository) SearchMetrics(
 			"experiments": "experiments",
 			"metrics":     "latest_metrics",
 		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
+		TzOffset:         timeZoneOffset,
+		Dialector:        r.GetDB().Dialector.Name(),
+		MaxJoins:         maxQueryJoins,
+		Timezone:         timezone,
+		JsonColumnType:   jsonColumnType,
+		DefaultContextID: defaultContextID,
 	}
-	pq, err := qp.Parse(req.Query)
+	pq, err := qp.ParseWithContext(ctx, req.Query)
 	if err != nil {
 		return nil, 0, nil, err
 	}
@@ -111,22 +142,24 @@ func (r MetricRepository) SearchMetrics(
 		return nil, 0, nil, eris.New("No metrics are selected")
 	}
 
+	db := database.WithSearchReplicas(r.GetDB())
+
 	var totalRuns int64
-	if err := r.GetDB().WithContext(ctx).Model(&models.Run{}).Count(&totalRuns).Error; err != nil {
+	if err := db.WithContext(ctx).Model(&models.Run{}).Count(&totalRuns).Error; err != nil {
 		return nil, 0, nil, eris.Wrap(err, "error counting metrics")
 	}
 
 	var runs []models.Run
-	if tx := r.GetDB().WithContext(ctx).
+	if tx := db.WithContext(ctx).
 		InnerJoins(
 			"Experiment",
-			r.GetDB().WithContext(ctx).Select(
+			db.WithContext(ctx).Select(
 				"ID", "Name",
 			).Where(&models.Experiment{NamespaceID: namespaceID}),
 		).
 		Preload("Params").
 		Preload("Tags").
-		Where("run_uuid IN (?)", pq.Filter(r.GetDB().WithContext(ctx).
+		Where("run_uuid IN (?)", pq.Filter(filter.Filter(ctx, db.WithContext(ctx).
 			Select("runs.run_uuid").
 			Table("runs").
 			Joins(
@@ -135,7 +168,7 @@ func (r MetricRepository) SearchMetrics(
 			).
 			Joins("JOIN latest_metrics USING(run_uuid)").
 			Joins("JOIN contexts ON latest_metrics.context_id = contexts.id"),
-		)).
+		))).
 		Order("runs.row_num DESC").
 		Find(&runs); tx.Error != nil {
 		return nil, 0, nil, eris.Wrap(err, "error searching metrics")
@@ -185,7 +218,7 @@ func (r MetricRepository) SearchMetrics(
 	}
 	metricKeyContextCondition := strings.Join(metricKeyContextConditionSlice, " OR ")
 
-	subQuery := r.GetDB().WithContext(ctx).
+	subQuery := db.WithContext(ctx).
 		Select(
 			"runs.run_uuid",
 			"runs.row_num",
@@ -203,7 +236,7 @@ func (r MetricRepository) SearchMetrics(
 		Joins("LEFT JOIN contexts ON latest_metrics.context_id = contexts.id").
 		Where(metricKeyContextCondition)
 
-	tx := r.GetDB().WithContext(ctx).
+	tx := db.WithContext(ctx).
 		Select(`
         metrics.*,
         runmetrics.context_json`,
@@ -211,7 +244,7 @@ func (r MetricRepository) SearchMetrics(
 		Table("metrics").
 		Joins(
 			"INNER JOIN (?) runmetrics USING(run_uuid, key, context_id)",
-			pq.Filter(subQuery),
+			pq.Filter(filter.Filter(ctx, subQuery)),
 		).
 		Where("MOD(metrics.iter + 1 + runmetrics.interval / 2, runmetrics.interval) < 1").
 		Order("runmetrics.row_num DESC").
@@ -254,6 +287,83 @@ func (r MetricRepository) GetContextListByContextObjects(
 	return contexts, nil
 }
 
+// GetLatestMetrics returns the latest value of the metric with provided key and context for the requested runs.
+// Runs which don't have the metric are returned with NULL values.
+func (r MetricRepository) GetLatestMetrics(
+	ctx context.Context, namespaceID uint, runIDs []string, key string, metricContext types.JSONB,
+) ([]models.RunLatestMetric, error) {
+	var metrics []models.RunLatestMetric
+	if err := r.GetDB().WithContext(ctx).Select(
+		"runs.run_uuid",
+		"latest_metrics.value",
+		"latest_metrics.is_nan",
+		"latest_metrics.step",
+		"latest_metrics.timestamp",
+	).Table(
+		"runs",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Joins(
+		"LEFT JOIN latest_metrics ON latest_metrics.run_uuid = runs.run_uuid AND latest_metrics.key = ? "+
+			"AND latest_metrics.context_id IN (SELECT id FROM contexts WHERE contexts.json = ?)",
+		key, metricContext,
+	).Where(
+		"runs.run_uuid IN ?", runIDs,
+	).Scan(&metrics).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting latest metrics")
+	}
+	return metrics, nil
+}
+
+// GetMetricPairs returns values of two metrics joined on step for the requested runs.
+// Steps which were logged only for one of the metrics are dropped.
+func (r MetricRepository) GetMetricPairs(
+	ctx context.Context, namespaceID uint, runIDs []string, xMetric, yMetric string,
+) ([]models.MetricPair, error) {
+	var pairs []models.MetricPair
+	if err := r.GetDB().WithContext(ctx).Select(
+		"x.run_uuid",
+		"x.step",
+		"x.context_id",
+		"contexts.json AS context_json",
+		"x.value AS x_value",
+		"x.is_nan AS x_is_nan",
+		"y.value AS y_value",
+		"y.is_nan AS y_is_nan",
+	).Table(
+		"metrics AS x",
+	).Joins(
+		"INNER JOIN metrics AS y ON y.run_uuid = x.run_uuid AND y.step = x.step AND y.context_id = x.context_id",
+	).Joins(
+		"INNER JOIN contexts ON contexts.id = x.context_id",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = x.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"x.run_uuid IN ?", runIDs,
+	).Where(
+		"x.key = ?", xMetric,
+	).Where(
+		"y.key = ?", yMetric,
+	).Order(
+		"x.run_uuid",
+	).Order(
+		"x.context_id",
+	).Order(
+		"x.step",
+	).Order(
+		"x.iter",
+	).Order(
+		"y.iter",
+	).Scan(&pairs).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting metric pairs")
+	}
+	return pairs, nil
+}
+
 func (r MetricRepository) findContextIDs(ctx context.Context, req *request.SearchMetricsRequest) ([]uint, error) {
 	contextList := []types.JSONB{}
 	contextsMap := map[string]types.JSONB{}
@@ -40,15 +40,23 @@ type RunRepositoryProvider interface {
 	GetByNamespaceIDAndStatus(ctx context.Context, namespaceID uint, status models.Status) ([]models.Run, error)
 	// Update updates existing models.Experiment entity.
 	Update(ctx context.Context, run *models.Run) error
-	// ArchiveBatch marks existing models.Run entities as archived.
-	ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error
-	// DeleteBatch removes the existing models.Run from the db.
-	DeleteBatch(ctx context.Context, namespaceID uint, ids []string) error
-	// RestoreBatch marks existing models.Run entities as active.
-	RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error
+	// ArchiveBatch marks existing models.Run entities as archived
+	// and records it in the audit log on behalf of the actor.
+	ArchiveBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error
+	// DeleteBatch removes the existing models.Run from the db
+	// and records it in the audit log on behalf of the actor.
+	DeleteBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error
+	// RestoreBatch marks existing models.Run entities as active
+	// and records it in the audit log on behalf of the actor.
+	RestoreBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error
 	// SearchRuns returns the list of runs by provided search request.
 	SearchRuns(
-		ctx context.Context, namespaceID uint, tzOffset int, req request.SearchRunsRequest,
+		ctx context.Context,
+		namespaceID uint,
+		tzOffset, maxQueryJoins int,
+		timezone string,
+		filter repositories.SearchRunsFilter,
+		req request.SearchRunsRequest,
 	) ([]models.Run, int64, error)
 }
 
@@ -256,48 +264,27 @@ func (r RunRepository) Update(ctx context.Context, run *models.Run) error {
 	return nil
 }
 
-// ArchiveBatch marks existing models.Run entities as archived.
-func (r RunRepository) ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error {
-	if err := r.GetDB().WithContext(
-		ctx,
-	).Model(
-		models.Run{},
-	).Where(
-		"run_uuid IN (?)",
-		r.GetDB().Model(
-			models.Run{},
-		).Select(
-			"run_uuid",
-		).Joins(
-			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
-			namespaceID,
-		).Where(
-			"run_uuid IN (?)", ids,
-		),
-	).Updates(models.Run{
-		DeletedTime: sql.NullInt64{
-			Int64: time.Now().UTC().UnixMilli(),
-			Valid: true,
-		},
-		LifecycleStage: models.LifecycleStageDeleted,
-	}).Error; err != nil {
+// ArchiveBatch marks existing models.Run entities as archived
+// and records it in the audit log on behalf of the actor.
+func (r RunRepository) ArchiveBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error {
+	if err := r.updateLifecycleStageBatch(ctx, namespaceID, ids, actor, models.LifecycleStageDeleted); err != nil {
 		return eris.Wrapf(err, "error updating existing runs with ids: %s", ids)
 	}
 
 	return nil
 }
 
-// Delete removes the existing models.Run from the db.
-func (r RunRepository) Delete(ctx context.Context, namespaceID uint, run *models.Run) error {
-	return r.DeleteBatch(ctx, namespaceID, []string{run.ID})
+// Delete removes the existing models.Run from the db and records it in the audit log on behalf of the actor.
+func (r RunRepository) Delete(ctx context.Context, namespaceID uint, run *models.Run, actor string) error {
+	return r.DeleteBatch(ctx, namespaceID, []string{run.ID}, actor)
 }
 
-// DeleteBatch removes existing models.Run from the db.
-func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []string) error {
-	if err := r.GetDB().Transaction(func(tx *gorm.DB) error {
+// DeleteBatch removes existing models.Run from the db and records it in the audit log on behalf of the actor.
+func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		runs := make([]models.Run, 0, len(ids))
 		if err := tx.Clauses(
-			clause.Returning{Columns: []clause.Column{{Name: "row_num"}}},
+			clause.Returning{Columns: []clause.Column{{Name: "row_num"}, {Name: "run_uuid"}}},
 		).Where(
 			"run_uuid IN (?)",
 			r.GetDB().Model(
@@ -326,7 +313,14 @@ func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []
 		if err := r.renumberRows(tx, getMinRowNum(runs)); err != nil {
 			return eris.Wrapf(err, "error renumbering runs.row_num")
 		}
-		return nil
+
+		deletedIDs := make([]string, len(runs))
+		for i, run := range runs {
+			deletedIDs[i] = run.ID
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			namespaceID, actor, models.AuditLogEntityTypeRun, models.AuditLogActionPurge, deletedIDs...,
+		))
 	}); err != nil {
 		return eris.Wrapf(err, "error deleting runs")
 	}
@@ -334,30 +328,62 @@ func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []
 	return nil
 }
 
-// RestoreBatch marks existing models.Run entities as active.
-func (r RunRepository) RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error {
-	if err := r.GetDB().WithContext(
-		ctx,
-	).Where(
-		"run_uuid IN (?)",
-		r.GetDB().Model(
+// RestoreBatch marks existing models.Run entities as active
+// and records it in the audit log on behalf of the actor.
+func (r RunRepository) RestoreBatch(ctx context.Context, namespaceID uint, ids []string, actor string) error {
+	if err := r.updateLifecycleStageBatch(ctx, namespaceID, ids, actor, models.LifecycleStageActive); err != nil {
+		return eris.Wrapf(err, "error updating existing runs with ids: %s", ids)
+	}
+
+	return nil
+}
+
+// updateLifecycleStageBatch moves the runs of the namespace, which are in the other lifecycle stage,
+// to the requested one and records the deletion or restoration of these runs in the audit log.
+func (r RunRepository) updateLifecycleStageBatch(
+	ctx context.Context, namespaceID uint, ids []string, actor string, lifecycleStage models.LifecycleStage,
+) error {
+	action, deletedTime := models.AuditLogActionRestore, sql.NullInt64{}
+	if lifecycleStage == models.LifecycleStageDeleted {
+		action, deletedTime = models.AuditLogActionDelete, sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		}
+	}
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var updatedIDs []string
+		if err := tx.Model(
 			models.Run{},
-		).Select(
-			"run_uuid",
 		).Joins(
 			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 			namespaceID,
 		).Where(
-			"run_uuid IN (?)", ids,
-		),
-	).Updates(models.Run{
-		DeletedTime:    sql.NullInt64{},
-		LifecycleStage: models.LifecycleStageActive,
-	}).Error; err != nil {
-		return eris.Wrapf(err, "error updating existing runs with ids: %s", ids)
-	}
+			"runs.run_uuid IN (?)", ids,
+		).Where(
+			"runs.lifecycle_stage <> ?", lifecycleStage,
+		).Pluck("runs.run_uuid", &updatedIDs).Error; err != nil {
+			return eris.Wrap(err, "error getting runs to update")
+		}
+		if len(updatedIDs) == 0 {
+			return nil
+		}
 
-	return nil
+		// Use UpdateColumns so we can reset DeletedTime to null
+		if err := tx.Model(
+			models.Run{},
+		).Where(
+			"run_uuid IN (?)", updatedIDs,
+		).UpdateColumns(map[string]any{
+			"DeletedTime":           deletedTime,
+			"DeletedWithExperiment": false,
+			"LifecycleStage":        lifecycleStage,
+		}).Error; err != nil {
+			return err
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			namespaceID, actor, models.AuditLogEntityTypeRun, action, updatedIDs...,
+		))
+	})
 }
 
 // UpdateWithTransaction updates existing models.Run entity in scope of transaction.
@@ -370,8 +396,21 @@ func (r RunRepository) UpdateWithTransaction(ctx context.Context, tx *gorm.DB, r
 
 // SearchRuns returns the list of runs by provided search request.
 func (r RunRepository) SearchRuns(
-	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchRunsRequest,
+	ctx context.Context,
+	namespaceID uint,
+	timeZoneOffset, maxQueryJoins int,
+	timezone string,
+	filter repositories.SearchRunsFilter,
+	req request.SearchRunsRequest,
 ) ([]models.Run, int64, error) {
+	jsonColumnType, err := query.GetJsonColumnType(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	defaultContextID, err := query.GetDefaultContextID(r.GetDB().WithContext(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
 	qp := query.QueryParser{
 		Default: query.DefaultExpression{
 			Contains:   "run.archived",
@@ -381,16 +420,22 @@ func (r RunRepository) SearchRuns(
 			"runs":        "runs",
 			"experiments": "Experiment",
 		},
-		TzOffset:  timeZoneOffset,
-		Dialector: r.GetDB().Dialector.Name(),
+		TzOffset:         timeZoneOffset,
+		Dialector:        r.GetDB().Dialector.Name(),
+		MaxJoins:         maxQueryJoins,
+		Timezone:         timezone,
+		JsonColumnType:   jsonColumnType,
+		DefaultContextID: defaultContextID,
 	}
-	pq, err := qp.Parse(req.Query)
+	pq, err := qp.ParseWithContext(ctx, req.Query)
 	if err != nil {
 		return nil, 0, eris.Wrap(err, "problem parsing query")
 	}
 
+	db := database.WithSearchReplicas(r.GetDB())
+
 	var total int64
-	if tx := r.GetDB().WithContext(ctx).
+	if tx := db.WithContext(ctx).
 		Model(&database.Run{}).
 		Count(&total); tx.Error != nil {
 		return nil, 0, eris.Wrap(tx.Error, "unable to count total runs")
@@ -398,7 +443,7 @@ func (r RunRepository) SearchRuns(
 
 	log.Debugf("Total runs: %d", total)
 
-	tx := r.GetDB().WithContext(ctx).
+	tx := db.WithContext(ctx).
 		InnerJoins(
 			"Experiment",
 			database.DB.Select(
@@ -410,6 +455,7 @@ func (r RunRepository) SearchRuns(
 			),
 		).
 		Order("row_num DESC")
+	tx = filter.Filter(ctx, tx)
 
 	if !req.ExcludeParams {
 		tx.Preload("Params")
@@ -421,6 +467,10 @@ func (r RunRepository) SearchRuns(
 		tx.Preload("LatestMetrics.Context")
 	}
 
+	// joined tables, e.g. metrics logged in several contexts, may repeat the runs, which would shrink the page.
+	if pq.HasJoins() {
+		tx.Distinct()
+	}
 	if req.Limit > 0 {
 		tx.Limit(req.Limit)
 	}
@@ -428,7 +478,7 @@ func (r RunRepository) SearchRuns(
 		run := &database.Run{
 			ID: req.Offset,
 		}
-		if err := database.DB.Select(
+		if err := db.WithContext(ctx).Select(
 			"row_num",
 		).First(
 			&run,
@@ -438,7 +488,7 @@ func (r RunRepository) SearchRuns(
 		tx.Where("row_num < ?", run.RowNum)
 	}
 	var runs []models.Run
-	if err := pq.Filter(tx).Find(&runs).Error; err != nil {
+	if err := pq.Run(tx, &runs); err != nil {
 		return nil, 0, eris.Wrap(err, "error searching runs")
 	}
 	log.Debugf("found %d runs", len(runs))
@@ -2,6 +2,7 @@ package query
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -12,6 +13,30 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+// Not negates the expression as a whole, e.g. `NOT ("value" > $1)`,
+// while clause.Not rewrites the comparison operator instead.
+type Not struct {
+	Expression clause.Expression
+}
+
+// Build builds positive statement.
+func (not Not) Build(builder clause.Builder) {
+	//nolint:errcheck,gosec
+	builder.WriteString("NOT (")
+	not.Expression.Build(builder)
+	//nolint:errcheck,gosec
+	builder.WriteString(")")
+}
+
+// NegationBuild builds negative statement.
+func (not Not) NegationBuild(builder clause.Builder) {
+	//nolint:errcheck,gosec
+	builder.WriteString("(")
+	not.Expression.Build(builder)
+	//nolint:errcheck,gosec
+	builder.WriteString(")")
+}
+
 // Regexp whether string matches regular expression
 type Regexp struct {
 	clause.Eq
@@ -62,8 +87,9 @@ func (regexp Regexp) writeColumn(builder clause.Builder) {
 // Json clause for string match at a json path.
 type Json struct {
 	clause.Column
-	JsonPath  string
-	Dialector string
+	JsonPath   string
+	Dialector  string
+	ColumnType string
 }
 
 // Build builds positive statement.
@@ -111,7 +137,7 @@ func (json Json) jsonPathForDialect() string {
 	switch json.Dialector {
 	case postgres.Dialector{}.Name():
 		jsonPath := removePrefix(json.JsonPath)
-		return "{" + strings.ReplaceAll(jsonPath, ",", ".") + "}"
+		return "{" + strings.ReplaceAll(jsonPath, ".", ",") + "}"
 	default:
 		return addPrefix(json.JsonPath)
 	}
@@ -123,7 +149,27 @@ type JsonEq struct {
 	Dialector string
 }
 
+// JsonOperator is the numeric comparison of the value at a json path given by the operator object
+// of the context dictionary, e.g. `{">": 0.01}`.
+type JsonOperator struct {
+	Op    string
+	Value any
+}
+
 func (eq JsonEq) Build(builder clause.Builder) {
+	if operators, ok := eq.Value.([]JsonOperator); ok {
+		renderJsonOperators(builder, eq.Left, operators)
+		return
+	}
+	if eq.isContainment() {
+		builder.WriteQuoted(eq.Left.Column)
+		//nolint:errcheck,gosec
+		builder.WriteString(" @> ")
+		builder.AddVar(builder, eq.jsonValue())
+		//nolint:errcheck,gosec
+		builder.WriteString("::jsonb")
+		return
+	}
 	eq.Left.Build(builder)
 	switch eq.Value.(type) {
 	case []JsonEq:
@@ -164,10 +210,44 @@ func (eq JsonEq) NegationBuild(builder clause.Builder) {
 	JsonNeq(eq).Build(builder)
 }
 
+// isContainment checks whether the scalar value could be matched with jsonb containment operator,
+// which is able to use GIN indexes, instead of the text extraction.
+func (eq JsonEq) isContainment() bool {
+	if eq.Dialector != (postgres.Dialector{}).Name() || eq.Left.ColumnType != JsonColumnTypeJsonb {
+		return false
+	}
+	switch eq.Value.(type) {
+	case string, int, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// jsonValue serializes the value to json object nested by the json path, e.g. `{"a":{"b":1}}` for `a.b`.
+func (eq JsonEq) jsonValue() string {
+	value := eq.Value
+	keys := strings.Split(removePrefix(eq.Left.JsonPath), ".")
+	for i := len(keys) - 1; i >= 0; i-- {
+		value = map[string]any{keys[i]: value}
+	}
+	//nolint:errcheck
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
 // JsonNeq not equal to for where
 type JsonNeq JsonEq
 
 func (neq JsonNeq) Build(builder clause.Builder) {
+	if operators, ok := neq.Value.([]JsonOperator); ok {
+		//nolint:errcheck,gosec
+		builder.WriteString("NOT COALESCE(")
+		renderJsonOperators(builder, neq.Left, operators)
+		//nolint:errcheck,gosec
+		builder.WriteString(", FALSE)")
+		return
+	}
 	neq.Left.Build(builder)
 	switch neq.Value.(type) {
 	case []JsonEq:
@@ -242,6 +322,87 @@ func (jnl JsonNotLike) NegationBuild(builder clause.Builder) {
 	JsonLike(jnl).Build(builder)
 }
 
+// JsonObjectEq compares the whole json object with the provided dictionary.
+// When `Contains` is set, the object only has to contain all the dictionary keys and values.
+// Postgres compares the objects as jsonb, so the `json` column is casted to it.
+type JsonObjectEq struct {
+	Column     clause.Column
+	Value      []JsonEq
+	Contains   bool
+	Dialector  string
+	ColumnType string
+}
+
+// Build renders the json object comparison.
+func (eq JsonObjectEq) Build(builder clause.Builder) {
+	switch eq.Dialector {
+	case postgres.Dialector{}.Name():
+		builder.WriteQuoted(eq.Column)
+		if eq.ColumnType != JsonColumnTypeJsonb {
+			//nolint:errcheck,gosec
+			builder.WriteString("::jsonb")
+		}
+		if eq.Contains {
+			//nolint:errcheck,gosec
+			builder.WriteString(" @> ")
+		} else {
+			//nolint:errcheck,gosec
+			builder.WriteString(" = ")
+		}
+		builder.AddVar(builder, eq.jsonValue())
+		//nolint:errcheck,gosec
+		builder.WriteString("::jsonb")
+	default:
+		// SQLite has no json containment operator, so every key is compared separately
+		// and the exact match additionally compares the number of keys in the object.
+		for i, value := range eq.Value {
+			if i > 0 {
+				//nolint:errcheck,gosec
+				builder.WriteString(" AND ")
+			}
+			value.Build(builder)
+		}
+		if eq.Contains {
+			if len(eq.Value) == 0 {
+				//nolint:errcheck,gosec
+				builder.WriteString("1 = 1")
+			}
+			return
+		}
+		if len(eq.Value) > 0 {
+			//nolint:errcheck,gosec
+			builder.WriteString(" AND ")
+		}
+		//nolint:errcheck,gosec
+		builder.WriteString("(SELECT COUNT(*) FROM json_each(IFNULL(")
+		builder.WriteQuoted(eq.Column)
+		//nolint:errcheck,gosec
+		builder.WriteString(", JSON('{}')))) = ")
+		builder.AddVar(builder, len(eq.Value))
+	}
+}
+
+// NegationBuild renders the negative json object comparison.
+// Missing keys and columns evaluate to NULL, so they are treated as a mismatch.
+func (eq JsonObjectEq) NegationBuild(builder clause.Builder) {
+	//nolint:errcheck,gosec
+	builder.WriteString("NOT COALESCE((")
+	eq.Build(builder)
+	//nolint:errcheck,gosec
+	builder.WriteString("), FALSE)")
+}
+
+// jsonValue serializes the dictionary to json with sorted keys.
+func (eq JsonObjectEq) jsonValue() string {
+	values := make(map[string]any, len(eq.Value))
+	for _, value := range eq.Value {
+		values[value.Left.JsonPath] = value.Value
+	}
+	//nolint:errcheck
+	data, _ := json.Marshal(values)
+	return string(data)
+}
+
 // addPrefix adds leading $. to a jsonPath if needed
 func addPrefix(jsonPath string) string {
 	if strings.HasPrefix(jsonPath, "$.") {
@@ -290,6 +451,50 @@ func renderArrayValue(builder clause.Builder, dialector string, rv reflect.Value
 	builder.WriteString("]'")
 }
 
+// renderJsonOperators renders numeric comparisons of the value at a json path. Values, which aren't json numbers,
+// are compared as NULL, so they never match and the database never fails to convert them.
+func renderJsonOperators(builder clause.Builder, json Json, operators []JsonOperator) {
+	//nolint:errcheck,gosec
+	builder.WriteString("(")
+	for i, operator := range operators {
+		if i > 0 {
+			//nolint:errcheck,gosec
+			builder.WriteString(" AND ")
+		}
+		//nolint:errcheck,gosec
+		builder.WriteString("CASE WHEN ")
+		switch json.Dialector {
+		case postgres.Dialector{}.Name():
+			//nolint:errcheck,gosec
+			builder.WriteString("jsonb_typeof((")
+			json.writeColumn(builder)
+			//nolint:errcheck,gosec
+			builder.WriteString("#>")
+			builder.AddVar(builder, json.jsonPathForDialect())
+			//nolint:errcheck,gosec
+			builder.WriteString(")::jsonb) = 'number' THEN (")
+			json.Build(builder)
+			//nolint:errcheck,gosec
+			builder.WriteString(")::numeric")
+		default:
+			//nolint:errcheck,gosec
+			builder.WriteString("json_type(")
+			json.writeColumn(builder)
+			//nolint:errcheck,gosec
+			builder.WriteString(", ")
+			builder.AddVar(builder, json.jsonPathForDialect())
+			//nolint:errcheck,gosec
+			builder.WriteString(") IN ('integer', 'real') THEN ")
+			json.Build(builder)
+		}
+		//nolint:errcheck,gosec
+		builder.WriteString(" END " + operator.Op + " ")
+		builder.AddVar(builder, operator.Value)
+	}
+	//nolint:errcheck,gosec
+	builder.WriteString(")")
+}
+
 func renderDictValue(builder clause.Builder, dialector string, rv reflect.Value) error {
 	//nolint:errcheck,gosec
 	builder.WriteString("'{")
@@ -1,40 +1,264 @@
 package query
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-python/gpython/ast"
 	"github.com/go-python/gpython/parser"
 	"github.com/go-python/gpython/py"
 	"github.com/gofiber/fiber/v2"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/tracing"
 )
 
 const (
 	TableContexts = "contexts"
 )
 
+// Supported types of the contexts json column. Equality of the context values is checked with the containment
+// operator for Postgres `jsonb` column, while `json` column falls back to the text extraction.
+const (
+	JsonColumnTypeJson  = "json"
+	JsonColumnTypeJsonb = "jsonb"
+)
+
+// jsonColumnTypes caches the detected json column types by database, see databaseKey.
+var jsonColumnTypes sync.Map
+
+// defaultContextIDs caches the ids of the default metric context by database, see databaseKey.
+var defaultContextIDs sync.Map
+
+// databaseKey identifies the database of the gorm.DB. gorm.Config is copied by every new session,
+// e.g. by WithContext, while its connection pool is shared by all the sessions of the database.
+func databaseKey(db *gorm.DB) any {
+	return db.Config.ConnPool
+}
+
+// jsonOperators maps the operators of the context dictionary operator objects, e.g. `{"lr": {">": 0.01}}`,
+// to SQL comparison operators.
+var jsonOperators = map[string]string{
+	"<":  "<",
+	"<=": "<=",
+	"==": "=",
+	"!=": "<>",
+	">":  ">",
+	">=": ">=",
+}
+
+// jsonOperatorNames lists the supported operators of the operator objects for error messages.
+var jsonOperatorNames = []string{"<", "<=", "==", "!=", ">", ">="}
+
+// parsedQueryCacheSize is the maximum number of cached parsed queries.
+const parsedQueryCacheSize = 1000
+
+// parsedQueryCache caches parsed queries, so the same query polled by UI isn't parsed again and again.
+// Parsed queries aren't modified after parsing, so they are safe to share.
+var parsedQueryCache = newParsedQueryCache()
+
+// newParsedQueryCache creates the cache of parsed queries.
+func newParsedQueryCache() *lru.Cache[parsedQueryCacheKey, *parsedQuery] {
+	cache, err := lru.New[parsedQueryCacheKey, *parsedQuery](parsedQueryCacheSize)
+	if err != nil {
+		panic(eris.Wrap(err, "error creating lru cache for parsed queries"))
+	}
+	return cache
+}
+
+// parsedQueryCacheKey identifies parsed query by the query string and every setting of the parser,
+// so the cache entries are never shared between dialects or differently configured parsers.
+type parsedQueryCacheKey struct {
+	query             string
+	defaultContains   string
+	defaultExpression string
+	tables            string
+	tzOffset          int
+	dialector         string
+	maxJoins          int
+	paramTrueValues   string
+	paramFalseValues  string
+	jsonColumnType    string
+	defaultContextID  uint
+	timezone          string
+}
+
+// newParsedQueryCacheKey creates the cache key of the query parsed by provided parser.
+// Tables and param values aren't comparable, so they are joined into strings.
+func newParsedQueryCacheKey(qp *QueryParser, q string) parsedQueryCacheKey {
+	tables := make([]string, 0, len(qp.Tables))
+	for alias, table := range qp.Tables {
+		tables = append(tables, alias+"\x00"+table)
+	}
+	sort.Strings(tables)
+	return parsedQueryCacheKey{
+		query:             q,
+		defaultContains:   qp.Default.Contains,
+		defaultExpression: qp.Default.Expression,
+		tables:            strings.Join(tables, "\x00"),
+		tzOffset:          qp.TzOffset,
+		dialector:         qp.Dialector,
+		maxJoins:          qp.MaxJoins,
+		paramTrueValues:   strings.Join(qp.ParamTrueValues, "\x00"),
+		paramFalseValues:  strings.Join(qp.ParamFalseValues, "\x00"),
+		jsonColumnType:    qp.JsonColumnType,
+		defaultContextID:  qp.DefaultContextID,
+		timezone:          qp.Timezone,
+	}
+}
+
+// metricStepQualifierRegexp matches the `step=N` qualifier of metric subscript, e.g. `run.metrics['loss', step=500]`.
+// Python grammar doesn't allow keywords inside of subscript, so the qualifier is rewritten to `step==N` before parsing.
+var metricStepQualifierRegexp = regexp.MustCompile(`(\[[^\[\]]*,\s*step\s*)=(\s*-?\d+\s*[,\]])`)
+
+// betweenOperatorRegexp matches the `between A and B` range operator, where bounds are numbers or calls like
+// `datetime(...)`. Python grammar has no such operator, so it is rewritten to `in between(A, B)` before parsing.
+var betweenOperatorRegexp = regexp.MustCompile(
+	`\s(not\s+)?between\s+(-?[\w.]+(?:\([^()]*\))?)\s+and\s+(-?[\w.]+(?:\([^()]*\))?)`,
+)
+
+// likeOperatorRegexp matches the `like 'pattern'` operator together with string literals, so literals which are not
+// operands of the operator are kept as is. Python grammar has no such operator, so it is rewritten to `in like(...)`.
+var likeOperatorRegexp = regexp.MustCompile(
+	`(\s(not\s+)?like\s+)?('(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*")`,
+)
+
+// numericLiteralRegexp matches decimal integer and float literals, which may contain underscore separators.
+// The parser follows Python 3.4 grammar without separators, so they are removed from valid literals before parsing.
+var numericLiteralRegexp = regexp.MustCompile(`\b\d[\d_]*(?:\.[\d_]*)?(?:[eE][+-]?[\d_]+)?`)
+
+// validNumericLiteralRegexp matches numeric literals which have underscore separators only between digits.
+var validNumericLiteralRegexp = regexp.MustCompile(
+	`^\d+(?:_\d+)*(?:\.(?:\d+(?:_\d+)*)?)?(?:[eE][+-]?\d+(?:_\d+)*)?$`,
+)
+
+// stringLiteralRegexp matches string literals, which are never rewritten.
+var stringLiteralRegexp = regexp.MustCompile(`'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"`)
+
+// DefaultExpression is added to the query, unless the query already accesses the attribute referenced by Contains,
+// e.g. `not run.archived` isn't added to the queries filtering by `run.archived` by themselves.
 type DefaultExpression struct {
 	Contains   string
 	Expression string
 }
 
 type QueryParser struct {
-	Default   DefaultExpression
-	Tables    map[string]string
+	Default DefaultExpression
+	Tables  map[string]string
+	// TzOffset is the client timezone offset in minutes, as returned by the JavaScript getTimezoneOffset,
+	// which is applied to the datetime values without explicit offset unless Timezone is configured.
 	TzOffset  int
 	Dialector string
+	// MaxJoins limits the number of joins, e.g. of metrics, params or tags, a single query may produce.
+	// Zero means no limit.
+	MaxJoins int
+	// ParamTrueValues and ParamFalseValues are the string param values matched when a param is compared
+	// to `True` or `False`, as params are stored as strings. Empty means DefaultParamTrueValues and
+	// DefaultParamFalseValues accordingly.
+	ParamTrueValues  []string
+	ParamFalseValues []string
+	// JsonColumnType is the type of the contexts json column, see GetJsonColumnType.
+	// Empty means JsonColumnTypeJson.
+	JsonColumnType string
+	// DefaultContextID is id of the default (empty) metric context, which the metric subscripts with
+	// the empty context, e.g. `run.metrics['loss', {}]`, refer to, see GetDefaultContextID.
+	DefaultContextID uint
+	// Timezone is the IANA name of the zone applied to the datetime values without explicit offset,
+	// i.e. to date and datetime literals, e.g. `run.created_at > '2024-01-01'`, and to the `datetime(...)`
+	// function. It takes precedence over TzOffset, empty means the TzOffset zone, which is UTC by default.
+	Timezone string
+}
+
+// datetimeLiteralLayouts are the supported layouts of date and datetime literals. Layouts without
+// offset are parsed in the QueryParser location, see parsedQuery.location.
+var datetimeLiteralLayouts = []string{
+	time.RFC3339Nano,
+	time.DateOnly,
+	time.DateTime,
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+}
+
+// errMetricComparedWithoutAttribute is returned when a metric is compared with another metric as is,
+// rather than by its attribute, e.g. `run.metrics['val_acc'] > run.metrics['train_acc']`.
+var errMetricComparedWithoutAttribute = errors.New("metrics have to be compared by their attributes, e.g. `.last`")
+
+// Default string param values matched by `True` and `False` literals. Python clients log booleans as `True`
+// and `False`, while the others usually log them in lower case.
+var (
+	DefaultParamTrueValues  = []string{"true", "True"}
+	DefaultParamFalseValues = []string{"false", "False"}
+)
+
+// GetJsonColumnType detects the type of the contexts json column. Only Postgres supports `jsonb`,
+// so the column type is looked up once per database for Postgres only.
+func GetJsonColumnType(db *gorm.DB) (string, error) {
+	if db.Dialector.Name() != (postgres.Dialector{}).Name() {
+		return JsonColumnTypeJson, nil
+	}
+	if columnType, ok := jsonColumnTypes.Load(databaseKey(db)); ok {
+		return columnType.(string), nil
+	}
+	columnTypes, err := db.Migrator().ColumnTypes(TableContexts)
+	if err != nil {
+		return "", eris.Wrap(err, "error getting contexts column types")
+	}
+	for _, columnType := range columnTypes {
+		if columnType.Name() == "json" {
+			jsonColumnType := strings.ToLower(columnType.DatabaseTypeName())
+			jsonColumnTypes.Store(databaseKey(db), jsonColumnType)
+			return jsonColumnType, nil
+		}
+	}
+	return "", eris.New("contexts json column not found")
+}
+
+// GetDefaultContextID looks up id of the default (empty) metric context, so the search query compares
+// the context id with the bound value instead of comparing the context json of every joined row.
+// Contexts are never deleted, so the id is looked up once per database, as soon as the context exists.
+func GetDefaultContextID(db *gorm.DB) (uint, error) {
+	if id, ok := defaultContextIDs.Load(databaseKey(db)); ok {
+		return id.(uint), nil
+	}
+	var ids []uint
+	if err := db.Table(TableContexts).Where(
+		"CAST(json AS TEXT) = ?", "{}",
+	).Order(
+		"id",
+	).Limit(
+		1,
+	).Pluck("id", &ids).Error; err != nil {
+		return 0, eris.Wrap(err, "error getting default context id")
+	}
+	// without the default context the empty context subscripts just match nothing.
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	defaultContextIDs.Store(databaseKey(db), ids[0])
+	return ids[0], nil
 }
 
 type ParsedQuery interface {
 	Filter(*gorm.DB) *gorm.DB
+	CountOnly(*gorm.DB) *gorm.DB
+	Run(tx *gorm.DB, dest any) error
+	HasJoins() bool
 }
 
 type parsedQuery struct {
@@ -43,6 +267,8 @@ type parsedQuery struct {
 	joinKeys       []string
 	conditions     []clause.Expression
 	metricSelected bool
+	// attributes holds the accessed attributes of the named objects, e.g. `run.archived`.
+	attributes map[string]struct{}
 }
 
 type callable func(args []ast.Expr) (any, error)
@@ -53,13 +279,59 @@ type subscriptSlicer func(index ast.Slicer) (any, error)
 
 type attributeOrSubscript func(v any) (any, error)
 
+// betweenRange represents the bounds of the `between` range operator.
+type betweenRange struct {
+	low  any
+	high any
+}
+
+// likePattern represents the raw pattern of the `like` operator.
+type likePattern string
+
+// contextGetter gives access to the context keys, or to the whole context object when it is compared.
+type contextGetter struct {
+	attributeGetter
+	column clause.Column
+}
+
+// experimentGetter gives access to the experiment attributes, or to the experiment name when it is used as is.
+type experimentGetter struct {
+	attributeGetter
+	column clause.Column
+}
+
+// metricGetter gives access to the metric attributes, or to the metric existence when it is compared to None.
+type metricGetter struct {
+	attributeGetter
+	column clause.Column
+}
+
+// metricCount is the number of values logged for the metric, e.g. `len(run.metrics['loss'])`.
+// It is built as a correlated subquery counting the rows of the metrics table.
+type metricCount clause.Expr
+
+// arithmeticExpression is the arithmetic operation on metric values, e.g. `run.metrics['step'].last % 100`.
+// Operands are kept in the vars, so the metric columns are quoted and the numbers are bound by the builder.
+type arithmeticExpression clause.Expr
+
 type join struct {
 	key   string
 	alias string
 	query string
 	args  []any
+	// columnType is the type of the values of the joined table, which defines how its columns are compared.
+	columnType joinColumnType
 }
 
+// joinColumnType is the type of the values of the joined table.
+type joinColumnType string
+
+// supported list of the joined table value types, the others are compared as is.
+const (
+	joinColumnTypeParam  joinColumnType = "param"
+	joinColumnTypeMetric joinColumnType = "metric"
+)
+
 type SyntaxError struct {
 	Statement string `json:"statement"`
 	Line      int    `json:"line"`
@@ -89,6 +361,51 @@ func (s SyntaxError) Is(target error) bool {
 	return ok
 }
 
+// ExecutionError wraps the database error of the filtered query together with the generated SQL.
+type ExecutionError struct {
+	SQL string `json:"sql"`
+	Err error  `json:"-"`
+}
+
+func (e ExecutionError) Error() string {
+	return fmt.Sprintf("error executing query %q: %s", e.SQL, e.Err)
+}
+
+func (e ExecutionError) Unwrap() error {
+	return e.Err
+}
+
+func (e ExecutionError) Is(target error) bool {
+	_, ok := target.(ExecutionError)
+	return ok
+}
+
+// syntaxErrorOffsetBase converts 0-based column offsets of ast nodes
+// into 1-based SyntaxError offsets, the way Python reports them.
+const syntaxErrorOffsetBase = 1
+
+// newNodeSyntaxError creates SyntaxError pointing at the first character of the node.
+func newNodeSyntaxError(node ast.Expr, err string) SyntaxError {
+	// the parser records the position of the trailer (`.`, `[` or nothing at all for calls) rather than
+	// the position of the whole expression, so the position of the leftmost node is used instead.
+	for {
+		switch n := node.(type) {
+		case *ast.Call:
+			node = n.Func
+		case *ast.Attribute:
+			node = n.Value
+		case *ast.Subscript:
+			node = n.Value
+		default:
+			return SyntaxError{
+				Line:   node.GetLineno(),
+				Offset: node.GetColOffset() + syntaxErrorOffsetBase,
+				Err:    err,
+			}
+		}
+	}
+}
+
 func wrapError(e error, q string) error {
 	switch e := e.(type) {
 	case *py.Exception:
@@ -120,24 +437,87 @@ func wrapError(e error, q string) error {
 	return e
 }
 
+// ParseWithContext parses the query in scope of a tracing span which is a child of the span from the context.
+func (qp *QueryParser) ParseWithContext(ctx context.Context, q string) (ParsedQuery, error) {
+	_, span := tracing.StartSpan(ctx, "QueryParser.Parse")
+	defer span.End()
+	span.SetAttributes(attribute.String("query", q))
+
+	pq, err := qp.Parse(q)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return pq, err
+}
+
+// Parse parses the query, the result is taken from cache when the same query was already parsed
+// by the parser with the same configuration.
 func (qp *QueryParser) Parse(q string) (ParsedQuery, error) {
+	key := newParsedQueryCacheKey(qp, q)
+	if pq, ok := parsedQueryCache.Get(key); ok {
+		return pq, nil
+	}
+	pq, err := qp.parse(q)
+	if err != nil {
+		return nil, err
+	}
+	parsedQueryCache.Add(key, pq)
+	return pq, nil
+}
+
+// parse parses the query without using the cache.
+func (qp *QueryParser) parse(q string) (*parsedQuery, error) {
 	pq := &parsedQuery{
-		qp:    qp,
-		joins: make(map[string]join),
+		qp:         qp,
+		joins:      make(map[string]join),
+		attributes: make(map[string]struct{}),
 	}
 
-	if q == "" {
+	// empty or whitespace-only query applies just the default expression.
+	if strings.TrimSpace(q) == "" {
 		if qp.Default.Expression == "" {
 			return pq, nil
 		}
 		q = qp.Default.Expression
 	}
 
-	if !strings.Contains(q, qp.Default.Contains) {
-		q = fmt.Sprintf("(%s) and (%s)", q, qp.Default.Expression)
+	cond, err := pq.parseExpression(q)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := pq.attributes[qp.Default.Contains]; !ok && qp.Default.Expression != "" {
+		defaultCond, err := pq.parseExpression(qp.Default.Expression)
+		if err != nil {
+			return nil, err
+		}
+		cond = clause.And(cond, defaultCond)
+	}
+
+	if qp.MaxJoins > 0 && len(pq.joinKeys) > qp.MaxJoins {
+		return nil, SyntaxError{
+			Statement: q,
+			Err: fmt.Sprintf(
+				"query is too complex: it requires %d joins, but no more than %d are allowed",
+				len(pq.joinKeys), qp.MaxJoins,
+			),
+		}
 	}
 
-	a, err := parser.ParseString(q, py.EvalMode)
+	pq.conditions = append(pq.conditions, cond)
+
+	return pq, nil
+}
+
+// parseExpression parses the query expression into SQL expression.
+func (pq *parsedQuery) parseExpression(q string) (clause.Expression, error) {
+	a, err := parser.ParseString(
+		rewriteLikeOperator(rewriteBetweenOperator(
+			metricStepQualifierRegexp.ReplaceAllString(rewriteNumericLiterals(q), "$1==$2"),
+		)),
+		py.EvalMode,
+	)
 	if err != nil {
 		return nil, wrapError(err, q)
 	}
@@ -147,6 +527,14 @@ func (qp *QueryParser) Parse(q string) (ParsedQuery, error) {
 		return nil, fmt.Errorf("not a valid Python expression: %#v", a)
 	}
 
+	// the comma is not a decimal separator, so `1,5` is parsed as a tuple of two numbers. A tuple is never
+	// a valid condition, hence it is reported as a syntax error after the comma, instead of being misread.
+	if t, ok := e.Body.(*ast.Tuple); ok && len(t.Elts) > 1 {
+		return nil, wrapError(newNodeSyntaxError(
+			t.Elts[1], "comma separated values are not a valid condition, a period is the only decimal separator",
+		), q)
+	}
+
 	cl, err := pq.parseNode(e.Body)
 	if err != nil {
 		return nil, wrapError(err, q)
@@ -156,10 +544,52 @@ func (qp *QueryParser) Parse(q string) (ParsedQuery, error) {
 	if !ok {
 		return nil, fmt.Errorf("not a valid SQL expression: %#v", cl)
 	}
+	return cond, nil
+}
 
-	pq.conditions = append(pq.conditions, cond)
+// rewriteBetweenOperator rewrites `x between A and B` to `x in between(A, B)` outside of string literals.
+func rewriteBetweenOperator(q string) string {
+	var result strings.Builder
+	last := 0
+	for _, loc := range stringLiteralRegexp.FindAllStringIndex(q, -1) {
+		result.WriteString(betweenOperatorRegexp.ReplaceAllString(q[last:loc[0]], " ${1}in between($2, $3)"))
+		result.WriteString(q[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	result.WriteString(betweenOperatorRegexp.ReplaceAllString(q[last:], " ${1}in between($2, $3)"))
+	return result.String()
+}
 
-	return pq, nil
+// rewriteNumericLiterals removes underscore separators from numeric literals outside of string literals.
+// Literals with misplaced separators, e.g. `1__000` or `1000_`, are kept as is to be reported by the parser.
+func rewriteNumericLiterals(q string) string {
+	rewrite := func(literal string) string {
+		if !validNumericLiteralRegexp.MatchString(literal) {
+			return literal
+		}
+		return strings.ReplaceAll(literal, "_", "")
+	}
+
+	var result strings.Builder
+	last := 0
+	for _, loc := range stringLiteralRegexp.FindAllStringIndex(q, -1) {
+		result.WriteString(numericLiteralRegexp.ReplaceAllStringFunc(q[last:loc[0]], rewrite))
+		result.WriteString(q[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	result.WriteString(numericLiteralRegexp.ReplaceAllStringFunc(q[last:], rewrite))
+	return result.String()
+}
+
+// rewriteLikeOperator rewrites `x like 'pattern'` to `x in like('pattern')` outside of string literals.
+func rewriteLikeOperator(q string) string {
+	return likeOperatorRegexp.ReplaceAllStringFunc(q, func(match string) string {
+		submatches := likeOperatorRegexp.FindStringSubmatch(match)
+		if submatches[1] == "" {
+			return match
+		}
+		return fmt.Sprintf(" %sin like(%s)", submatches[2], submatches[3])
+	})
 }
 
 // AddJoin will append a query join and retain the order added.
@@ -171,37 +601,87 @@ func (pq *parsedQuery) AddJoin(key string, j join) {
 	}
 }
 
+// joinColumnType returns the value type of the joined table, which the column belongs to,
+// or empty type if the column doesn't belong to the joined table.
+func (pq *parsedQuery) joinColumnType(column clause.Column) joinColumnType {
+	for _, j := range pq.joins {
+		if j.alias == column.Table {
+			return j.columnType
+		}
+	}
+	return ""
+}
+
 // Filter will add the appropriate Joins and Where clauses to the tx.
 func (pq *parsedQuery) Filter(tx *gorm.DB) *gorm.DB {
+	_, span := tracing.StartSpan(tx.Statement.Context, "ParsedQuery.Filter")
+	defer span.End()
+
+	// the result of every chained call is kept, so the existing Select, Joins and Where clauses of tx
+	// are preserved and extended, even if tx is a new session which gorm doesn't modify in place.
 	for _, k := range pq.joinKeys {
 		j, ok := pq.joins[k]
 		// prevents panic, but something is wrong if not okay here
 		if ok {
-			tx.Joins(j.query, j.args...)
+			tx = tx.Joins(j.query, j.args...)
 		} else {
 			log.Errorf("error preparing query filter, join key not found in joins map: %s", k)
 		}
 	}
 	if len(pq.conditions) > 0 {
-		tx.Where(clause.And(pq.conditions...))
+		tx = tx.Where(clause.And(pq.conditions...))
 	}
 	return tx
 }
 
+// CountOnly will add the same Joins and Where clauses as Filter does, but select only the number of matched rows,
+// so results don't have to be fetched just to be counted.
+func (pq *parsedQuery) CountOnly(tx *gorm.DB) *gorm.DB {
+	return pq.Filter(tx).Select("COUNT(*)")
+}
+
+// HasJoins makes check that the query joins other tables, so rows of the queried table may be repeated.
+func (pq *parsedQuery) HasJoins() bool {
+	return len(pq.joinKeys) > 0
+}
+
+// Run will filter the tx and find the results into dest, database errors are returned as ExecutionError.
+func (pq *parsedQuery) Run(tx *gorm.DB, dest any) error {
+	tx = pq.Filter(tx)
+	// gorm resets the generated SQL after execution, so the query is executed in a separate session
+	// and the SQL is regenerated in dry run mode only in case of error.
+	if err := tx.Session(&gorm.Session{}).Find(dest).Error; err != nil {
+		stmt := tx.Session(&gorm.Session{DryRun: true}).Find(dest).Statement
+		return ExecutionError{
+			SQL: tx.Dialector.Explain(stmt.SQL.String(), stmt.Vars...),
+			Err: err,
+		}
+	}
+	return nil
+}
+
 func (pq *parsedQuery) parseNode(node ast.Expr) (any, error) {
+	ret, err := pq.parseNodeWithGetters(node)
+	if g, ok := ret.(experimentGetter); ok {
+		return g.column, nil
+	}
+	return ret, err
+}
+
+// parseNodeWithGetters parses the node like parseNode does, but keeps the getters, which otherwise are resolved
+// to the columns, as is, so their attributes can be accessed.
+func (pq *parsedQuery) parseNodeWithGetters(node ast.Expr) (any, error) {
 	ret, err := pq._parseNode(node)
 	if err != nil && !errors.Is(err, SyntaxError{}) {
-		return nil, SyntaxError{
-			Line:   node.GetLineno(),
-			Offset: node.GetColOffset() + 3,
-			Err:    err.Error(),
-		}
+		return nil, newNodeSyntaxError(node, err.Error())
 	}
 	return ret, err
 }
 
 func (pq *parsedQuery) _parseNode(node ast.Expr) (any, error) {
 	switch n := node.(type) {
+	case *ast.BinOp:
+		return pq.parseBinOp(n)
 	case *ast.BoolOp:
 		return pq.parseBoolOp(n)
 	case *ast.Call:
@@ -236,11 +716,22 @@ func (pq *parsedQuery) _parseNode(node ast.Expr) (any, error) {
 func (pq *parsedQuery) parseAttribute(node *ast.Attribute) (any, error) {
 	switch node.Ctx {
 	case ast.Load:
-		parsedNode, err := pq.parseNode(node.Value)
+		parsedNode, err := pq.parseNodeWithGetters(node.Value)
 		if err != nil {
 			return nil, err
 		}
 		attribute := string(node.Attr)
+		if name, ok := node.Value.(*ast.Name); ok {
+			pq.attributes[fmt.Sprintf("%s.%s", name.Id, attribute)] = struct{}{}
+		}
+		if g, ok := parsedNode.(experimentGetter); ok {
+			switch strings.ToLower(attribute) {
+			case "endswith", "startswith":
+				parsedNode = g.column
+			default:
+				return g.attributeGetter(attribute)
+			}
+		}
 		switch strings.ToLower(attribute) {
 		case "endswith":
 			return callable(func(args []ast.Expr) (any, error) {
@@ -303,6 +794,14 @@ func (pq *parsedQuery) parseAttribute(node *ast.Attribute) (any, error) {
 		switch value := parsedNode.(type) {
 		case attributeGetter:
 			return value(attribute)
+		case contextGetter:
+			return value.attributeGetter(attribute)
+		case Json:
+			// nested context keys, e.g. `metric.context.parent.nested`, extend the json path.
+			value.JsonPath = fmt.Sprintf("%s.%s", value.JsonPath, attribute)
+			return value, nil
+		case metricGetter:
+			return value.attributeGetter(attribute)
 		case attributeOrSubscript:
 			return value(attribute)
 		default:
@@ -336,6 +835,67 @@ func (pq *parsedQuery) parseBoolOp(node *ast.BoolOp) (any, error) {
 	}
 }
 
+// parseBinOp parses the arithmetic operation on metric values and numbers, e.g. `run.metrics['loss'].last * 100`.
+// Every operation is enclosed in parentheses, so the SQL keeps the precedence of the parsed expression.
+func (pq *parsedQuery) parseBinOp(node *ast.BinOp) (any, error) {
+	left, err := pq.parseNode(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := pq.parseNode(node.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	l, lok := pq.arithmeticOperand(left)
+	r, rok := pq.arithmeticOperand(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf(
+			"unsupported operands %#v and %#v of arithmetic operator %q (should be numbers or metric values)",
+			left, right, node.Op,
+		)
+	}
+
+	// operations on numbers only are evaluated right away, as the type of bound SQL parameters
+	// couldn't be inferred, when there is no column in the operation.
+	_, isLeftNumber := numericValue(l)
+	_, isRightNumber := numericValue(r)
+	if isLeftNumber && isRightNumber {
+		return evaluateArithmetic(node.Op, l, r)
+	}
+
+	isPostgres := pq.qp.Dialector == postgres.Dialector{}.Name()
+	sql, vars := "", []any{l, r}
+	switch node.Op {
+	case ast.Add:
+		sql = "(? + ?)"
+	case ast.Sub:
+		sql = "(? - ?)"
+	case ast.Mult:
+		sql = "(? * ?)"
+	case ast.Div:
+		// division is always done on floating point numbers, and division by zero gives NULL,
+		// so the comparison with it doesn't match the run.
+		sql = "(CAST(? AS REAL) / NULLIF(CAST(? AS REAL), 0))"
+		if isPostgres {
+			sql = "(CAST(? AS DOUBLE PRECISION) / NULLIF(CAST(? AS DOUBLE PRECISION), 0))"
+		}
+	case ast.Modulo:
+		// the remainder has the sign of the dividend. SQLite `%` operator truncates the operands to integers,
+		// so the remainder is calculated as `a - b * trunc(a / b)` there.
+		sql, vars = "(? - ? * CAST(CAST(? AS REAL) / NULLIF(?, 0) AS INTEGER))", []any{l, r, l, r}
+		if isPostgres {
+			sql, vars = "MOD(CAST(? AS NUMERIC), NULLIF(CAST(? AS NUMERIC), 0))", []any{l, r}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported arithmetic operator %q", node.Op)
+	}
+	return arithmeticExpression{
+		SQL:  sql,
+		Vars: vars,
+	}, nil
+}
+
 func (pq *parsedQuery) parseCall(node *ast.Call) (any, error) {
 	f, err := pq.parseNode(node.Func)
 	if err != nil {
@@ -368,9 +928,40 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 			return nil, err
 		}
 
+		if r, ok := right.(likePattern); ok {
+			exprs[i], err = newSqlLikeComparison(op, left, r)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if r, ok := right.(betweenRange); ok {
+			exprs[i], err = newSqlBetweenComparison(op, left, r)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		_, isLeftArithmetic := left.(arithmeticExpression)
+		_, isRightArithmetic := right.(arithmeticExpression)
+		if isLeftArithmetic || isRightArithmetic {
+			exprs[i], err = pq.newSqlArithmeticComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		switch left := left.(type) {
 		case clause.Column:
-			exprs[i], err = newSqlComparison(op, left, right)
+			// metrics are compared with each other by their attributes,
+			// e.g. `run.metrics['val_acc'].last > run.metrics['train_acc'].last`.
+			if _, ok := right.(metricGetter); ok {
+				return nil, errMetricComparedWithoutAttribute
+			}
+			exprs[i], err = pq.newSqlColumnComparison(op, left, right)
 			if err != nil {
 				return nil, err
 			}
@@ -389,8 +980,45 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 			if err != nil {
 				return nil, err
 			}
+		case contextGetter:
+			exprs[i], err = pq.newSqlJsonObjectComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
+		case metricGetter:
+			exprs[i], err = newSqlMetricExistenceComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
+		case metricCount:
+			exprs[i], err = newSqlMetricCountComparison(op, left, right)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			switch right := right.(type) {
+			case metricCount:
+				// `1000 < len(run.metrics['loss'])` is the same as `len(run.metrics['loss']) > 1000`.
+				o, r, l, err := reverseComparison(op, left, right)
+				if err != nil {
+					return nil, err
+				}
+				exprs[i], err = newSqlMetricCountComparison(o, r, l)
+				if err != nil {
+					return nil, err
+				}
+			case metricGetter:
+				// `None != run.metrics['key']` is the same as `run.metrics['key'] != None`.
+				exprs[i], err = newSqlMetricExistenceComparison(op, right, left)
+				if err != nil {
+					return nil, err
+				}
+			case contextGetter:
+				// `{...} in metric.context` checks that the context contains the dictionary.
+				exprs[i], err = pq.newSqlJsonObjectComparison(op, right, left)
+				if err != nil {
+					return nil, err
+				}
 			case clause.Column:
 				switch op {
 				case ast.In:
@@ -416,7 +1044,7 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 					if err != nil {
 						return nil, err
 					}
-					expression, err := newSqlComparison(o, l, r)
+					expression, err := pq.newSqlColumnComparison(o, l, r)
 					if err != nil {
 						return nil, err
 					}
@@ -443,6 +1071,10 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 						Json:  right,
 					}), nil
 				default:
+					exprs[i], err = pq.newSqlJsonPathComparison(op, right, left)
+					if err != nil {
+						return nil, err
+					}
 				}
 			case clause.Eq:
 				switch left := left.(type) {
@@ -465,25 +1097,95 @@ func (pq *parsedQuery) parseCompare(node *ast.Compare) (any, error) {
 }
 
 // parseDictionary returns []JsonEq conditions derived from the dictionary.
+// Dictionary values could be operator objects, e.g. `{"lr": {">": 0.01}}`, see parseJsonOperators.
 func (pq *parsedQuery) parseDictionary(node *ast.Dict) (any, error) {
 	clauses := make([]JsonEq, len(node.Keys))
 	for i, key := range node.Keys {
+		k, ok := key.(*ast.Str)
+		if !ok {
+			return nil, fmt.Errorf("unsupported dictionary key %q (should be string)", ast.Dump(key))
+		}
+		var value any
+		if operators, ok := node.Values[i].(*ast.Dict); ok {
+			jsonOperators, err := pq.parseJsonOperators(operators)
+			if err != nil {
+				return nil, err
+			}
+			value = jsonOperators
+		} else {
+			parsedValue, err := pq.parseNode(node.Values[i])
+			if err != nil {
+				return nil, err
+			}
+			switch parsedValue.(type) {
+			case string, int, float64, bool, nil:
+			default:
+				return nil, fmt.Errorf("unsupported dictionary value %q", ast.Dump(node.Values[i]))
+			}
+			value = parsedValue
+		}
 		clauses[i] = JsonEq{
 			Left: Json{
 				Column: clause.Column{
 					Table: TableContexts,
 					Name:  "json",
 				},
-				JsonPath:  string(key.(*ast.Str).S),
-				Dialector: pq.qp.Dialector,
+				JsonPath:   string(k.S),
+				Dialector:  pq.qp.Dialector,
+				ColumnType: pq.qp.JsonColumnType,
 			},
-			Value:     string(node.Values[i].(*ast.Str).S),
+			Value:     value,
 			Dialector: pq.qp.Dialector,
 		}
 	}
 	return clauses, nil
 }
 
+// parseJsonOperators returns numeric comparisons derived from the operator object of the dictionary value,
+// e.g. `{">": 0.01, "<=": 0.1}`. Supported operators are listed by jsonOperators.
+func (pq *parsedQuery) parseJsonOperators(node *ast.Dict) ([]JsonOperator, error) {
+	if len(node.Keys) == 0 {
+		return nil, errors.New("operator object has to contain at least one operator")
+	}
+	operators := make([]JsonOperator, len(node.Keys))
+	for i, key := range node.Keys {
+		k, ok := key.(*ast.Str)
+		if !ok {
+			return nil, fmt.Errorf("unsupported operator %q (should be string)", ast.Dump(key))
+		}
+		op, ok := jsonOperators[string(k.S)]
+		if !ok {
+			return nil, fmt.Errorf(
+				"unsupported operator %q, supported operators are: [%s]", k.S, strings.Join(jsonOperatorNames, ", "),
+			)
+		}
+		value, err := pq.parseNode(node.Values[i])
+		if err != nil {
+			return nil, err
+		}
+		switch value.(type) {
+		case int, float64:
+		default:
+			return nil, fmt.Errorf("operator %q supports numeric values only", k.S)
+		}
+		operators[i] = JsonOperator{
+			Op:    op,
+			Value: value,
+		}
+	}
+	return operators, nil
+}
+
+// hasJsonOperators makes check that some of the dictionary values are operator objects.
+func hasJsonOperators(values []JsonEq) bool {
+	for _, value := range values {
+		if _, ok := value.Value.([]JsonOperator); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // parseTuple converts a tuple node to slice of parsed nodes.
 func (pq *parsedQuery) parseTuple(node *ast.Tuple) (any, error) {
 	var err error
@@ -516,9 +1218,9 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 	case ast.Load:
 		switch string(node.Id) {
 		case "run":
-			table, ok := pq.qp.Tables["runs"]
-			if !ok {
-				return nil, errors.New("unsupported name identifier 'run'")
+			table, err := pq.getTable("runs", "run")
+			if err != nil {
+				return nil, err
 			}
 			return attributeGetter(
 				func(attr string) (any, error) {
@@ -544,13 +1246,24 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 							Name:  "name",
 						}, nil
 					case "experiment":
-						e, ok := pq.qp.Tables["experiments"]
-						if !ok {
-							return nil, errors.New("unsupported attribute 'experiment'")
+						e, err := pq.getTable("experiments", "run.experiment")
+						if err != nil {
+							return nil, err
 						}
-						return clause.Column{
+						column := clause.Column{
 							Table: e,
 							Name:  "name",
+						}
+						return experimentGetter{
+							attributeGetter: func(attr string) (any, error) {
+								switch attr {
+								case "name":
+									return column, nil
+								default:
+									return nil, fmt.Errorf("unsupported experiment attribute %q", attr)
+								}
+							},
+							column: column,
 						}, nil
 					case "archived":
 						return clause.Eq{
@@ -574,18 +1287,9 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 							Raw:  true,
 						}, nil
 					case "metrics":
-						return subscriptSlicer(func(s ast.Slicer) (any, error) {
-							switch s := s.(type) {
-							case *ast.Index:
-								v, err := pq.parseNode(s.Value)
-								if err != nil {
-									return nil, err
-								}
-								return pq.metricSubscriptSlicer(v)
-							default:
-								return nil, fmt.Errorf("unsupported slicer %q", ast.Dump(s))
-							}
-						}), nil
+						return pq.metricsSubscript(""), nil
+					case "system_metrics":
+						return pq.metricsSubscript(models.MetricKindSystem), nil
 					case "tags":
 						// handle dot (attribute) or dict (subscriptSlicer) syntax
 						return attributeOrSubscript(func(v any) (any, error) {
@@ -602,25 +1306,62 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 								return nil, fmt.Errorf("unsupported slicer or attribute %v", v)
 							}
 						}), nil
-					default:
-						joinKey := fmt.Sprintf("params:%s", attr)
-						j, ok := pq.joins[joinKey]
-						if !ok {
-							alias := fmt.Sprintf("params_%d", len(pq.joins))
-							j = join{
-								alias: alias,
-								query: fmt.Sprintf(
-									"LEFT JOIN params %s ON %s.run_uuid = %s.run_uuid AND %s.key = ?",
-									alias, table, alias, alias,
-								),
-								args: []any{attr},
-							}
-							pq.AddJoin(joinKey, j)
-						}
+					case "params":
+						// handle dot (attribute) or dict (subscriptSlicer) syntax
+						return attributeOrSubscript(func(v any) (any, error) {
+							switch v := v.(type) {
+							case string:
+								return pq.paramJoin(v, table), nil
+							case *ast.Index:
+								val, err := pq.parseNode(v.Value)
+								if err != nil {
+									return nil, err
+								}
+								key, ok := val.(string)
+								if !ok {
+									return nil, fmt.Errorf("unsupported index value type %T", val)
+								}
+								return pq.paramJoin(key, table), nil
+							default:
+								return nil, fmt.Errorf("unsupported slicer or attribute %v", v)
+							}
+						}), nil
+					default:
+						return pq.paramJoin(attr, table), nil
+					}
+				},
+			), nil
+		case "metric":
+			table, err := pq.getTable("metrics", "metric")
+			if err != nil {
+				return nil, err
+			}
+			return attributeGetter(
+				func(attr string) (any, error) {
+					switch attr {
+					case "name":
 						return clause.Column{
-							Table: j.alias,
-							Name:  "value",
+							Table: table,
+							Name:  "key",
 						}, nil
+					case "context":
+						column := clause.Column{
+							Table: TableContexts,
+							Name:  "json",
+						}
+						return contextGetter{
+							attributeGetter: func(contextKey string) (any, error) {
+								return Json{
+									Column:     column,
+									JsonPath:   contextKey,
+									Dialector:  pq.qp.Dialector,
+									ColumnType: pq.qp.JsonColumnType,
+								}, nil
+							},
+							column: column,
+						}, nil
+					default:
+						return nil, fmt.Errorf("unsupported metric attribute %q", attr)
 					}
 				},
 			), nil
@@ -676,6 +1417,58 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 					}
 				},
 			), nil
+		case "between":
+			return callable(
+				func(args []ast.Expr) (any, error) {
+					if len(args) != 2 {
+						return nil, fmt.Errorf("between requires lower and upper bounds, got %d arguments", len(args))
+					}
+					low, err := pq.parseNode(args[0])
+					if err != nil {
+						return nil, err
+					}
+					high, err := pq.parseNode(args[1])
+					if err != nil {
+						return nil, err
+					}
+					lowValue, ok := numericValue(low)
+					if !ok {
+						return nil, fmt.Errorf("unsupported lower bound of between: %#v", low)
+					}
+					highValue, ok := numericValue(high)
+					if !ok {
+						return nil, fmt.Errorf("unsupported upper bound of between: %#v", high)
+					}
+					if lowValue > highValue {
+						return nil, fmt.Errorf(
+							"invalid range of between: lower bound %v is greater than upper bound %v", low, high,
+						)
+					}
+					return betweenRange{low: low, high: high}, nil
+				},
+			), nil
+		case "like":
+			return callable(
+				func(args []ast.Expr) (any, error) {
+					if len(args) != 1 {
+						return nil, fmt.Errorf("like requires exactly one pattern, got %d arguments", len(args))
+					}
+					arg, ok := args[0].(*ast.Str)
+					if !ok {
+						return nil, errors.New("unsupported pattern of like. has to be `string` only")
+					}
+					return likePattern(arg.S), nil
+				},
+			), nil
+		case "len":
+			return callable(
+				func(args []ast.Expr) (any, error) {
+					if len(args) != 1 {
+						return nil, fmt.Errorf("len function supports exactly 1 argument, got %d arguments", len(args))
+					}
+					return pq.parseMetricCount(args[0])
+				},
+			), nil
 		case "datetime":
 			return callable(
 				func(args []ast.Expr) (any, error) {
@@ -694,6 +1487,10 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 						}
 						intArgs[i] = n
 					}
+					location, err := pq.location()
+					if err != nil {
+						return nil, err
+					}
 					return time.Date(
 						intArgs[0],
 						time.Month(intArgs[1]),
@@ -702,14 +1499,14 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 						intArgs[4],
 						intArgs[5],
 						intArgs[6]*1000,
-						time.FixedZone("custom", -pq.qp.TzOffset*60),
+						location,
 					).UnixMilli(), nil
 				},
 			), nil
 		case "images":
-			table, ok := pq.qp.Tables["runs"]
-			if !ok {
-				return nil, errors.New("unsupported name identifier 'runs'")
+			table, err := pq.getTable("runs", "images")
+			if err != nil {
+				return nil, err
 			}
 			return attributeGetter(
 				func(attr string) (any, error) {
@@ -745,16 +1542,56 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 	}
 }
 
-func (pq *parsedQuery) metricSubscriptSlicer(v any) (any, error) {
-	table, ok := pq.qp.Tables["runs"]
-	if !ok {
-		return nil, errors.New("unsupported table name 'runs'")
+// getTable returns the physical name of the table mapped to the provided logical name.
+// It fails with a descriptive error when the query references a table the parser isn't configured for.
+func (pq *parsedQuery) getTable(name, identifier string) (string, error) {
+	if table, ok := pq.qp.Tables[name]; ok {
+		return table, nil
+	}
+	tables := make([]string, 0, len(pq.qp.Tables))
+	for table := range pq.qp.Tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return "", fmt.Errorf(
+		"unknown table %q referenced by %q, supported tables are: [%s]", name, identifier, strings.Join(tables, ", "),
+	)
+}
+
+// metricsSubscript handles the `run.metrics[...]` subscript. Metrics are limited to the provided kind,
+// unless it is empty, e.g. `run.system_metrics[...]` only selects metrics of the system kind.
+func (pq *parsedQuery) metricsSubscript(kind models.MetricKind) subscriptSlicer {
+	return func(s ast.Slicer) (any, error) {
+		switch s := s.(type) {
+		case *ast.Index:
+			index, step, err := parseMetricStepQualifier(s.Value)
+			if err != nil {
+				return nil, err
+			}
+			v, err := pq.parseNode(index)
+			if err != nil {
+				return nil, err
+			}
+			if step != nil {
+				return pq.metricStepSubscriptSlicer(v, *step, kind)
+			}
+			return pq.metricSubscriptSlicer(v, kind)
+		default:
+			return nil, fmt.Errorf("unsupported slicer %q", ast.Dump(s))
+		}
+	}
+}
+
+func (pq *parsedQuery) metricSubscriptSlicer(v any, kind models.MetricKind) (any, error) {
+	table, err := pq.getTable("runs", "run.metrics")
+	if err != nil {
+		return nil, err
 	}
 	switch v := v.(type) {
 	case string:
 		// case of metric key
 		pq.metricSelected = true
-		latestMetricJoin := pq.latestMetricsKeyJoin(v, table)
+		latestMetricJoin := pq.latestMetricsKeyJoin(v, table, kind, nil, false)
 		return metricAttributeGetter(latestMetricJoin.alias)
 	case []any:
 		// case of subscript tuple (string and context dictionary)
@@ -770,14 +1607,207 @@ func (pq *parsedQuery) metricSubscriptSlicer(v any) (any, error) {
 			return nil, fmt.Errorf("unsupported index value type %T (should be []JsonEq at 1)", v)
 		}
 		pq.metricSelected = true
-		latestMetricJoin := pq.latestMetricsKeyJoin(metricKey, table)
-		pq.latestMetricsContextJoin(metricContextExpression, latestMetricJoin)
+		// the empty context `{}` refers to the default context, while the metric key alone matches any context.
+		isDefaultContext := len(metricContextExpression) == 0
+		latestMetricJoin := pq.latestMetricsKeyJoin(metricKey, table, kind, metricContextExpression, isDefaultContext)
+		if !isDefaultContext {
+			pq.latestMetricsContextJoin(metricContextExpression, latestMetricJoin)
+		}
 		return metricAttributeGetter(latestMetricJoin.alias)
 	default:
 		return nil, fmt.Errorf("unsupported index value type %T", v)
 	}
 }
 
+// parseMetricCount parses the argument of `len` function, which has to be a metric subscript,
+// e.g. `run.metrics['loss']` or `run.system_metrics['gpu', {"device": 0}]`.
+func (pq *parsedQuery) parseMetricCount(node ast.Expr) (metricCount, error) {
+	errUnsupportedArgument := fmt.Errorf(
+		"unsupported argument of len function %q (should be metric, e.g. run.metrics['loss'])", ast.Dump(node),
+	)
+	subscript, ok := node.(*ast.Subscript)
+	if !ok {
+		return metricCount{}, errUnsupportedArgument
+	}
+	attribute, ok := subscript.Value.(*ast.Attribute)
+	if !ok {
+		return metricCount{}, errUnsupportedArgument
+	}
+	if name, ok := attribute.Value.(*ast.Name); !ok || name.Id != "run" {
+		return metricCount{}, errUnsupportedArgument
+	}
+	var kind models.MetricKind
+	switch attribute.Attr {
+	case "metrics":
+	case "system_metrics":
+		kind = models.MetricKindSystem
+	default:
+		return metricCount{}, errUnsupportedArgument
+	}
+	index, ok := subscript.Slice.(*ast.Index)
+	if !ok {
+		return metricCount{}, fmt.Errorf("unsupported slicer %q", ast.Dump(subscript.Slice))
+	}
+	v, err := pq.parseNode(index.Value)
+	if err != nil {
+		return metricCount{}, err
+	}
+
+	var metricKey string
+	var metricContextExpression []JsonEq
+	var isDefaultContext bool
+	switch v := v.(type) {
+	case string:
+		// case of metric key
+		metricKey = v
+	case []any:
+		// case of subscript tuple (string and context dictionary)
+		if len(v) != 2 {
+			return metricCount{}, fmt.Errorf("unsupported tuple length %d (should be 2)", len(v))
+		}
+		if metricKey, ok = v[0].(string); !ok {
+			return metricCount{}, fmt.Errorf("unsupported tuple value type %T (should be string at 0)", v)
+		}
+		if metricContextExpression, ok = v[1].([]JsonEq); !ok {
+			return metricCount{}, fmt.Errorf("unsupported index value type %T (should be []JsonEq at 1)", v)
+		}
+		isDefaultContext = len(metricContextExpression) == 0
+	default:
+		return metricCount{}, fmt.Errorf("unsupported index value type %T", v)
+	}
+
+	table, err := pq.getTable("runs", "len")
+	if err != nil {
+		return metricCount{}, err
+	}
+	query := "SELECT COUNT(*) FROM metrics metric_counts"
+	conditions := fmt.Sprintf("metric_counts.run_uuid = %s.run_uuid AND metric_counts.key = ?", table)
+	vars := []any{metricKey}
+	if kind != "" {
+		conditions = fmt.Sprintf("%s AND metric_counts.kind = ?", conditions)
+		vars = append(vars, kind)
+	}
+	if isDefaultContext {
+		conditions = fmt.Sprintf("%s AND metric_counts.context_id = ?", conditions)
+		vars = append(vars, pq.qp.DefaultContextID)
+	} else if len(metricContextExpression) > 0 {
+		query = fmt.Sprintf(
+			"%s INNER JOIN contexts metric_count_contexts ON metric_counts.context_id = metric_count_contexts.id",
+			query,
+		)
+		clauses := make([]clause.Expression, len(metricContextExpression))
+		for idx := range metricContextExpression {
+			metricContextExpression[idx].Left.Table = "metric_count_contexts"
+			clauses[idx] = metricContextExpression[idx]
+		}
+		conditions = fmt.Sprintf("%s AND ?", conditions)
+		vars = append(vars, clause.And(clauses...))
+	}
+	return metricCount{
+		SQL:  fmt.Sprintf("(%s WHERE %s)", query, conditions),
+		Vars: vars,
+	}, nil
+}
+
+// parseMetricStepQualifier extracts the `step==N` qualifier from the metric subscript tuple.
+// returns the subscript without the qualifier and the step, when the qualifier was provided.
+func parseMetricStepQualifier(node ast.Expr) (ast.Expr, *int, error) {
+	tuple, ok := node.(*ast.Tuple)
+	if !ok {
+		return node, nil, nil
+	}
+	var step *int
+	elts := make([]ast.Expr, 0, len(tuple.Elts))
+	for _, elt := range tuple.Elts {
+		compare, ok := elt.(*ast.Compare)
+		if !ok {
+			elts = append(elts, elt)
+			continue
+		}
+		name, ok := compare.Left.(*ast.Name)
+		if !ok || name.Id != "step" {
+			elts = append(elts, elt)
+			continue
+		}
+		if len(compare.Ops) != 1 || compare.Ops[0] != ast.Eq {
+			return nil, nil, errors.New("unsupported step qualifier (should be step=N)")
+		}
+		if step != nil {
+			return nil, nil, errors.New("step qualifier provided more than once")
+		}
+		value, err := parseStepQualifierValue(compare.Comparators[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		step = &value
+	}
+	if step == nil {
+		return node, nil, nil
+	}
+	if len(elts) == 1 {
+		return elts[0], step, nil
+	}
+	return &ast.Tuple{ExprBase: tuple.ExprBase, Elts: elts, Ctx: tuple.Ctx}, step, nil
+}
+
+// parseStepQualifierValue converts the value of step qualifier to int.
+func parseStepQualifierValue(node ast.Expr) (int, error) {
+	sign := 1
+	if op, ok := node.(*ast.UnaryOp); ok && op.Op == ast.USub {
+		sign, node = -1, op.Operand
+	}
+	num, ok := node.(*ast.Num)
+	if !ok {
+		return 0, fmt.Errorf("unsupported step qualifier value %q (should be integer)", ast.Dump(node))
+	}
+	value, ok := num.N.(py.Int)
+	if !ok {
+		return 0, fmt.Errorf("unsupported step qualifier value type %q (should be integer)", num.N.Type())
+	}
+	step, err := value.GoInt()
+	if err != nil {
+		return 0, err
+	}
+	return sign * step, nil
+}
+
+// metricStepSubscriptSlicer joins the metrics table to get the metric value at the provided step.
+func (pq *parsedQuery) metricStepSubscriptSlicer(v any, step int, kind models.MetricKind) (any, error) {
+	table, err := pq.getTable("runs", "run.metrics")
+	if err != nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case string:
+		// case of metric key
+		pq.metricSelected = true
+		metricJoin := pq.metricsKeyStepJoin(v, step, table, kind, nil, false)
+		return metricStepAttributeGetter(metricJoin.alias)
+	case []any:
+		// case of subscript tuple (string and context dictionary)
+		if len(v) != 2 {
+			return nil, fmt.Errorf("unsupported tuple length %d (should be 2)", len(v))
+		}
+		metricKey, ok := v[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported tuple value type %T (should be string at 0)", v)
+		}
+		metricContextExpression, ok := v[1].([]JsonEq)
+		if !ok {
+			return nil, fmt.Errorf("unsupported index value type %T (should be []JsonEq at 1)", v)
+		}
+		pq.metricSelected = true
+		isDefaultContext := len(metricContextExpression) == 0
+		metricJoin := pq.metricsKeyStepJoin(metricKey, step, table, kind, metricContextExpression, isDefaultContext)
+		if !isDefaultContext {
+			pq.latestMetricsContextJoin(metricContextExpression, metricJoin)
+		}
+		return metricStepAttributeGetter(metricJoin.alias)
+	default:
+		return nil, fmt.Errorf("unsupported index value type %T", v)
+	}
+}
+
 // tagsSubscriptSlicer will join the tags table using the index key.
 func (pq *parsedQuery) tagsSubscriptSlicer(key any, table string) (any, error) {
 	switch v := key.(type) {
@@ -810,9 +1840,37 @@ func (pq *parsedQuery) tagJoin(key string, table string) (any, error) {
 	}, nil
 }
 
-// latestMetricsKeyJoin joins the latest_metrics table by run_uuid and metric key, returning the join struct.
-func (pq *parsedQuery) latestMetricsKeyJoin(key, table string) join {
-	joinsKey := fmt.Sprintf("metrics:%s", key)
+// paramJoin joins the params table using provided key and returns the string value column.
+// Numeric values are compared to the int and float value columns instead, see newSqlColumnComparison.
+func (pq *parsedQuery) paramJoin(key string, table string) clause.Column {
+	joinKey := fmt.Sprintf("params:%s", key)
+	j, ok := pq.joins[joinKey]
+	if !ok {
+		alias := fmt.Sprintf("params_%d", len(pq.joins))
+		j = join{
+			alias: alias,
+			query: fmt.Sprintf(
+				"LEFT JOIN params %s ON %s.run_uuid = %s.run_uuid AND %s.key = ?",
+				alias, table, alias, alias,
+			),
+			args:       []any{key},
+			columnType: joinColumnTypeParam,
+		}
+		pq.AddJoin(joinKey, j)
+	}
+	return clause.Column{
+		Table: j.alias,
+		Name:  "value_str",
+	}
+}
+
+// latestMetricsKeyJoin joins the latest_metrics table by run_uuid, metric key and kind, when provided,
+// returning the join struct. Every context gets its own join, the default context one is restricted
+// to the default context id, while the join without context matches the metric in any context.
+func (pq *parsedQuery) latestMetricsKeyJoin(
+	key, table string, kind models.MetricKind, context []JsonEq, isDefaultContext bool,
+) join {
+	joinsKey := metricJoinsKey(fmt.Sprintf("metrics:%s", key), kind, context, isDefaultContext)
 	j, ok := pq.joins[joinsKey]
 	if !ok {
 		alias := fmt.Sprintf("metrics_%d", len(pq.joins))
@@ -822,8 +1880,65 @@ func (pq *parsedQuery) latestMetricsKeyJoin(key, table string) join {
 				"LEFT JOIN latest_metrics %s ON %s.run_uuid = %s.run_uuid AND %s.key = ?",
 				alias, table, alias, alias,
 			),
-			args: []any{key},
-			key:  joinsKey,
+			args:       []any{key},
+			key:        joinsKey,
+			columnType: joinColumnTypeMetric,
+		}
+		if kind != "" {
+			j.query = fmt.Sprintf("%s AND %s.kind = ?", j.query, alias)
+			j.args = append(j.args, kind)
+		}
+		if isDefaultContext {
+			j.query = fmt.Sprintf("%s AND %s.context_id = ?", j.query, alias)
+			j.args = append(j.args, pq.qp.DefaultContextID)
+		}
+		pq.AddJoin(joinsKey, j)
+	}
+	return j
+}
+
+// metricJoinsKey builds the key of the metric join, which is unique for the metric kind and context.
+func metricJoinsKey(prefix string, kind models.MetricKind, context []JsonEq, isDefaultContext bool) string {
+	joinsKey := prefix
+	if kind != "" {
+		joinsKey = fmt.Sprintf("%s:kind:%s", joinsKey, kind)
+	}
+	if isDefaultContext {
+		joinsKey = fmt.Sprintf("%s:context:default", joinsKey)
+	}
+	for _, exp := range context {
+		joinsKey = fmt.Sprintf("%s:context:%s=%v", joinsKey, exp.Left.JsonPath, exp.Value)
+	}
+	return joinsKey
+}
+
+// metricsKeyStepJoin joins the metrics table by run_uuid, metric key, step and kind, when provided,
+// returning the join struct. Like latestMetricsKeyJoin, the default context join is restricted
+// to the default context id.
+func (pq *parsedQuery) metricsKeyStepJoin(
+	key string, step int, table string, kind models.MetricKind, context []JsonEq, isDefaultContext bool,
+) join {
+	joinsKey := metricJoinsKey(fmt.Sprintf("metrics:%s:step:%d", key, step), kind, context, isDefaultContext)
+	j, ok := pq.joins[joinsKey]
+	if !ok {
+		alias := fmt.Sprintf("metrics_%d", len(pq.joins))
+		j = join{
+			alias: alias,
+			query: fmt.Sprintf(
+				"LEFT JOIN metrics %s ON %s.run_uuid = %s.run_uuid AND %s.key = ? AND %s.step = ?",
+				alias, table, alias, alias, alias,
+			),
+			args:       []any{key, step},
+			key:        joinsKey,
+			columnType: joinColumnTypeMetric,
+		}
+		if kind != "" {
+			j.query = fmt.Sprintf("%s AND %s.kind = ?", j.query, alias)
+			j.args = append(j.args, kind)
+		}
+		if isDefaultContext {
+			j.query = fmt.Sprintf("%s AND %s.context_id = ?", j.query, alias)
+			j.args = append(j.args, pq.qp.DefaultContextID)
 		}
 		pq.AddJoin(joinsKey, j)
 	}
@@ -842,7 +1957,8 @@ func (pq *parsedQuery) latestMetricsContextJoin(exps []JsonEq, latestMetricsJoin
 				"LEFT JOIN latest_metrics %s USING(run_uuid)",
 				alias,
 			),
-			key: alias,
+			key:        alias,
+			columnType: joinColumnTypeMetric,
 		}
 		pq.AddJoin(alias, latestMetricsJoin)
 	}
@@ -876,7 +1992,7 @@ func (pq *parsedQuery) latestMetricsContextJoin(exps []JsonEq, latestMetricsJoin
 }
 
 func metricAttributeGetter(table string) (any, error) {
-	return attributeGetter(func(attr string) (any, error) {
+	return newMetricGetter(table, func(attr string) (any, error) {
 		var name string
 		switch attr {
 		case "last":
@@ -895,6 +2011,37 @@ func metricAttributeGetter(table string) (any, error) {
 	}), nil
 }
 
+// metricStepAttributeGetter returns attributes of the metric value logged at a specific step.
+func metricStepAttributeGetter(table string) (any, error) {
+	return newMetricGetter(table, func(attr string) (any, error) {
+		var name string
+		switch attr {
+		case "last":
+			name = "value"
+		case "last_step":
+			name = "iter"
+		default:
+			return nil, fmt.Errorf("unsupported metrics attribute %q", attr)
+		}
+		return clause.Column{
+			Table: table,
+			Name:  name,
+		}, nil
+	}), nil
+}
+
+// newMetricGetter creates metricGetter for the joined metric table.
+// The joined `run_uuid` column is NULL when the run has no such metric.
+func newMetricGetter(table string, getter attributeGetter) metricGetter {
+	return metricGetter{
+		attributeGetter: getter,
+		column: clause.Column{
+			Table: table,
+			Name:  "run_uuid",
+		},
+	}
+}
+
 func (pq *parsedQuery) parseNameConstant(node *ast.NameConstant) (any, error) {
 	switch node.Value.Type() {
 	case py.NoneTypeType:
@@ -959,7 +2106,18 @@ func (pq *parsedQuery) parseUnaryOp(node *ast.UnaryOp) (any, error) {
 	case ast.Not:
 		switch e := e.(type) {
 		case clause.Expression:
-			return clause.Not(e), nil
+			// clause.Not unwraps AND conditions and negates each of them separately,
+			// which turns `not (a and b)` into `NOT a AND NOT b`, so only a single
+			// condition is unwrapped here and anything else is negated as a whole.
+			if and, ok := e.(clause.AndConditions); ok && len(and.Exprs) == 1 {
+				e = and.Exprs[0]
+			}
+			// negated comparisons are kept as they are written, e.g. `not run.duration > 1`
+			// becomes `NOT (duration > $1)` rather than `duration <= $1`.
+			if _, ok := node.Operand.(*ast.Compare); ok && isColumnComparison(e) {
+				return Not{Expression: e}, nil
+			}
+			return negativeClause(e), nil
 		default:
 			return nil, fmt.Errorf("unsupported type %T for unary operation %q", e, node.Op)
 		}
@@ -985,7 +2143,108 @@ func newSqlBoolComparison(op ast.CmpOp, left clause.Eq, right bool) (clause.Expr
 	}
 }
 
-func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expression, error) {
+// newSqlColumnComparison compares the column with the value. Booleans are logged as string params,
+// so a param compared to a boolean literal is compared to the configured string values instead,
+// while a param compared to a number is compared to the int or float param value. A param compared
+// to None checks whether the param is logged at all, since the string value of numeric params is NULL.
+func (pq *parsedQuery) newSqlColumnComparison(
+	op ast.CmpOp, left clause.Column, right any,
+) (clause.Expression, error) {
+	if pq.joinColumnType(left) == joinColumnTypeParam {
+		switch value := right.(type) {
+		case nil:
+			return newSqlComparison(op, clause.Column{Table: left.Table, Name: "key"}, nil)
+		case bool:
+			return pq.newSqlParamBoolComparison(op, left, value)
+		case int, float64:
+			return newSqlComparison(op, clause.Expr{
+				SQL: "COALESCE(?, ?)",
+				Vars: []any{
+					clause.Column{Table: left.Table, Name: "value_int"},
+					clause.Column{Table: left.Table, Name: "value_float"},
+				},
+			}, value)
+		}
+	}
+	if value, ok := right.(string); ok && pq.isDatetimeColumn(left) {
+		millis, err := pq.parseDatetimeLiteral(value)
+		if err != nil {
+			return nil, err
+		}
+		right = millis
+	}
+	return newSqlComparison(op, left, right)
+}
+
+// isDatetimeColumn checks whether the column holds the datetime as epoch millis.
+func (pq *parsedQuery) isDatetimeColumn(column clause.Column) bool {
+	return column.Table == pq.qp.Tables["runs"] && (column.Name == "start_time" || column.Name == "end_time")
+}
+
+// location returns the zone of the datetime values without explicit offset. The configured Timezone
+// takes precedence over the client TzOffset.
+func (pq *parsedQuery) location() (*time.Location, error) {
+	if pq.qp.Timezone == "" {
+		return time.FixedZone("custom", -pq.qp.TzOffset*60), nil
+	}
+	location, err := time.LoadLocation(pq.qp.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported timezone %q: %w", pq.qp.Timezone, err)
+	}
+	return location, nil
+}
+
+// parseDatetimeLiteral converts date or datetime literal, e.g. '2024-01-01' or '2024-01-01 12:00:00',
+// to epoch millis. Literals without explicit offset are taken in the QueryParser location.
+func (pq *parsedQuery) parseDatetimeLiteral(value string) (int64, error) {
+	location, err := pq.location()
+	if err != nil {
+		return 0, err
+	}
+	for _, layout := range datetimeLiteralLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t.UnixMilli(), nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported datetime literal %q, expected e.g. '2024-01-01' or '2024-01-01 12:00:00'", value)
+}
+
+// newSqlParamBoolComparison compares the param value column with the string values matching the boolean.
+func (pq *parsedQuery) newSqlParamBoolComparison(
+	op ast.CmpOp, left clause.Column, right bool,
+) (clause.Expression, error) {
+	values := pq.qp.ParamFalseValues
+	if len(values) == 0 {
+		values = DefaultParamFalseValues
+	}
+	if right {
+		values = pq.qp.ParamTrueValues
+		if len(values) == 0 {
+			values = DefaultParamTrueValues
+		}
+	}
+	in := clause.IN{
+		Column: left,
+		Values: make([]any, len(values)),
+	}
+	for i, value := range values {
+		in.Values[i] = value
+	}
+	switch op {
+	case ast.Eq, ast.Is:
+		return in, nil
+	case ast.NotEq, ast.IsNot:
+		return negativeClause(in), nil
+	default:
+		return nil, fmt.Errorf("comparison operation incompatible with bool %q", op)
+	}
+}
+
+func newSqlComparison(op ast.CmpOp, left, right any) (clause.Expression, error) {
+	// None is only compared using `IS NULL` and `IS NOT NULL`, which are built by clause.Eq and clause.Neq.
+	if right == nil && op != ast.Eq && op != ast.Is && op != ast.NotEq && op != ast.IsNot {
+		return nil, fmt.Errorf("comparison operation incompatible with None %q", op)
+	}
 	switch op {
 	case ast.Eq, ast.Is:
 		return clause.Eq{
@@ -1022,6 +2281,9 @@ func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expre
 		if !ok {
 			return nil, fmt.Errorf("right value in \"in\" comparison is not a list: %#v", right)
 		}
+		if err := validateListValues(r); err != nil {
+			return nil, err
+		}
 		return clause.IN{
 			Column: left,
 			Values: r,
@@ -1031,6 +2293,9 @@ func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expre
 		if !ok {
 			return nil, fmt.Errorf("right value in \"not in\" comparison is not a list: %#v", right)
 		}
+		if err := validateListValues(r); err != nil {
+			return nil, err
+		}
 		return negativeClause(clause.IN{
 			Column: left,
 			Values: r,
@@ -1040,15 +2305,219 @@ func newSqlComparison(op ast.CmpOp, left clause.Column, right any) (clause.Expre
 	}
 }
 
+// newSqlBetweenComparison creates `column >= low AND column <= high` comparison for the `between` operator.
+func newSqlBetweenComparison(op ast.CmpOp, left any, right betweenRange) (clause.Expression, error) {
+	column, ok := left.(clause.Column)
+	if !ok {
+		return nil, errors.New("between is supported only for numeric and datetime fields")
+	}
+	expression := clause.And(
+		clause.Gte{Column: column, Value: right.low},
+		clause.Lte{Column: column, Value: right.high},
+	)
+	switch op {
+	case ast.In:
+		return expression, nil
+	case ast.NotIn:
+		return negativeClause(expression), nil
+	default:
+		return nil, fmt.Errorf("unsupported comparison operator %q for between", op)
+	}
+}
+
+// newSqlLikeComparison creates `LIKE` comparison for the `like` operator, the pattern is passed through as is.
+func newSqlLikeComparison(op ast.CmpOp, left any, right likePattern) (clause.Expression, error) {
+	var expression clause.Expression
+	switch left := left.(type) {
+	case clause.Column:
+		expression = clause.Like{Column: left, Value: string(right)}
+	case Json:
+		expression = JsonLike{Json: left, Value: string(right)}
+	default:
+		return nil, errors.New("like is supported only for string fields")
+	}
+	switch op {
+	case ast.In:
+		return expression, nil
+	case ast.NotIn:
+		return negativeClause(expression), nil
+	default:
+		return nil, fmt.Errorf("unsupported comparison operator %q for like", op)
+	}
+}
+
+// newSqlMetricExistenceComparison creates comparison checking whether the run has the metric or not.
+// Only comparison to None is supported, e.g. `run.metrics['key'] != None`.
+func newSqlMetricExistenceComparison(op ast.CmpOp, left metricGetter, right any) (clause.Expression, error) {
+	if _, ok := right.(metricGetter); ok {
+		return nil, errMetricComparedWithoutAttribute
+	}
+	if right != nil {
+		return nil, fmt.Errorf("unsupported metric comparison value %#v (should be None)", right)
+	}
+	switch op {
+	case ast.Eq, ast.Is, ast.NotEq, ast.IsNot:
+		return newSqlComparison(op, left.column, nil)
+	default:
+		return nil, fmt.Errorf("unsupported comparison operator %q for metric existence", op)
+	}
+}
+
+// newSqlMetricCountComparison compares the number of metric values with the number,
+// e.g. `len(run.metrics['loss']) > 1000`.
+func newSqlMetricCountComparison(op ast.CmpOp, left metricCount, right any) (clause.Expression, error) {
+	if _, ok := right.(metricCount); ok {
+		return nil, errors.New("metric counts could be compared with numbers only")
+	}
+	if _, ok := numericValue(right); !ok {
+		return nil, fmt.Errorf("unsupported metric count comparison value %#v (should be number)", right)
+	}
+	return newSqlComparison(op, clause.Expr(left), right)
+}
+
+// newSqlArithmeticComparison compares the arithmetic expression with a number, a metric value,
+// or another arithmetic expression, e.g. `run.metrics['step'].last % 100 == 0`.
+func (pq *parsedQuery) newSqlArithmeticComparison(op ast.CmpOp, left, right any) (clause.Expression, error) {
+	expression, ok := left.(arithmeticExpression)
+	if !ok {
+		// `0 == run.metrics['step'].last % 100` is the same as `run.metrics['step'].last % 100 == 0`.
+		o, r, l, err := reverseComparison(op, left, right)
+		if err != nil {
+			return nil, err
+		}
+		op, expression, right = o, r.(arithmeticExpression), l
+	}
+	switch op {
+	case ast.Eq, ast.NotEq, ast.Lt, ast.LtE, ast.Gt, ast.GtE:
+	default:
+		return nil, fmt.Errorf("unsupported comparison operator %q for arithmetic expression", op)
+	}
+	value, ok := pq.arithmeticOperand(right)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported arithmetic expression comparison value %#v (should be number or metric value)", right,
+		)
+	}
+	return newSqlComparison(op, clause.Expr(expression), value)
+}
+
+// arithmeticOperand returns the value, which could be used as an operand of the arithmetic expression.
+// Only numbers, metric values and nested arithmetic expressions are supported.
+func (pq *parsedQuery) arithmeticOperand(value any) (any, bool) {
+	switch value := value.(type) {
+	case int, float64:
+		return value, true
+	case clause.Column:
+		if pq.joinColumnType(value) == joinColumnTypeMetric {
+			return value, true
+		}
+		return nil, false
+	case arithmeticExpression:
+		return clause.Expr(value), true
+	default:
+		return nil, false
+	}
+}
+
+// evaluateArithmetic evaluates the arithmetic operation on numbers. Integer operands keep the integer result,
+// except for the division, and the remainder has the sign of the dividend, the same as SQL `MOD` has.
+func evaluateArithmetic(op ast.OperatorNumber, left, right any) (any, error) {
+	l, lok := left.(int)
+	r, rok := right.(int)
+	if lok && rok {
+		switch op {
+		case ast.Add:
+			return l + r, nil
+		case ast.Sub:
+			return l - r, nil
+		case ast.Mult:
+			return l * r, nil
+		case ast.Div, ast.Modulo:
+			if r == 0 {
+				return nil, errors.New("division by zero")
+			}
+			if op == ast.Modulo {
+				return l % r, nil
+			}
+			return float64(l) / float64(r), nil
+		}
+	}
+
+	lf, _ := numericValue(left)
+	rf, _ := numericValue(right)
+	switch op {
+	case ast.Add:
+		return lf + rf, nil
+	case ast.Sub:
+		return lf - rf, nil
+	case ast.Mult:
+		return lf * rf, nil
+	case ast.Div, ast.Modulo:
+		if rf == 0 {
+			return nil, errors.New("division by zero")
+		}
+		if op == ast.Modulo {
+			return math.Mod(lf, rf), nil
+		}
+		return lf / rf, nil
+	default:
+		return nil, fmt.Errorf("unsupported arithmetic operator %q", op)
+	}
+}
+
+// numericValue converts numeric value to float64.
+func numericValue(value any) (float64, bool) {
+	switch value := value.(type) {
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	default:
+		return 0, false
+	}
+}
+
+// validateListValues makes sure that the list of `in` comparison contains scalar values of the same kind,
+// so numeric columns, like metric values, are never compared with strings and vice versa.
+func validateListValues(values []any) error {
+	var kind string
+	for _, value := range values {
+		var valueKind string
+		switch value.(type) {
+		case int, float64:
+			valueKind = "numeric"
+		case string:
+			valueKind = "string"
+		case bool:
+			valueKind = "boolean"
+		default:
+			return fmt.Errorf("unsupported list value %#v", value)
+		}
+		if kind != "" && kind != valueKind {
+			return fmt.Errorf("list values have to be of the same type, got %s and %s", kind, valueKind)
+		}
+		kind = valueKind
+	}
+	return nil
+}
+
+// newSqlJsonPathComparison creates comparison for the value extracted by json path.
+// comparison with `None` renders as `IS NULL` / `IS NOT NULL`, which matches both,
+// absent key and key with JSON null value.
 func (pq *parsedQuery) newSqlJsonPathComparison(op ast.CmpOp, left Json, right any) (clause.Expression, error) {
+	if value, ok := right.([]JsonEq); ok && hasJsonOperators(value) {
+		return nil, errors.New("operator objects are supported in metric subscript only")
+	}
 	switch op {
-	case ast.Eq:
+	case ast.Eq, ast.Is:
 		return JsonEq{
 			Left:      left,
 			Value:     right,
 			Dialector: pq.qp.Dialector,
 		}, nil
-	case ast.NotEq:
+	case ast.NotEq, ast.IsNot:
 		return JsonNeq{
 			Left:      left,
 			Value:     right,
@@ -1059,7 +2528,41 @@ func (pq *parsedQuery) newSqlJsonPathComparison(op ast.CmpOp, left Json, right a
 	}
 }
 
-func reverseComparison(op ast.CmpOp, left any, right clause.Column) (ast.CmpOp, clause.Column, any, error) {
+// newSqlJsonObjectComparison creates comparison of the whole context object with the dictionary.
+// `==` and `!=` check exact equality, `in` and `not in` check whether the context contains the dictionary.
+func (pq *parsedQuery) newSqlJsonObjectComparison(
+	op ast.CmpOp, left contextGetter, right any,
+) (clause.Expression, error) {
+	value, ok := right.([]JsonEq)
+	if !ok {
+		return nil, fmt.Errorf("context can be compared with a dictionary only: %#v", right)
+	}
+	if hasJsonOperators(value) {
+		return nil, errors.New("operator objects are supported in metric subscript only")
+	}
+	expression := JsonObjectEq{
+		Column:     left.column,
+		Value:      value,
+		Dialector:  pq.qp.Dialector,
+		ColumnType: pq.qp.JsonColumnType,
+	}
+	switch op {
+	case ast.Eq:
+		return expression, nil
+	case ast.NotEq:
+		return negativeClause(expression), nil
+	case ast.In:
+		expression.Contains = true
+		return expression, nil
+	case ast.NotIn:
+		expression.Contains = true
+		return negativeClause(expression), nil
+	default:
+		return nil, fmt.Errorf("unsupported context comparison operation %q", op)
+	}
+}
+
+func reverseComparison[T any](op ast.CmpOp, left any, right T) (ast.CmpOp, T, any, error) {
 	switch op {
 	case ast.Lt:
 		return ast.Gt, right, left, nil
@@ -1076,6 +2579,17 @@ func reverseComparison(op ast.CmpOp, left any, right clause.Column) (ast.CmpOp,
 	}
 }
 
+// isColumnComparison makes check that the expression is a plain comparison, which clause.Not would negate
+// by rewriting the comparison operator. Custom expressions have their own negation, so they aren't included.
+func isColumnComparison(expression clause.Expression) bool {
+	switch expression.(type) {
+	case clause.Eq, clause.Neq, clause.Gt, clause.Gte, clause.Lt, clause.Lte, clause.IN:
+		return true
+	default:
+		return false
+	}
+}
+
 func negativeClause(expression clause.Expression) clause.Expression {
 	return clause.NotConditions{
 		Exprs: []clause.Expression{
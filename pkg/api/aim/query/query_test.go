@@ -1,6 +1,10 @@
 package query
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -122,6 +126,98 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
 			expectedVars: []interface{}{"my_metric", -1.0, models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestMetricWithEmptyContext",
+			query: `run.metrics['my_metric', {}].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`AND metrics_0.context_id = $2 ` +
+				`WHERE "metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"my_metric", uint(0), -1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricModulo",
+			query: `run.metrics['step'].last % 100 == 0`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE MOD(CAST("metrics_0"."value" AS NUMERIC), NULLIF(CAST($2 AS NUMERIC), 0)) = $3 ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"step", 100, 0, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricAddition",
+			query: `run.metrics['loss'].last + 1 > 2`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ("metrics_0"."value" + $2) > $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1, 2, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricArithmeticPrecedence",
+			query: `1 + run.metrics['loss'].last * 2 <= 3`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ($2 + ("metrics_0"."value" * $3)) <= $4 AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"loss", 1, 2, 3, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricDivisionReversed",
+			query: `0.5 < run.metrics['loss'].last / (2 + 2)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (CAST("metrics_0"."value" AS DOUBLE PRECISION) / NULLIF(CAST($2 AS DOUBLE PRECISION), 0)) > $3 ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 4, 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestScientificNotationWithNegativeExponent",
+			query: `run.metrics['loss'].last < 1e-3`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 0.001, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestScientificNotationWithUpperCaseExponent",
+			query: `run.metrics['loss'].last < 1.5E10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1.5e10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestIntegerWithUnderscores",
+			query: `run.metrics['loss_1_0'].last < 1_000`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss_1_0", 1000, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestFloatWithUnderscores",
+			query: `run.metrics['loss', step=1_000].last < -1_000.000_5e1_0`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1000, -1000.0005e10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueInList",
+			query: `run.metrics['epoch'].last in [10, 20, 30]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" IN ($2,$3,$4) AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"epoch", 10, 20, 30, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueNotInList",
+			query: `run.metrics['epoch'].last not in [10, 20.5]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" NOT IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"epoch", 10, 20.5, models.LifecycleStageDeleted},
+		},
 		{
 			name:  "TestMetricContextSliceTuple",
 			query: `run.metrics["my_metric", {"key1": "value1"}].last < -1`,
@@ -132,6 +228,100 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
 			expectedVars: []interface{}{"my_metric", "{key1}", "value1", -1, models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestMetricContextSliceTupleWithOperators",
+			query: `run.metrics["my_metric", {"lr": {">": 0.01, "<=": 0.1}}].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE (CASE WHEN jsonb_typeof(("contexts_1"."json"#>$2)::jsonb) = 'number' ` +
+				`THEN ("contexts_1"."json"#>>$3)::numeric END > $4 ` +
+				`AND CASE WHEN jsonb_typeof(("contexts_1"."json"#>$5)::jsonb) = 'number' ` +
+				`THEN ("contexts_1"."json"#>>$6)::numeric END <= $7) ` +
+				`AND ("metrics_0"."value" < $8 AND "runs"."lifecycle_stage" <> $9)`,
+			expectedVars: []interface{}{
+				"my_metric", "{lr}", "{lr}", 0.01, "{lr}", "{lr}", 0.1, -1, models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestSystemMetricSubscript",
+			query: `run.system_metrics['gpu'].last > 50`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`AND metrics_0.kind = $2 ` +
+				`WHERE "metrics_0"."value" > $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"gpu", models.MetricKindSystem, 50, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestSystemMetricStepSubscript",
+			query: `run.system_metrics['gpu', step=10].last > 50`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 AND metrics_0.kind = $3 ` +
+				`WHERE "metrics_0"."value" > $4 AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"gpu", 10, models.MetricKindSystem, 50, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricStepSubscript",
+			query: `run.metrics['loss', step=500].last < 0.5`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 500, 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestCrossMetricComparison",
+			query: `run.metrics['val_acc'].last > run.metrics['train_acc'].last`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN latest_metrics metrics_1 ON runs.run_uuid = metrics_1.run_uuid AND metrics_1.key = $2 ` +
+				`WHERE "metrics_0"."value" > "metrics_1"."value" AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"val_acc", "train_acc", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestCrossMetricComparisonWithContextAndStep",
+			query: `run.metrics['acc', {"subset": "val"}].last >= run.metrics['acc', step=10].last`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`LEFT JOIN metrics metrics_2 ON runs.run_uuid = metrics_2.run_uuid ` +
+				`AND metrics_2.key = $2 AND metrics_2.step = $3 ` +
+				`WHERE "contexts_1"."json"#>>$4 = $5 ` +
+				`AND ("metrics_0"."value" >= "metrics_2"."value" AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"acc", "acc", 10, "{subset}", "val", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricStepSubscriptWithContext",
+			query: `run.metrics['loss', {"key1": "value1"}, step=500].last == 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE "contexts_1"."json"#>>$3 = $4 ` +
+				`AND ("metrics_0"."value" = $5 AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"loss", 500, "{key1}", "value1", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricCount",
+			query: `len(run.metrics['loss']) > 1000`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (SELECT COUNT(*) FROM metrics metric_counts ` +
+				`WHERE metric_counts.run_uuid = runs.run_uuid AND metric_counts.key = $1) > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1000, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestReversedSystemMetricCountWithContext",
+			query: `1000 <= len(run.system_metrics['gpu', {"device": 0}])`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (SELECT COUNT(*) FROM metrics metric_counts ` +
+				`INNER JOIN contexts metric_count_contexts ON metric_counts.context_id = metric_count_contexts.id ` +
+				`WHERE metric_counts.run_uuid = runs.run_uuid AND metric_counts.key = $1 AND metric_counts.kind = $2 ` +
+				`AND "metric_count_contexts"."json"#>>$3 = $4) >= $5 ` +
+				`AND "runs"."lifecycle_stage" <> $6`,
+			expectedVars: []interface{}{"gpu", models.MetricKindSystem, "{device}", 0, 1000, models.LifecycleStageDeleted},
+		},
 		{
 			name:  "TestMetricContextSliceTupleWithPrefix",
 			query: `run.metrics["my_metric", {"$.key1": "value1"}].last < -1`,
@@ -204,6 +394,36 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" = $1`,
 			expectedVars: []interface{}{models.LifecycleStageDeleted},
 		},
+		{
+			name:         "TestArchivedEqualTrue",
+			query:        `run.archived == True`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" = $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestArchivedEqualFalse",
+			query:        `run.archived == False`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestActiveEqualTrue",
+			query:        `run.active == True`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."status" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{models.StatusRunning, models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestActiveAndNotArchived",
+			query:        `run.active and not run.archived`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."status" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{models.StatusRunning, models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestArchivedInStringLiteral",
+			query:        `run.name == 'run.archived'`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"run.archived", models.LifecycleStageDeleted},
+		},
 		{
 			name:         "TestActiveAttribute",
 			query:        `run.active`,
@@ -223,6 +443,327 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
 			expectedVars: []interface{}{int64(1643760000000), models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestMetricValueBetween",
+			query: `run.metrics['loss'].last between 0.1 and 0.5`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ("metrics_0"."value" >= $2 AND "metrics_0"."value" <= $3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 0.1, 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueNotBetween",
+			query: `run.metrics['loss'].last not between -1 and 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" >= $2 AND "metrics_0"."value" <= $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", -1, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDurationBetween",
+			query: `run.duration between 60 and 3600`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((runs.end_time - runs.start_time) / 1000 >= $1 AND (runs.end_time - runs.start_time) / 1000 <= $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{60, 3600, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDatetimeBetween",
+			query: `run.created_at between datetime(2022, 2, 2) and datetime(2022, 3, 2)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."start_time" >= $1 AND "runs"."start_time" <= $2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{int64(1643760000000), int64(1646179200000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestEndTimeIsNone",
+			query:        `run.end_time == None`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" IS NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestEndTimeIsNotNone",
+			query: `run.end_time is not None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."end_time" IS NOT NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestFinalizedAtIsNoneReversed",
+			query:        `None is run.finalized_at`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" IS NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricExists",
+			query: `run.metrics['custom_metric'] != None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricNotExists",
+			query: `run.metrics['custom_metric'] is None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricExistsReversed",
+			query: `None != run.metrics['custom_metric']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricWithStepExists",
+			query: `run.metrics['loss', step=500] != None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 500, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentName",
+			query: `run.experiment.name == 'prod'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"prod", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentNameStartsWith",
+			query: `run.experiment.name.startswith('prod')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"prod%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentNameIn",
+			query: `run.experiment.name in ['prod', 'staging']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" IN ($1,$2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"prod", "staging", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentEndsWith",
+			query: `run.experiment.endswith('prod')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"%prod", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNameLike",
+			query: `run.name like 'exp_%_final'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"exp_%_final", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNameNotLike",
+			query: `run.name not like "exp_%"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."name" NOT LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"exp_%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTagLike",
+			query: `run.tags['model'] like '%net%' and run.name == 'a like "b"'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE ("tags_0"."value" LIKE $2 AND "runs"."name" = $3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"model", "%net%", `a like "b"`, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamEqualTrue",
+			query: `run.params['use_amp'] == True`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "true", "True", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamAttributeNotEqualFalse",
+			query: `run.use_amp != False`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" NOT IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "false", "False", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamReversedFalse",
+			query: `False == run.params['use_amp']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "false", "False", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamString",
+			query: `run.params['optimizer'] == 'adam'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" = $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"optimizer", "adam", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamIsNone",
+			query: `run.params['optimizer'] is None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."key" IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"optimizer", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotAndExpression",
+			query: `not (run.name == 'a' and run.experiment == 'b')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 AND "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotOrExpression",
+			query: `not (run.name == 'a' or run.experiment == 'b')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 OR "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotNestedExpression",
+			query: `not (run.name == 'a' and (run.experiment == 'b' or run.hash == 'c'))`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 AND ("Experiment"."name" = $2 OR "runs"."run_uuid" = $3)) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"a", "b", "c", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotChainedComparison",
+			query: `not (1 < run.metrics['loss'].last < 3)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" > $2 AND "metrics_0"."value" < $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1, 3, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotSingleExpression",
+			query: `not (run.name == 'a') and run.experiment == 'b'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (NOT ("runs"."name" = $1) AND "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotMetricComparison",
+			query: `not run.metrics['loss'].last > 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" > $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotComparisonInAndExpression",
+			query: `run.name == 'a' and not run.duration <= 10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."name" = $1 AND NOT ((runs.end_time - runs.start_time) / 1000 <= $2)) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", 10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDoubleNotComparison",
+			query: `not (not run.metrics['loss'].last > 1)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ("metrics_0"."value" > $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyEqualNone",
+			query:         `metric.context.subset == None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{subset}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyNotEqualNone",
+			query:         `metric.context.subset != None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{subset}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyIsNone",
+			query:         `metric.context.subset is None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{subset}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyIsNotNone",
+			query:         `metric.context.subset is not None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{subset}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyReversedEqualNone",
+			query:         `None == metric.context.subset`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{subset}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextTwoLevelNestedKey",
+			query:         `metric.context.parent.nested == "x"`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 = $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"{parent,nested}", "x", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextThreeLevelNestedKeyIsNone",
+			query:         `metric.context.a.b.c is None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"#>>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"{a,b,c}", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextObjectEqual",
+			query:         `metric.context == {"b": 2, "a": "x"}`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"::jsonb = $1::jsonb AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{`{"a":"x","b":2}`, models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextObjectNotEqual",
+			query:         `metric.context != {"a": 1}`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE NOT COALESCE(("contexts"."json"::jsonb = $1::jsonb), FALSE) AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{`{"a":1}`, models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextObjectContains",
+			query:         `{"a": 1} in metric.context`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE "contexts"."json"::jsonb @> $1::jsonb AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{`{"a":1}`, models.LifecycleStageDeleted},
+		},
 	}
 
 	for _, tt := range tests {
@@ -347,53 +888,530 @@ func (s *QueryTestSuite) TestSqliteDialector_Ok() {
 			expectedVars: []interface{}{"my_metric", -1.0, models.LifecycleStageDeleted},
 		},
 		{
-			name:  "TestMetricKeySlice",
-			query: `run.metrics["key1"].last < -1`,
+			name:  "TestMetricModulo",
+			query: `run.metrics['step'].last % 100 == 0`,
 			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
 				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
-				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
-			expectedVars: []interface{}{"key1", -1, models.LifecycleStageDeleted},
+				`WHERE ("metrics_0"."value" - $2 * CAST(CAST("metrics_0"."value" AS REAL) / NULLIF($3, 0) AS INTEGER)) = $4 ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"step", 100, 100, 0, models.LifecycleStageDeleted},
 		},
 		{
-			name:  "TestMetricContextSliceTuple",
-			query: `run.metrics["my_metric", {"key1": "value1"}].last < -1`,
+			name:  "TestMetricAddition",
+			query: `run.metrics['loss'].last + 1 > 2`,
 			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
 				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
-				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
-				`WHERE IFNULL("contexts_1"."json", JSON('{}'))->>$2 = $3 ` +
-				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
-			expectedVars: []interface{}{"my_metric", "$.key1", "value1", -1, models.LifecycleStageDeleted},
+				`WHERE ("metrics_0"."value" + $2) > $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1, 2, models.LifecycleStageDeleted},
 		},
 		{
-			name:  "TestMetricContextSliceTupleWithPrefix",
-			query: `run.metrics["my_metric", {"$.key1": "value1"}].last < -1`,
+			name:  "TestMetricArithmeticPrecedence",
+			query: `1 + run.metrics['loss'].last * 2 <= 3`,
 			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
 				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
-				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
-				`WHERE IFNULL("contexts_1"."json", JSON('{}'))->>$2 = $3 ` +
-				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
-			expectedVars: []interface{}{"my_metric", "$.key1", "value1", -1, models.LifecycleStageDeleted},
+				`WHERE ($2 + ("metrics_0"."value" * $3)) <= $4 AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"loss", 1, 2, 3, models.LifecycleStageDeleted},
 		},
 		{
-			name:  "TestImagesName",
-			query: `(images.name == 'my-image')`,
+			name:  "TestMetricDivisionReversed",
+			query: `0.5 < run.metrics['loss'].last / (2 + 2)`,
 			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
-				`INNER JOIN artifacts artifacts_0 ON runs.run_uuid = artifacts_0.run_uuid ` +
-				`WHERE "artifacts_0"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
-			expectedVars: []interface{}{"my-image", models.LifecycleStageDeleted},
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE (CAST("metrics_0"."value" AS REAL) / NULLIF(CAST($2 AS REAL), 0)) > $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 4, 0.5, models.LifecycleStageDeleted},
 		},
-	}
-
-	for _, tt := range tests {
-		s.Run(tt.name, func() {
-			pq := QueryParser{
-				Default: DefaultExpression{
-					Contains:   "run.archived",
-					Expression: "not run.archived",
-				},
-				Tables: map[string]string{
-					"runs":        "runs",
-					"experiments": "Experiment",
+		{
+			name:  "TestScientificNotationWithNegativeExponent",
+			query: `run.metrics['loss'].last < 1e-3`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 0.001, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestScientificNotationWithUpperCaseExponent",
+			query: `run.metrics['loss'].last < 1.5E10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1.5e10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestIntegerWithUnderscores",
+			query: `run.metrics['loss_1_0'].last < 1_000`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss_1_0", 1000, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestFloatWithUnderscores",
+			query: `run.metrics['loss', step=1_000].last < -1_000.000_5e1_0`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1000, -1000.0005e10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueInList",
+			query: `run.metrics['epoch'].last in [10, 20, 30]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" IN ($2,$3,$4) AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"epoch", 10, 20, 30, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueNotInList",
+			query: `run.metrics['epoch'].last not in [10, 20.5]`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" NOT IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"epoch", 10, 20.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricKeySlice",
+			query: `run.metrics["key1"].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."value" < $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"key1", -1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricContextSliceTuple",
+			query: `run.metrics["my_metric", {"key1": "value1"}].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE IFNULL("contexts_1"."json", JSON('{}'))->>$2 = $3 ` +
+				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
+			expectedVars: []interface{}{"my_metric", "$.key1", "value1", -1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricContextSliceTupleWithOperators",
+			query: `run.metrics["my_metric", {"lr": {">": 0.01, "<=": 0.1}}].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE (CASE WHEN json_type(IFNULL("contexts_1"."json", JSON('{}')), $2) IN ('integer', 'real') ` +
+				`THEN IFNULL("contexts_1"."json", JSON('{}'))->>$3 END > $4 ` +
+				`AND CASE WHEN json_type(IFNULL("contexts_1"."json", JSON('{}')), $5) IN ('integer', 'real') ` +
+				`THEN IFNULL("contexts_1"."json", JSON('{}'))->>$6 END <= $7) ` +
+				`AND ("metrics_0"."value" < $8 AND "runs"."lifecycle_stage" <> $9)`,
+			expectedVars: []interface{}{
+				"my_metric", "$.lr", "$.lr", 0.01, "$.lr", "$.lr", 0.1, -1, models.LifecycleStageDeleted,
+			},
+		},
+		{
+			name:  "TestSystemMetricSubscript",
+			query: `run.system_metrics['gpu'].last > 50`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`AND metrics_0.kind = $2 ` +
+				`WHERE "metrics_0"."value" > $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"gpu", models.MetricKindSystem, 50, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestSystemMetricStepSubscript",
+			query: `run.system_metrics['gpu', step=10].last > 50`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 AND metrics_0.kind = $3 ` +
+				`WHERE "metrics_0"."value" > $4 AND "runs"."lifecycle_stage" <> $5`,
+			expectedVars: []interface{}{"gpu", 10, models.MetricKindSystem, 50, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricStepSubscript",
+			query: `run.metrics['loss', step=500].last < 0.5`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 500, 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestCrossMetricComparison",
+			query: `run.metrics['val_acc'].last > run.metrics['train_acc'].last`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN latest_metrics metrics_1 ON runs.run_uuid = metrics_1.run_uuid AND metrics_1.key = $2 ` +
+				`WHERE "metrics_0"."value" > "metrics_1"."value" AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"val_acc", "train_acc", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricStepSubscriptWithContext",
+			query: `run.metrics['loss', {"key1": "value1"}, step=500].last == 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE IFNULL("contexts_1"."json", JSON('{}'))->>$3 = $4 ` +
+				`AND ("metrics_0"."value" = $5 AND "runs"."lifecycle_stage" <> $6)`,
+			expectedVars: []interface{}{"loss", 500, "$.key1", "value1", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricCount",
+			query: `len(run.metrics['loss']) > 1000`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (SELECT COUNT(*) FROM metrics metric_counts ` +
+				`WHERE metric_counts.run_uuid = runs.run_uuid AND metric_counts.key = $1) > $2 ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1000, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestReversedSystemMetricCountWithContext",
+			query: `1000 <= len(run.system_metrics['gpu', {"device": 0}])`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (SELECT COUNT(*) FROM metrics metric_counts ` +
+				`INNER JOIN contexts metric_count_contexts ON metric_counts.context_id = metric_count_contexts.id ` +
+				`WHERE metric_counts.run_uuid = runs.run_uuid AND metric_counts.key = $1 AND metric_counts.kind = $2 ` +
+				`AND IFNULL("metric_count_contexts"."json", JSON('{}'))->>$3 = $4) >= $5 ` +
+				`AND "runs"."lifecycle_stage" <> $6`,
+			expectedVars: []interface{}{"gpu", models.MetricKindSystem, "$.device", 0, 1000, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricContextSliceTupleWithPrefix",
+			query: `run.metrics["my_metric", {"$.key1": "value1"}].last < -1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN contexts contexts_1 ON metrics_0.context_id = contexts_1.id ` +
+				`WHERE IFNULL("contexts_1"."json", JSON('{}'))->>$2 = $3 ` +
+				`AND ("metrics_0"."value" < $4 AND "runs"."lifecycle_stage" <> $5)`,
+			expectedVars: []interface{}{"my_metric", "$.key1", "value1", -1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueBetween",
+			query: `run.metrics['loss'].last between 0.1 and 0.5`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ("metrics_0"."value" >= $2 AND "metrics_0"."value" <= $3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 0.1, 0.5, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricValueNotBetween",
+			query: `run.metrics['loss'].last not between -1 and 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" >= $2 AND "metrics_0"."value" <= $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", -1, 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDurationBetween",
+			query: `run.duration between 60 and 3600`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ((runs.end_time - runs.start_time) / 1000 >= $1 AND (runs.end_time - runs.start_time) / 1000 <= $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{60, 3600, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDatetimeBetween",
+			query: `run.created_at between datetime(2022, 2, 2) and datetime(2022, 3, 2)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."start_time" >= $1 AND "runs"."start_time" <= $2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{int64(1643760000000), int64(1646179200000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestArchivedEqualTrue",
+			query:        `run.archived == True`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" = $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestArchivedEqualFalse",
+			query:        `run.archived == False`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestActiveEqualTrue",
+			query:        `run.active == True`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."status" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{models.StatusRunning, models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestActiveAndNotArchived",
+			query:        `run.active and not run.archived`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."status" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{models.StatusRunning, models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestArchivedInStringLiteral",
+			query:        `run.name == 'run.archived'`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"run.archived", models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestEndTimeIsNone",
+			query:        `run.end_time == None`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" IS NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestEndTimeIsNotNone",
+			query: `run.end_time is not None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."end_time" IS NOT NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestFinalizedAtIsNoneReversed",
+			query:        `None is run.finalized_at`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" IS NULL AND "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []interface{}{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricExists",
+			query: `run.metrics['custom_metric'] != None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricNotExists",
+			query: `run.metrics['custom_metric'] is None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricExistsReversed",
+			query: `None != run.metrics['custom_metric']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"custom_metric", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricWithStepExists",
+			query: `run.metrics['loss', step=500] != None`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`WHERE "metrics_0"."run_uuid" IS NOT NULL AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 500, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentName",
+			query: `run.experiment.name == 'prod'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"prod", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentNameStartsWith",
+			query: `run.experiment.name.startswith('prod')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"prod%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentNameIn",
+			query: `run.experiment.name in ['prod', 'staging']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" IN ($1,$2) AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"prod", "staging", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestRunExperimentEndsWith",
+			query: `run.experiment.endswith('prod')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "Experiment"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"%prod", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNameLike",
+			query: `run.name like 'exp_%_final'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."name" LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"exp_%_final", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNameNotLike",
+			query: `run.name not like "exp_%"`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE "runs"."name" NOT LIKE $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"exp_%", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTagLike",
+			query: `run.tags['model'] like '%net%' and run.name == 'a like "b"'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE ("tags_0"."value" LIKE $2 AND "runs"."name" = $3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"model", "%net%", `a like "b"`, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamEqualTrue",
+			query: `run.params['use_amp'] == True`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "true", "True", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamAttributeNotEqualFalse",
+			query: `run.use_amp != False`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" NOT IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "false", "False", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamReversedFalse",
+			query: `False == run.params['use_amp']`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" IN ($2,$3) AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"use_amp", "false", "False", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestParamString",
+			query: `run.params['optimizer'] == 'adam'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 ` +
+				`WHERE "params_0"."value_str" = $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"optimizer", "adam", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotAndExpression",
+			query: `not (run.name == 'a' and run.experiment == 'b')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 AND "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotOrExpression",
+			query: `not (run.name == 'a' or run.experiment == 'b')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 OR "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotNestedExpression",
+			query: `not (run.name == 'a' and (run.experiment == 'b' or run.hash == 'c'))`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE NOT ("runs"."name" = $1 AND ("Experiment"."name" = $2 OR "runs"."run_uuid" = $3)) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"a", "b", "c", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotChainedComparison",
+			query: `not (1 < run.metrics['loss'].last < 3)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" > $2 AND "metrics_0"."value" < $3) ` +
+				`AND "runs"."lifecycle_stage" <> $4`,
+			expectedVars: []interface{}{"loss", 1, 3, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotSingleExpression",
+			query: `not (run.name == 'a') and run.experiment == 'b'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE (NOT ("runs"."name" = $1) AND "Experiment"."name" = $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", "b", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotMetricComparison",
+			query: `not run.metrics['loss'].last > 1`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE NOT ("metrics_0"."value" > $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNotComparisonInAndExpression",
+			query: `run.name == 'a' and not run.duration <= 10`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("runs"."name" = $1 AND NOT ((runs.end_time - runs.start_time) / 1000 <= $2)) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"a", 10, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestDoubleNotComparison",
+			query: `not (not run.metrics['loss'].last > 1)`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE ("metrics_0"."value" > $2) ` +
+				`AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"loss", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyEqualNone",
+			query:         `metric.context.subset == None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"$.subset", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextKeyIsNotNone",
+			query:         `metric.context.subset is not None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 IS NOT NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"$.subset", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextTwoLevelNestedKey",
+			query:         `metric.context.parent.nested == "x"`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 = $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"$.parent.nested", "x", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextThreeLevelNestedKeyIsNone",
+			query:         `metric.context.a.b.c is None`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 IS NULL AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"$.a.b.c", models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextObjectEqual",
+			query:         `metric.context == {"b": 2, "a": "x"}`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 = $2 AND IFNULL("contexts"."json", JSON('{}'))->>$3 = $4 ` +
+				`AND (SELECT COUNT(*) FROM json_each(IFNULL("contexts"."json", JSON('{}')))) = $5 ` +
+				`AND "runs"."lifecycle_stage" <> $6`,
+			expectedVars: []interface{}{"$.b", 2, "$.a", "x", 2, models.LifecycleStageDeleted},
+		},
+		{
+			name:          "TestMetricContextObjectContains",
+			query:         `{"a": 1} in metric.context`,
+			selectMetrics: true,
+			expectedSQL: `SELECT ID FROM "metrics" ` +
+				`WHERE IFNULL("contexts"."json", JSON('{}'))->>$1 = $2 AND "runs"."lifecycle_stage" <> $3`,
+			expectedVars: []interface{}{"$.a", 1, models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestImagesName",
+			query: `(images.name == 'my-image')`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`INNER JOIN artifacts artifacts_0 ON runs.run_uuid = artifacts_0.run_uuid ` +
+				`WHERE "artifacts_0"."name" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []interface{}{"my-image", models.LifecycleStageDeleted},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: DefaultExpression{
+					Contains:   "run.archived",
+					Expression: "not run.archived",
+				},
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
 					"metrics":     "metrics",
 					"images":      "images",
 				},
@@ -435,24 +1453,1063 @@ func (s *QueryTestSuite) Test_Error() {
 			query:         `run.metrics[{"key1": "value1"}].last < -1`,
 			expectedError: SyntaxError{},
 		},
-	}
-	for _, tt := range tests {
-		s.Run(tt.name, func() {
-			pq := QueryParser{
-				Default: DefaultExpression{
-					Contains:   "run.archived",
-					Expression: "not run.archived",
-				},
-				Tables: map[string]string{
-					"runs":        "runs",
-					"experiments": "Experiment",
-					"metrics":     "metrics",
-				},
-				Dialector: sqlite.Dialector{}.Name(),
-			}
-			parsedQuery, err := pq.Parse(tt.query)
-			require.IsType(s.T(), tt.expectedError, err)
-			require.Nil(s.T(), parsedQuery)
-		})
-	}
+		{
+			name:          "TestMetricContextSubscriptUnsupportedOperator",
+			query:         `run.metrics['my_metric', {"lr": {"~": 0.01}}].last < -1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricContextSubscriptNonNumericOperatorValue",
+			query:         `run.metrics['my_metric', {"lr": {">": "0.01"}}].last < -1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricContextSubscriptEmptyOperatorObject",
+			query:         `run.metrics['my_metric', {"lr": {}}].last < -1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricContextComparisonWithOperatorObject",
+			query:         `metric.context == {"lr": {">": 0.01}}`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricStepSubscriptWithNonIntegerStep",
+			query:         `run.metrics['loss', step==0.5].last < -1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricStepSubscriptWithUnsupportedOperator",
+			query:         `run.metrics['loss', step>5].last < -1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestEndTimeLessThanNone",
+			query:         `run.end_time < None`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricValueInMixedList",
+			query:         `run.metrics['epoch'].last in [10, 'a']`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricValueNotInListWithColumn",
+			query:         `run.metrics['epoch'].last not in [10, run.name]`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricExistenceWithValue",
+			query:         `run.metrics['custom_metric'] == 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricExistenceWithUnsupportedOperator",
+			query:         `run.metrics['custom_metric'] > None`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricComparedWithMetricWithoutAttribute",
+			query:         `run.metrics['val_acc'] > run.metrics['train_acc']`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricValueComparedWithMetricWithoutAttribute",
+			query:         `run.metrics['val_acc'].last > run.metrics['train_acc']`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestBetweenInvertedRange",
+			query:         `run.metrics['loss'].last between 0.5 and 0.1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestBetweenNonNumericBound",
+			query:         `run.metrics['loss'].last between 'a' and 0.5`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestBetweenWithNonColumn",
+			query:         `1 between 0 and 2`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestRunExperimentUnsupportedAttribute",
+			query:         `run.experiment.owner == 'me'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestIntegerWithDoubleUnderscore",
+			query:         `run.metrics['loss'].last < 1__000`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestIntegerWithTrailingUnderscore",
+			query:         `run.metrics['loss'].last < 1000_`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestFloatWithCommaDecimalSeparator",
+			query:         `run.metrics['loss'].last < 1,5`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestParamWithCommaDecimalSeparator",
+			query:         `run.params['lr'] == 0,001`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestFloatWithCommaDecimalSeparatorAndCondition",
+			query:         `run.duration > 1,5 and run.name == 'a'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricArithmeticWithString",
+			query:         `run.metrics['loss'].last + 'a' > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestRunAttributeArithmetic",
+			query:         `run.duration % 2 == 0`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricArithmeticComparedWithNone",
+			query:         `run.metrics['loss'].last * 2 == None`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricArithmeticWithUnsupportedOperator",
+			query:         `run.metrics['loss'].last ** 2 > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricArithmeticDivisionByZeroConstant",
+			query:         `run.metrics['loss'].last > 1 / 0`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestLikeWithNonString",
+			query:         `run.metrics['loss'] like '1%'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestParamBoolWithUnsupportedOperator",
+			query:         `run.params['use_amp'] > True`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestParamWithNonStringIndex",
+			query:         `run.params[1] == 'adam'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricCountOfParam",
+			query:         `len(run.params['lr']) > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricCountWithoutArguments",
+			query:         `len() > 1`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricCountComparedWithString",
+			query:         `len(run.metrics['loss']) > 'many'`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestMetricCountsCompared",
+			query:         `len(run.metrics['loss']) > len(run.metrics['acc'])`,
+			expectedError: SyntaxError{},
+		},
+		{
+			name:          "TestLikeWithNonStringPattern",
+			query:         `run.name like 1`,
+			expectedError: SyntaxError{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: DefaultExpression{
+					Contains:   "run.archived",
+					Expression: "not run.archived",
+				},
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+					"metrics":     "metrics",
+				},
+				Dialector: sqlite.Dialector{}.Name(),
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.IsType(s.T(), tt.expectedError, err)
+			require.Nil(s.T(), parsedQuery)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_ErrorPosition() {
+	tests := []struct {
+		name           string
+		query          string
+		expectedLine   int
+		expectedOffset int
+	}{
+		{
+			name:           "TestCommaDecimalSeparator",
+			query:          `run.duration > 1,5`,
+			expectedLine:   1,
+			expectedOffset: 18,
+		},
+		{
+			name:           "TestCommaDecimalSeparatorAfterCondition",
+			query:          `run.name == 'a' and run.duration > 1,5`,
+			expectedLine:   1,
+			expectedOffset: 38,
+		},
+		{
+			name:           "TestUnsupportedName",
+			query:          `foo == 1`,
+			expectedLine:   1,
+			expectedOffset: 1,
+		},
+		{
+			name:           "TestUnsupportedNameAfterCondition",
+			query:          `run.name == 'a' and  bar`,
+			expectedLine:   1,
+			expectedOffset: 22,
+		},
+		{
+			name:           "TestUnsupportedCall",
+			query:          `run.name == 'a' and run.bar(1)`,
+			expectedLine:   1,
+			expectedOffset: 21,
+		},
+		{
+			name:           "TestUnsupportedSubscript",
+			query:          `run.name == 'a' and  run.params[1] == 'adam'`,
+			expectedLine:   1,
+			expectedOffset: 22,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+				},
+				Dialector: sqlite.Dialector{}.Name(),
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), parsedQuery)
+			var syntaxError SyntaxError
+			require.ErrorAs(s.T(), err, &syntaxError)
+			assert.Equal(s.T(), tt.query, syntaxError.Statement)
+			assert.Equal(s.T(), tt.expectedLine, syntaxError.Line)
+			assert.Equal(s.T(), tt.expectedOffset, syntaxError.Offset)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_UnknownTable_Error() {
+	// run search doesn't map `metrics` table, so `metric` accessor can't be used there.
+	pq := QueryParser{
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector: sqlite.Dialector{}.Name(),
+	}
+	parsedQuery, err := pq.Parse(`metric.name == "loss"`)
+	require.Nil(s.T(), parsedQuery)
+	var syntaxError SyntaxError
+	require.ErrorAs(s.T(), err, &syntaxError)
+	assert.Equal(s.T(), `metric.name == "loss"`, syntaxError.Statement)
+	assert.Equal(
+		s.T(),
+		`unknown table "metrics" referenced by "metric", supported tables are: [experiments, runs]`,
+		syntaxError.Err,
+	)
+}
+
+func (s *QueryTestSuite) Test_ParamBoolValues() {
+	pq := QueryParser{
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector:        postgres.Dialector{}.Name(),
+		ParamTrueValues:  []string{"yes", "1"},
+		ParamFalseValues: []string{"no"},
+	}
+	parsedQuery, err := pq.Parse(`run.params['use_amp'] == True and run.params['debug'] is False`)
+	require.Nil(s.T(), err)
+	tx := parsedQuery.Filter(
+		s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+	).Select("ID").Find(&models.Run{})
+	require.Nil(s.T(), tx.Error)
+	assert.Equal(
+		s.T(),
+		`SELECT "run_uuid" FROM "runs" `+
+			`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 `+
+			`LEFT JOIN params params_1 ON runs.run_uuid = params_1.run_uuid AND params_1.key = $2 `+
+			`WHERE "params_0"."value_str" IN ($3,$4) AND "params_1"."value_str" = $5`,
+		tx.Statement.SQL.String(),
+	)
+	assert.Equal(s.T(), []interface{}{"use_amp", "debug", "yes", "1", "no"}, tx.Statement.Vars)
+}
+
+func (s *QueryTestSuite) Test_ParamNumberValues() {
+	pq := QueryParser{
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector: postgres.Dialector{}.Name(),
+	}
+	parsedQuery, err := pq.Parse(
+		`run.params['lr'] < 0.01 and 10 <= run.params['epochs'] and run.params['name'] == 'resnet'`,
+	)
+	require.Nil(s.T(), err)
+	tx := parsedQuery.Filter(
+		s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+	).Select("ID").Find(&models.Run{})
+	require.Nil(s.T(), tx.Error)
+	assert.Equal(
+		s.T(),
+		`SELECT "run_uuid" FROM "runs" `+
+			`LEFT JOIN params params_0 ON runs.run_uuid = params_0.run_uuid AND params_0.key = $1 `+
+			`LEFT JOIN params params_1 ON runs.run_uuid = params_1.run_uuid AND params_1.key = $2 `+
+			`LEFT JOIN params params_2 ON runs.run_uuid = params_2.run_uuid AND params_2.key = $3 `+
+			`WHERE COALESCE("params_0"."value_int", "params_0"."value_float") < $4 `+
+			`AND COALESCE("params_1"."value_int", "params_1"."value_float") >= $5 `+
+			`AND "params_2"."value_str" = $6`,
+		tx.Statement.SQL.String(),
+	)
+	assert.Equal(s.T(), []interface{}{"lr", "epochs", "name", 0.01, 10, "resnet"}, tx.Statement.Vars)
+}
+
+func (s *QueryTestSuite) Test_ParamNone() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.Nil(s.T(), err)
+	require.Nil(s.T(), db.Exec(`CREATE TABLE runs (run_uuid TEXT PRIMARY KEY)`).Error)
+	require.Nil(s.T(), db.Exec(
+		`CREATE TABLE params (key TEXT, value_str TEXT, value_int INTEGER, value_float REAL, run_uuid TEXT)`,
+	).Error)
+	require.Nil(s.T(), db.Exec(
+		`INSERT INTO runs (run_uuid) VALUES ('str'), ('int'), ('float'), ('unset')`,
+	).Error)
+	require.Nil(s.T(), db.Exec(
+		`INSERT INTO params (key, value_str, value_int, value_float, run_uuid) VALUES `+
+			`('x', 'a', NULL, NULL, 'str'), ('x', NULL, 1, NULL, 'int'), ('x', NULL, NULL, 0.5, 'float')`,
+	).Error)
+
+	tests := []struct {
+		name         string
+		query        string
+		expectedRuns []string
+	}{
+		{
+			name:         "ParamEqualNone",
+			query:        `run.params['x'] == None`,
+			expectedRuns: []string{"unset"},
+		},
+		{
+			name:         "ParamAttributeIsNone",
+			query:        `run.x is None`,
+			expectedRuns: []string{"unset"},
+		},
+		{
+			name:         "ParamReversedNone",
+			query:        `None == run.x`,
+			expectedRuns: []string{"unset"},
+		},
+		{
+			name:         "ParamIsNotNone",
+			query:        `run.x is not None`,
+			expectedRuns: []string{"float", "int", "str"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+				},
+				Dialector: sqlite.Dialector{}.Name(),
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+
+			var runs []models.Run
+			require.Nil(s.T(), parsedQuery.Run(db.Table("runs").Select("runs.run_uuid").Order("runs.run_uuid"), &runs))
+			ids := make([]string, len(runs))
+			for i, run := range runs {
+				ids[i] = run.ID
+			}
+			assert.Equal(s.T(), tt.expectedRuns, ids)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_GetDefaultContextID() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.Nil(s.T(), err)
+	// every connection gets its own in-memory database, so only one connection is used.
+	sqlDB, err := db.DB()
+	require.Nil(s.T(), err)
+	sqlDB.SetMaxOpenConns(1)
+	require.Nil(s.T(), db.Exec(`CREATE TABLE contexts (id INTEGER PRIMARY KEY, json TEXT)`).Error)
+
+	// missing default context is not cached, so it is found once it is created.
+	id, err := GetDefaultContextID(db)
+	require.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(0), id)
+
+	require.Nil(s.T(), db.Exec(`INSERT INTO contexts (id, json) VALUES (1, '{"a": 1}'), (2, '{}')`).Error)
+	id, err = GetDefaultContextID(db)
+	require.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(2), id)
+
+	// once found, the id is taken from the cache rather than from the contexts table.
+	require.Nil(s.T(), db.Exec(`DROP TABLE contexts`).Error)
+	id, err = GetDefaultContextID(db.WithContext(context.Background()))
+	require.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(2), id)
+}
+
+func (s *QueryTestSuite) Test_JsonColumnType() {
+	tests := []struct {
+		name           string
+		query          string
+		jsonColumnType string
+		expectedSQL    string
+		expectedVars   []interface{}
+	}{
+		{
+			name:           "JsonKeyEqual",
+			query:          `metric.context.subset == "train"`,
+			jsonColumnType: JsonColumnTypeJson,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 = $2`,
+			expectedVars:   []interface{}{"{subset}", "train"},
+		},
+		{
+			name:           "JsonbKeyEqual",
+			query:          `metric.context.subset == "train"`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json" @> $1::jsonb`,
+			expectedVars:   []interface{}{`{"subset":"train"}`},
+		},
+		{
+			name:           "JsonNestedKeyEqual",
+			query:          `metric.context.a.b == 1`,
+			jsonColumnType: JsonColumnTypeJson,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 = $2`,
+			expectedVars:   []interface{}{"{a,b}", 1},
+		},
+		{
+			name:           "JsonbNestedKeyEqual",
+			query:          `metric.context.a.b == 1`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json" @> $1::jsonb`,
+			expectedVars:   []interface{}{`{"a":{"b":1}}`},
+		},
+		{
+			name:           "JsonbKeyNotEqual",
+			query:          `metric.context.subset != "train"`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 <> $2`,
+			expectedVars:   []interface{}{"{subset}", "train"},
+		},
+		{
+			name:           "JsonbNegatedKeyEqual",
+			query:          `not metric.context.subset == "train"`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 <> $2`,
+			expectedVars:   []interface{}{"{subset}", "train"},
+		},
+		{
+			name:           "JsonbKeyIsNone",
+			query:          `metric.context.subset is None`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 IS NULL`,
+			expectedVars:   []interface{}{"{subset}"},
+		},
+		{
+			name:           "JsonbKeyStartsWith",
+			query:          `metric.context.subset.startswith("tr")`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json"#>>$1 LIKE $2`,
+			expectedVars:   []interface{}{"{subset}", "tr%"},
+		},
+		{
+			name:           "JsonObjectEqual",
+			query:          `metric.context == {"subset": "train"}`,
+			jsonColumnType: JsonColumnTypeJson,
+			expectedSQL:    `WHERE "contexts"."json"::jsonb = $1::jsonb`,
+			expectedVars:   []interface{}{`{"subset":"train"}`},
+		},
+		{
+			name:           "JsonbObjectEqual",
+			query:          `metric.context == {"subset": "train"}`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json" = $1::jsonb`,
+			expectedVars:   []interface{}{`{"subset":"train"}`},
+		},
+		{
+			name:           "JsonObjectContains",
+			query:          `{"subset": "train"} in metric.context`,
+			jsonColumnType: JsonColumnTypeJson,
+			expectedSQL:    `WHERE "contexts"."json"::jsonb @> $1::jsonb`,
+			expectedVars:   []interface{}{`{"subset":"train"}`},
+		},
+		{
+			name:           "JsonbObjectContains",
+			query:          `{"subset": "train"} in metric.context`,
+			jsonColumnType: JsonColumnTypeJsonb,
+			expectedSQL:    `WHERE "contexts"."json" @> $1::jsonb`,
+			expectedVars:   []interface{}{`{"subset":"train"}`},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+					"metrics":     "metrics",
+				},
+				Dialector:      postgres.Dialector{}.Name(),
+				JsonColumnType: tt.jsonColumnType,
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+			tx := parsedQuery.Filter(
+				s.db.Session(&gorm.Session{DryRun: true}).Model(models.Metric{}),
+			).Select("ID").Find(models.Metric{})
+			require.Nil(s.T(), tx.Error)
+			assert.Equal(s.T(), `SELECT ID FROM "metrics" `+tt.expectedSQL, tx.Statement.SQL.String())
+			assert.Equal(s.T(), tt.expectedVars, tx.Statement.Vars)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_ParseCache() {
+	newQueryParser := func(dialector string) *QueryParser {
+		return &QueryParser{
+			Default: DefaultExpression{
+				Contains:   "run.archived",
+				Expression: "not run.archived",
+			},
+			Tables: map[string]string{
+				"runs":        "runs",
+				"experiments": "Experiment",
+			},
+			Dialector: dialector,
+		}
+	}
+	query := `run.metrics['loss'].last < 0.5 and run.name.startswith('cache')`
+
+	firstQuery, err := newQueryParser(postgres.Dialector{}.Name()).Parse(query)
+	require.Nil(s.T(), err)
+
+	// the second parse by the parser with the same configuration is taken from cache.
+	cachedQuery, err := newQueryParser(postgres.Dialector{}.Name()).Parse(query)
+	require.Nil(s.T(), err)
+	assert.Same(s.T(), firstQuery, cachedQuery)
+
+	// and renders the same SQL as the query which isn't cached.
+	uncachedQuery, err := newQueryParser(postgres.Dialector{}.Name()).parse(query)
+	require.Nil(s.T(), err)
+	cachedTx := cachedQuery.Filter(
+		s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+	).Select("ID").Find(&models.Run{})
+	uncachedTx := uncachedQuery.Filter(
+		s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+	).Select("ID").Find(&models.Run{})
+	require.Nil(s.T(), cachedTx.Error)
+	assert.Equal(s.T(), uncachedTx.Statement.SQL.String(), cachedTx.Statement.SQL.String())
+	assert.Equal(s.T(), uncachedTx.Statement.Vars, cachedTx.Statement.Vars)
+
+	// cache entries are dialect specific.
+	sqliteQuery, err := newQueryParser(sqlite.Dialector{}.Name()).Parse(query)
+	require.Nil(s.T(), err)
+	assert.NotSame(s.T(), firstQuery, sqliteQuery)
+	assert.Equal(s.T(), sqlite.Dialector{}.Name(), sqliteQuery.(*parsedQuery).qp.Dialector)
+
+	// as well as specific to the rest of parser configuration.
+	queryParser := newQueryParser(postgres.Dialector{}.Name())
+	queryParser.TzOffset = 60
+	offsetQuery, err := queryParser.Parse(query)
+	require.Nil(s.T(), err)
+	assert.NotSame(s.T(), firstQuery, offsetQuery)
+
+	queryParser = newQueryParser(postgres.Dialector{}.Name())
+	queryParser.DefaultContextID = 2
+	contextQuery, err := queryParser.Parse(query)
+	require.Nil(s.T(), err)
+	assert.NotSame(s.T(), firstQuery, contextQuery)
+	assert.Equal(s.T(), uint(2), contextQuery.(*parsedQuery).qp.DefaultContextID)
+
+	queryParser = newQueryParser(postgres.Dialector{}.Name())
+	queryParser.Tables["metrics"] = "metrics"
+	tablesQuery, err := queryParser.Parse(query)
+	require.Nil(s.T(), err)
+	assert.NotSame(s.T(), firstQuery, tablesQuery)
+}
+
+func (s *QueryTestSuite) Test_MaxJoins() {
+	pq := QueryParser{
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector: sqlite.Dialector{}.Name(),
+		MaxJoins:  3,
+	}
+
+	conditions := make([]string, 0, pq.MaxJoins+1)
+	for i := 0; i < pq.MaxJoins; i++ {
+		conditions = append(conditions, fmt.Sprintf("run.metrics['metric%d'].last > 0", i))
+	}
+
+	// query with exactly allowed number of joins is accepted.
+	parsedQuery, err := pq.Parse(strings.Join(conditions, " and "))
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), parsedQuery)
+
+	// subscripts of the same metric reuse the same join.
+	parsedQuery, err = pq.Parse(strings.Join(append(conditions, "run.metrics['metric0'].last < 1"), " and "))
+	require.Nil(s.T(), err)
+	require.NotNil(s.T(), parsedQuery)
+
+	// one more metric exceeds the limit.
+	q := strings.Join(append(conditions, fmt.Sprintf("run.metrics['metric%d'].last > 0", pq.MaxJoins)), " and ")
+	parsedQuery, err = pq.Parse(q)
+	require.Nil(s.T(), parsedQuery)
+	var syntaxError SyntaxError
+	require.ErrorAs(s.T(), err, &syntaxError)
+	assert.Equal(s.T(), q, syntaxError.Statement)
+	assert.Equal(s.T(), "query is too complex: it requires 4 joins, but no more than 3 are allowed", syntaxError.Err)
+}
+
+func (s *QueryTestSuite) Test_CountOnly() {
+	tests := []struct {
+		name        string
+		query       string
+		dialector   string
+		expectedSQL string
+	}{
+		{
+			name:      "TestPostgresMetricAndTag",
+			query:     `run.metrics['loss'].last < 0.5 and run.tags['env'] == 'prod'`,
+			dialector: postgres.Dialector{}.Name(),
+			expectedSQL: `SELECT COUNT(*) FROM "runs" ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`LEFT JOIN tags tags_1 ON runs.run_uuid = tags_1.run_uuid AND tags_1.key = $2 ` +
+				`WHERE ("metrics_0"."value" < $3 AND "tags_1"."value" = $4) ` +
+				`AND "runs"."lifecycle_stage" <> $5`,
+		},
+		{
+			name:      "TestSqliteMetricStepAndTag",
+			query:     `run.metrics['loss', step=5].last < 0.5 and run.tags['env'] == 'prod'`,
+			dialector: sqlite.Dialector{}.Name(),
+			expectedSQL: `SELECT COUNT(*) FROM "runs" ` +
+				`LEFT JOIN metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid ` +
+				`AND metrics_0.key = $1 AND metrics_0.step = $2 ` +
+				`LEFT JOIN tags tags_1 ON runs.run_uuid = tags_1.run_uuid AND tags_1.key = $3 ` +
+				`WHERE ("metrics_0"."value" < $4 AND "tags_1"."value" = $5) ` +
+				`AND "runs"."lifecycle_stage" <> $6`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: DefaultExpression{
+					Contains:   "run.archived",
+					Expression: "not run.archived",
+				},
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+					"metrics":     "metrics",
+				},
+				Dialector: tt.dialector,
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+
+			var count int64
+			countTx := parsedQuery.CountOnly(
+				s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+			).Find(&count)
+			require.Nil(s.T(), countTx.Error)
+			assert.Equal(s.T(), tt.expectedSQL, countTx.Statement.SQL.String())
+
+			// the count query has to have exactly the same joins and conditions as the filter query.
+			filterTx := parsedQuery.Filter(
+				s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+			).Select("ID").Find(&models.Run{})
+			require.Nil(s.T(), filterTx.Error)
+			assert.Equal(
+				s.T(),
+				strings.SplitN(filterTx.Statement.SQL.String(), " FROM ", 2)[1],
+				strings.SplitN(countTx.Statement.SQL.String(), " FROM ", 2)[1],
+			)
+			assert.Equal(s.T(), filterTx.Statement.Vars, countTx.Statement.Vars)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_FilterScopedDB() {
+	tests := []struct {
+		name         string
+		query        string
+		expectedSQL  string
+		expectedVars []any
+	}{
+		{
+			name:  "TestTagCondition",
+			query: `run.tags['env'] == 'prod'`,
+			expectedSQL: `SELECT runs.run_uuid,experiments.name FROM "runs" ` +
+				`INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id ` +
+				`LEFT JOIN tags tags_0 ON runs.run_uuid = tags_0.run_uuid AND tags_0.key = $1 ` +
+				`WHERE runs.experiment_id = $2 ` +
+				`AND ("tags_0"."value" = $3 AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []any{"env", 1, "prod", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestMetricCondition",
+			query: `run.metrics['loss'].last < 0.5`,
+			expectedSQL: `SELECT runs.run_uuid,experiments.name FROM "runs" ` +
+				`INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id ` +
+				`LEFT JOIN latest_metrics metrics_0 ON runs.run_uuid = metrics_0.run_uuid AND metrics_0.key = $1 ` +
+				`WHERE runs.experiment_id = $2 ` +
+				`AND ("metrics_0"."value" < $3 AND "runs"."lifecycle_stage" <> $4)`,
+			expectedVars: []any{"loss", 1, 0.5, models.LifecycleStageDeleted},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: DefaultExpression{
+					Contains:   "run.archived",
+					Expression: "not run.archived",
+				},
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+					"metrics":     "metrics",
+				},
+				Dialector: postgres.Dialector{}.Name(),
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+
+			// the incoming db is already scoped to an experiment and is a new session,
+			// so the filter has to build on top of it instead of modifying it in place.
+			scopedDB := s.db.Session(&gorm.Session{DryRun: true}).Model(
+				models.Run{},
+			).Select(
+				"runs.run_uuid", "experiments.name",
+			).Joins(
+				"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+			).Where(
+				"runs.experiment_id = ?", 1,
+			).Session(&gorm.Session{})
+
+			tx := parsedQuery.Filter(scopedDB).Find(&models.Run{})
+			require.Nil(s.T(), tx.Error)
+			assert.Equal(s.T(), tt.expectedSQL, tx.Statement.SQL.String())
+			assert.Equal(s.T(), tt.expectedVars, tx.Statement.Vars)
+
+			// the incoming db must stay untouched, so it can be reused by the caller.
+			tx = scopedDB.Find(&models.Run{})
+			require.Nil(s.T(), tx.Error)
+			assert.Equal(
+				s.T(),
+				`SELECT runs.run_uuid,experiments.name FROM "runs" `+
+					`INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id `+
+					`WHERE runs.experiment_id = $1`,
+				tx.Statement.SQL.String(),
+			)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_ExecutionError() {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.Nil(s.T(), err)
+
+	pq := QueryParser{
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector: sqlite.Dialector{}.Name(),
+	}
+	parsedQuery, err := pq.Parse(`run.name == 'run'`)
+	require.Nil(s.T(), err)
+
+	// table `runs` doesn't exist, so the query fails on execution.
+	var runs []models.Run
+	err = parsedQuery.Run(db.Table("runs").Select("run_uuid"), &runs)
+	var executionError ExecutionError
+	require.ErrorAs(s.T(), err, &executionError)
+	assert.True(s.T(), errors.Is(err, ExecutionError{}))
+	assert.Contains(s.T(), executionError.Err.Error(), "no such table: runs")
+	assert.Equal(
+		s.T(),
+		"SELECT `run_uuid` FROM `runs` WHERE `runs`.`name` = \"run\"",
+		executionError.SQL,
+	)
+}
+
+func (s *QueryTestSuite) Test_EmptyQuery() {
+	tests := []struct {
+		name         string
+		query        string
+		defaultExpr  DefaultExpression
+		expectedSQL  string
+		expectedVars []any
+	}{
+		{
+			name:  "TestEmptyQuery",
+			query: "",
+			defaultExpr: DefaultExpression{
+				Contains:   "run.archived",
+				Expression: "not run.archived",
+			},
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []any{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestWhitespaceQuery",
+			query: "   ",
+			defaultExpr: DefaultExpression{
+				Contains:   "run.archived",
+				Expression: "not run.archived",
+			},
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []any{models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestNewlineQuery",
+			query: "\n",
+			defaultExpr: DefaultExpression{
+				Contains:   "run.archived",
+				Expression: "not run.archived",
+			},
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."lifecycle_stage" <> $1`,
+			expectedVars: []any{models.LifecycleStageDeleted},
+		},
+		{
+			name:        "TestWhitespaceQueryWithoutDefault",
+			query:       " \t\n",
+			expectedSQL: `SELECT "run_uuid" FROM "runs"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: tt.defaultExpr,
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+				},
+				Dialector: postgres.Dialector{}.Name(),
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+			tx := parsedQuery.Filter(
+				s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+			).Select("ID").Find(&models.Run{})
+			require.Nil(s.T(), tx.Error)
+			assert.Equal(s.T(), tt.expectedSQL, tx.Statement.SQL.String())
+			assert.Equal(s.T(), tt.expectedVars, tx.Statement.Vars)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_DatetimeLiteral() {
+	tests := []struct {
+		name         string
+		query        string
+		timezone     string
+		tzOffset     int
+		expectedSQL  string
+		expectedVars []any
+	}{
+		{
+			name:         "TestDateInUTC",
+			query:        `run.created_at > '2024-01-01'`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDateInConfiguredTimezone",
+			query:        `run.created_at > '2024-01-01'`,
+			timezone:     "Europe/Berlin",
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDatetimeInUTC",
+			query:        `run.finalized_at <= '2024-01-01 12:30:00'`,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" <= $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704112200000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDatetimeInConfiguredTimezone",
+			query:        `run.finalized_at <= '2024-01-01T12:30'`,
+			timezone:     "America/New_York",
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."end_time" <= $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704112200000 + 5*60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDatetimeWithExplicitOffset",
+			query:        `run.created_at == '2024-01-01T00:00:00+02:00'`,
+			timezone:     "America/New_York",
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" = $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 2*60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestReversedDateInConfiguredTimezone",
+			query:        `'2024-01-01' < run.created_at`,
+			timezone:     "Europe/Berlin",
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDateInClientTimezone",
+			query:        `run.created_at > '2024-01-01'`,
+			tzOffset:     -120,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 2*60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestConfiguredTimezoneOverridesClientTimezone",
+			query:        `run.created_at > '2024-01-01'`,
+			timezone:     "Europe/Berlin",
+			tzOffset:     -120,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDatetimeFunctionInClientTimezone",
+			query:        `run.created_at > datetime(2024, 1, 1)`,
+			tzOffset:     -120,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 2*60*60*1000), models.LifecycleStageDeleted},
+		},
+		{
+			name:         "TestDatetimeFunctionInConfiguredTimezone",
+			query:        `run.created_at > datetime(2024, 1, 1)`,
+			timezone:     "Europe/Berlin",
+			tzOffset:     -120,
+			expectedSQL:  `SELECT "run_uuid" FROM "runs" WHERE "runs"."start_time" > $1 AND "runs"."lifecycle_stage" <> $2`,
+			expectedVars: []any{int64(1704067200000 - 60*60*1000), models.LifecycleStageDeleted},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Default: DefaultExpression{
+					Contains:   "run.archived",
+					Expression: "not run.archived",
+				},
+				Tables: map[string]string{
+					"runs":        "runs",
+					"experiments": "Experiment",
+				},
+				Dialector: postgres.Dialector{}.Name(),
+				Timezone:  tt.timezone,
+				TzOffset:  tt.tzOffset,
+			}
+			parsedQuery, err := pq.Parse(tt.query)
+			require.Nil(s.T(), err)
+			tx := parsedQuery.Filter(
+				s.db.Session(&gorm.Session{DryRun: true}).Model(models.Run{}),
+			).Select("ID").Find(&models.Run{})
+			require.Nil(s.T(), tx.Error)
+			assert.Equal(s.T(), tt.expectedSQL, tx.Statement.SQL.String())
+			assert.Equal(s.T(), tt.expectedVars, tx.Statement.Vars)
+		})
+	}
+}
+
+func (s *QueryTestSuite) Test_DatetimeLiteral_Error() {
+	tests := []struct {
+		name     string
+		query    string
+		timezone string
+		error    string
+	}{
+		{
+			name:  "TestUnsupportedLiteral",
+			query: `run.created_at > 'yesterday'`,
+			error: `unsupported datetime literal "yesterday"`,
+		},
+		{
+			name:     "TestUnsupportedTimezone",
+			query:    `run.created_at > '2024-01-01'`,
+			timezone: "Mars/Olympus",
+			error:    `unsupported timezone "Mars/Olympus"`,
+		},
+		{
+			name:     "TestUnsupportedTimezoneInDatetimeFunction",
+			query:    `run.created_at > datetime(2024, 1, 1)`,
+			timezone: "Mars/Olympus",
+			error:    `unsupported timezone "Mars/Olympus"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			pq := QueryParser{
+				Tables: map[string]string{
+					"runs": "runs",
+				},
+				Dialector: postgres.Dialector{}.Name(),
+				Timezone:  tt.timezone,
+			}
+			_, err := pq.Parse(tt.query)
+			require.NotNil(s.T(), err)
+			assert.Contains(s.T(), err.Error(), tt.error)
+		})
+	}
+}
+
+func BenchmarkQueryParser_Parse(b *testing.B) {
+	qp := QueryParser{
+		Default: DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "Experiment",
+		},
+		Dialector: postgres.Dialector{}.Name(),
+	}
+	query := `run.metrics['loss', {"subset": "val"}].last < 0.5 and run.params['lr'] == '0.01' ` +
+		`and run.name.startswith('bench') and run.created_at > datetime(2024, 1, 1)`
+
+	b.Run("Uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := qp.parse(query); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := qp.Parse(query); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
 }
@@ -48,6 +48,9 @@ func (r *Router) Init(server fiber.Router) {
 	experiments.Get("/:id/", r.controller.GetExperiment)
 	experiments.Get("/:id/activity/", r.controller.GetExperimentActivity)
 	experiments.Get("/:id/runs/", r.controller.GetExperimentRuns)
+	experiments.Get("/:id/tag-facets/", r.controller.GetExperimentTagFacets)
+	experiments.Get("/:id/metric-contexts/", r.controller.GetExperimentMetricContexts)
+	experiments.Get("/:id/metric-summary/", r.controller.GetExperimentMetricSummary)
 	experiments.Delete("/:id/", r.controller.DeleteExperiment)
 	experiments.Put("/:id/", r.controller.UpdateExperiment)
 
@@ -64,6 +67,8 @@ func (r *Router) Init(server fiber.Router) {
 	runs.Get("/search/run/", r.controller.SearchRuns)
 	runs.Post("/search/metric/", r.controller.SearchMetrics)
 	runs.Post("/search/metric/align/", r.controller.SearchAlignedMetrics)
+	runs.Post("/search/metric/pairs/", r.controller.SearchMetricPairs)
+	runs.Post("/search/metric/latest/", r.controller.SearchLatestMetrics)
 	runs.Post("/search/images/", r.controller.SearchImages)
 	runs.Get("/:id/info/", r.controller.GetRunInfo)
 	runs.Post("/:id/tags/new", r.controller.AddRunTag)
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rotisserie/eris"
+)
+
+// StateSchema represents a JSON Schema used to validate App state.
+// Only `type`, `properties`, `required`, `additionalProperties`, `items`, `enum`,
+// `minimum` and `maximum` keywords are supported, schemas with other keywords are rejected.
+type StateSchema struct {
+	Type                 string                  `json:"type"`
+	Properties           map[string]*StateSchema `json:"properties"`
+	Required             []string                `json:"required"`
+	AdditionalProperties *bool                   `json:"additionalProperties"`
+	Items                *StateSchema            `json:"items"`
+	Enum                 []any                   `json:"enum"`
+	Minimum              *float64                `json:"minimum"`
+	Maximum              *float64                `json:"maximum"`
+}
+
+// StateSchemaRegistry holds JSON Schemas for the state of apps by app type.
+type StateSchemaRegistry struct {
+	mutex   sync.RWMutex
+	schemas map[string]*StateSchema
+}
+
+// NewStateSchemaRegistry creates new empty StateSchemaRegistry instance.
+func NewStateSchemaRegistry() *StateSchemaRegistry {
+	return &StateSchemaRegistry{
+		schemas: map[string]*StateSchema{},
+	}
+}
+
+// Register registers JSON Schema for the state of apps with provided type.
+// Previously registered schema for the same type is replaced.
+func (r *StateSchemaRegistry) Register(appType string, schema []byte) error {
+	var parsed StateSchema
+	decoder := json.NewDecoder(bytes.NewReader(schema))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&parsed); err != nil {
+		return eris.Wrapf(err, "error parsing state schema for app type '%s'", appType)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.schemas[appType] = &parsed
+	return nil
+}
+
+// Get returns JSON Schema registered for provided app type.
+func (r *StateSchemaRegistry) Get(appType string) (*StateSchema, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	schema, ok := r.schemas[appType]
+	return schema, ok
+}
+
+// Validate validates provided value against the schema and returns the list of errors,
+// each of them starts with JSON path of the failing value.
+func (s StateSchema) Validate(value any) []string {
+	return s.validate("$", value)
+}
+
+// validate validates provided value located by provided path against the schema.
+func (s StateSchema) validate(path string, value any) []string {
+	if s.Type != "" && !isOfSchemaType(s.Type, value) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, s.Type, getSchemaType(value))}
+	}
+
+	var errs []string
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(item any) bool {
+		return reflect.DeepEqual(item, value)
+	}) {
+		errs = append(errs, fmt.Sprintf("%s: value %v is not one of %v", path, value, s.Enum))
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			errs = append(errs, fmt.Sprintf("%s: value %v is less than minimum %v", path, v, *s.Minimum))
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			errs = append(errs, fmt.Sprintf("%s: value %v is greater than maximum %v", path, v, *s.Maximum))
+		}
+	case []any:
+		if s.Items != nil {
+			for i, item := range v {
+				errs = append(errs, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+			}
+		}
+	case map[string]any:
+		for _, key := range s.Required {
+			if _, ok := v[key]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: missing required property", getPropertyPath(path, key)))
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if property, ok := s.Properties[key]; ok {
+				errs = append(errs, property.validate(getPropertyPath(path, key), v[key])...)
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				errs = append(errs, fmt.Sprintf("%s: additional property is not allowed", getPropertyPath(path, key)))
+			}
+		}
+	}
+	return errs
+}
+
+// getPropertyPath returns JSON path of the object property.
+func getPropertyPath(path, key string) string {
+	if key != "" && !strings.ContainsAny(key, ".[]'\" ") {
+		return fmt.Sprintf("%s.%s", path, key)
+	}
+	return fmt.Sprintf("%s['%s']", path, strings.ReplaceAll(key, "'", `\'`))
+}
+
+// isOfSchemaType checks that provided value is of provided JSON Schema type.
+func isOfSchemaType(schemaType string, value any) bool {
+	if schemaType == "integer" {
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	}
+	return getSchemaType(value) == schemaType
+}
+
+// getSchemaType returns JSON Schema type of provided decoded JSON value.
+func getSchemaType(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -13,12 +13,16 @@ import (
 // Service provides service layer to work with `app` business logic.
 type Service struct {
 	appRepository repositories.AppRepositoryProvider
+	stateSchemas  *StateSchemaRegistry
 }
 
 // NewService creates new Service instance.
-func NewService(appRepository repositories.AppRepositoryProvider) *Service {
+func NewService(
+	appRepository repositories.AppRepositoryProvider, stateSchemas *StateSchemaRegistry,
+) *Service {
 	return &Service{
 		appRepository: appRepository,
+		stateSchemas:  stateSchemas,
 	}
 }
 
@@ -40,6 +44,10 @@ func (s Service) Get(
 func (s Service) Create(
 	ctx context.Context, namespaceID uint, req *request.CreateAppRequest,
 ) (*models.App, error) {
+	if err := ValidateAppState(s.stateSchemas, req.Type, req.State); err != nil {
+		return nil, err
+	}
+
 	app := convertors.ConvertCreateAppRequestToDBModel(namespaceID, req)
 	if err := s.appRepository.Create(ctx, app); err != nil {
 		return nil, api.NewInternalError("unable to create app: %v", err)
@@ -51,6 +59,10 @@ func (s Service) Create(
 func (s Service) Update(
 	ctx context.Context, namespaceID uint, req *request.UpdateAppRequest,
 ) (*models.App, error) {
+	if err := ValidateAppState(s.stateSchemas, req.Type, req.State); err != nil {
+		return nil, err
+	}
+
 	app, err := s.appRepository.GetByNamespaceIDAndAppID(ctx, namespaceID, req.ID.String())
 	if err != nil {
 		return nil, api.NewInternalError("unable to find app by id %s: %s", req.ID, err)
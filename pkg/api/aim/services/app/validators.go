@@ -0,0 +1,23 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// ValidateAppState validates app state against JSON Schema registered for the app type.
+// State of apps with types without registered schema is not validated.
+func ValidateAppState(stateSchemas *StateSchemaRegistry, appType string, state request.AppState) error {
+	schema, ok := stateSchemas.Get(appType)
+	if !ok {
+		return nil
+	}
+	if errs := schema.Validate(map[string]any(state)); len(errs) > 0 {
+		return api.NewInvalidParameterValueError(
+			"invalid state for app type '%s': %s", appType, strings.Join(errs, "; "),
+		)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 )
 
 // Service provides service layer to work with `experiment` business logic.
@@ -72,6 +73,71 @@ func (s Service) GetExperimentActivity(
 	return activity, nil
 }
 
+// GetExperimentTagFacets returns distinct run tag values of requested experiment grouped by tag key.
+func (s Service) GetExperimentTagFacets(
+	ctx context.Context, namespaceID uint, req *request.GetExperimentTagFacetsRequest,
+) (models.ExperimentTagFacets, error) {
+	experiment, err := s.experimentRepository.GetExperimentByNamespaceIDAndExperimentID(ctx, namespaceID, req.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find experiment by id %d: %s", req.ID, err)
+	}
+	if experiment == nil {
+		return nil, api.NewResourceDoesNotExistError("experiment '%d' not found", req.ID)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = common.DefaultTagFacetValuesLimit
+	}
+	facets, err := s.experimentRepository.GetExperimentTagFacets(ctx, namespaceID, *experiment.ID, limit)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get experiment tag facets: %s", err)
+	}
+	return facets, nil
+}
+
+// GetExperimentMetricContexts returns distinct contexts of requested metric logged in the experiment.
+func (s Service) GetExperimentMetricContexts(
+	ctx context.Context, namespaceID uint, req *request.GetExperimentMetricContextsRequest,
+) ([]models.Context, error) {
+	if req.Key == "" {
+		return nil, api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+
+	experiment, err := s.experimentRepository.GetExperimentByNamespaceIDAndExperimentID(ctx, namespaceID, req.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find experiment by id %d: %s", req.ID, err)
+	}
+	if experiment == nil {
+		return nil, api.NewResourceDoesNotExistError("experiment '%d' not found", req.ID)
+	}
+
+	contexts, err := s.experimentRepository.GetExperimentMetricContexts(ctx, namespaceID, *experiment.ID, req.Key)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get experiment metric contexts: %s", err)
+	}
+	return contexts, nil
+}
+
+// GetExperimentMetricSummary returns aggregates of the latest metric values logged in the experiment.
+func (s Service) GetExperimentMetricSummary(
+	ctx context.Context, namespaceID uint, req *request.GetExperimentMetricSummaryRequest,
+) ([]models.ExperimentMetricSummary, error) {
+	experiment, err := s.experimentRepository.GetExperimentByNamespaceIDAndExperimentID(ctx, namespaceID, req.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find experiment by id %d: %s", req.ID, err)
+	}
+	if experiment == nil {
+		return nil, api.NewResourceDoesNotExistError("experiment '%d' not found", req.ID)
+	}
+
+	summaries, err := s.experimentRepository.GetExperimentMetricSummary(ctx, namespaceID, *experiment.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get experiment metric summary: %s", err)
+	}
+	return summaries, nil
+}
+
 // GetExperimentRuns returns list of runs related to requested experiment.
 func (s Service) GetExperimentRuns(
 	ctx context.Context, namespaceID uint, req *request.GetExperimentRunsRequest,
@@ -104,7 +170,7 @@ func (s Service) UpdateExperiment(
 
 	experiment = convertors.ConvertUpdateExperimentToDBModel(req, experiment)
 	if req.Archived != nil || req.Name != nil {
-		if err := s.experimentRepository.Update(ctx, experiment); err != nil {
+		if err := s.experimentRepository.Update(ctx, experiment, middleware.GetActorFromContext(ctx)); err != nil {
 			return api.NewInternalError("unable to update experiment %q: %s", req.ID, err)
 		}
 	}
@@ -136,7 +202,7 @@ func (s Service) DeleteExperiment(
 		return api.NewBadRequestError("unable to delete default experiment")
 	}
 
-	if err := s.experimentRepository.Delete(ctx, experiment); err != nil {
+	if err := s.experimentRepository.Delete(ctx, experiment, middleware.GetActorFromContext(ctx)); err != nil {
 		return api.NewInternalError("unable to delete experiment by id %d: %s", req.ID, err)
 	}
 
@@ -17,7 +17,10 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	commonRepositories "github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
 )
 
@@ -30,6 +33,7 @@ const (
 
 // Service provides service layer to work with `run` business logic.
 type Service struct {
+	config                 *config.Config
 	runRepository          repositories.RunRepositoryProvider
 	logRepository          repositories.LogRepositoryProvider
 	metricRepository       repositories.MetricRepositoryProvider
@@ -37,10 +41,12 @@ type Service struct {
 	sharedTagRepository    repositories.SharedTagRepositoryProvider
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider
 	artifactRepository     repositories.ArtifactRepositoryProvider
+	searchRunsFilter       commonRepositories.SearchRunsFilter
 }
 
 // NewService creates new Service instance.
 func NewService(
+	config *config.Config,
 	runRepository repositories.RunRepositoryProvider,
 	logRepository repositories.LogRepositoryProvider,
 	metricRepository repositories.MetricRepositoryProvider,
@@ -48,8 +54,10 @@ func NewService(
 	sharedTagRepository repositories.SharedTagRepositoryProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 	artifactRepository repositories.ArtifactRepositoryProvider,
+	searchRunsFilter commonRepositories.SearchRunsFilter,
 ) *Service {
 	return &Service{
+		config:                 config,
 		runRepository:          runRepository,
 		logRepository:          logRepository,
 		metricRepository:       metricRepository,
@@ -57,6 +65,7 @@ func NewService(
 		sharedTagRepository:    sharedTagRepository,
 		artifactStorageFactory: artifactStorageFactory,
 		artifactRepository:     artifactRepository,
+		searchRunsFilter:       searchRunsFilter,
 	}
 }
 
@@ -117,6 +126,60 @@ func (s Service) GetRunMetrics(
 	return metrics, metricKeysMap, nil
 }
 
+// SearchLatestMetrics returns the latest metric values for the requested runs in the requested order.
+// Runs which don't exist in the namespace are skipped.
+func (s Service) SearchLatestMetrics(
+	ctx context.Context, namespaceID uint, req *request.SearchLatestMetricsRequest,
+) ([]models.RunLatestMetric, error) {
+	if err := ValidateSearchLatestMetricsRequest(req); err != nil {
+		return nil, err
+	}
+
+	// the metric without context is requested by default.
+	metricContext := types.JSONB(`{}`)
+	if req.Context != nil {
+		data, err := json.Marshal(req.Context)
+		if err != nil {
+			return nil, api.NewBadRequestError("unable to serialize context: %s", err)
+		}
+		metricContext = data
+	}
+
+	metrics, err := s.metricRepository.GetLatestMetrics(ctx, namespaceID, req.RunIDs, req.Name, metricContext)
+	if err != nil {
+		return nil, api.NewInternalError("error getting latest metrics: %s", err)
+	}
+
+	metricsByRunID := make(map[string]models.RunLatestMetric, len(metrics))
+	for _, metric := range metrics {
+		metricsByRunID[metric.RunID] = metric
+	}
+	result := make([]models.RunLatestMetric, 0, len(metrics))
+	for _, runID := range req.RunIDs {
+		if metric, ok := metricsByRunID[runID]; ok {
+			result = append(result, metric)
+			delete(metricsByRunID, runID)
+		}
+	}
+	return result, nil
+}
+
+// SearchMetricPairs returns values of two metrics paired by step for the requested runs.
+func (s Service) SearchMetricPairs(
+	ctx context.Context, namespaceID uint, req *request.SearchMetricPairsRequest,
+) ([]models.MetricPair, error) {
+	if err := ValidateSearchMetricPairsRequest(req); err != nil {
+		return nil, err
+	}
+
+	pairs, err := s.metricRepository.GetMetricPairs(ctx, namespaceID, req.RunIDs, req.XMetric, req.YMetric)
+	if err != nil {
+		return nil, api.NewInternalError("error getting metric pairs: %s", err)
+	}
+
+	return pairs, nil
+}
+
 // GetRunImages returns run images.
 func (s Service) GetRunImages(
 	ctx context.Context, namespaceID uint, runID string, req *request.GetRunImagesRequest,
@@ -208,22 +271,44 @@ func (s Service) GetRunsActive(
 	return runs, nil
 }
 
-// SearchRuns returns the list of runs by provided search criteria.
+// SearchRuns returns the list of runs by provided search criteria and the offset of the next page,
+// which is empty when there are no more runs.
 func (s Service) SearchRuns(
 	ctx context.Context, namespaceID uint, tzOffset int, req request.SearchRunsRequest,
-) ([]models.Run, int64, error) {
-	runs, total, err := s.runRepository.SearchRuns(ctx, namespaceID, tzOffset, req)
+) ([]models.Run, int64, string, error) {
+	// runs are paged by default, except of the export, which only respects the hard cap.
+	if req.Action == "export" {
+		req.Limit = s.config.LimitSearchMaxResults(req.Limit)
+	} else {
+		req.Limit = s.config.GetSearchMaxResults(req.Limit)
+	}
+	runs, total, err := s.runRepository.SearchRuns(
+		ctx, namespaceID, tzOffset, s.config.SearchMaxQueryJoins, s.config.SearchTimezone, s.searchRunsFilter, req,
+	)
 	if err != nil {
-		return nil, 0, api.NewInternalError("error searching runs: %s", err)
+		return nil, 0, "", api.NewInternalError("error searching runs: %s", err)
+	}
+
+	var nextOffset string
+	if req.Limit > 0 && len(runs) == req.Limit {
+		nextOffset = runs[len(runs)-1].ID
 	}
-	return runs, total, nil
+	return runs, total, nextOffset, nil
 }
 
 // SearchMetrics returns the list of metrics by provided search criteria.
 func (s Service) SearchMetrics(
 	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchMetricsRequest,
 ) (*sql.Rows, int64, repositories.SearchResultMap, error) {
-	rows, total, searchResult, err := s.metricRepository.SearchMetrics(ctx, namespaceID, timeZoneOffset, req)
+	rows, total, searchResult, err := s.metricRepository.SearchMetrics(
+		ctx,
+		namespaceID,
+		timeZoneOffset,
+		s.config.SearchMaxQueryJoins,
+		s.config.SearchTimezone,
+		s.searchRunsFilter,
+		req,
+	)
 	if err != nil {
 		return nil, 0, nil, api.NewInternalError("error searching runs: %s", err)
 	}
@@ -234,7 +319,15 @@ func (s Service) SearchMetrics(
 func (s Service) SearchArtifacts(
 	ctx context.Context, namespaceID uint, timeZoneOffset int, req request.SearchArtifactsRequest,
 ) (*sql.Rows, map[string]models.Run, repositories.ArtifactSearchSummary, error) {
-	rows, runs, result, err := s.artifactRepository.Search(ctx, namespaceID, timeZoneOffset, req)
+	rows, runs, result, err := s.artifactRepository.Search(
+		ctx,
+		namespaceID,
+		timeZoneOffset,
+		s.config.SearchMaxQueryJoins,
+		s.config.SearchTimezone,
+		s.searchRunsFilter,
+		req,
+	)
 	if err != nil {
 		return nil, nil, nil, api.NewInternalError("error searching artifacts: %s", err)
 	}
@@ -296,7 +389,9 @@ func (s Service) DeleteRun(
 		return api.NewResourceDoesNotExistError("run '%s' not found", req.ID)
 	}
 
-	if err = s.runRepository.DeleteBatch(ctx, namespaceID, []string{run.ID}); err != nil {
+	if err = s.runRepository.DeleteBatch(
+		ctx, namespaceID, []string{run.ID}, middleware.GetActorFromContext(ctx),
+	); err != nil {
 		return api.NewInternalError("unable to delete run %q: %s", req.ID, err)
 	}
 	return nil
@@ -317,11 +412,15 @@ func (s Service) UpdateRun(
 
 	if req.Archived != nil {
 		if *req.Archived {
-			if err := s.runRepository.ArchiveBatch(ctx, namespaceID, []string{run.ID}); err != nil {
+			if err := s.runRepository.ArchiveBatch(
+				ctx, namespaceID, []string{run.ID}, middleware.GetActorFromContext(ctx),
+			); err != nil {
 				return api.NewInternalError("error archiving run %s: %s", req.ID, err)
 			}
 		} else {
-			if err := s.runRepository.RestoreBatch(ctx, namespaceID, []string{run.ID}); err != nil {
+			if err := s.runRepository.RestoreBatch(
+				ctx, namespaceID, []string{run.ID}, middleware.GetActorFromContext(ctx),
+			); err != nil {
 				return api.NewInternalError("error restoring run %s: %s", req.ID, err)
 			}
 		}
@@ -352,15 +451,15 @@ func (s Service) ProcessBatch(
 ) error {
 	switch action {
 	case BatchActionArchive:
-		if err := s.runRepository.ArchiveBatch(ctx, namespaceID, ids); err != nil {
+		if err := s.runRepository.ArchiveBatch(ctx, namespaceID, ids, middleware.GetActorFromContext(ctx)); err != nil {
 			return api.NewInternalError("error archiving runs: %s", err)
 		}
 	case BatchActionRestore:
-		if err := s.runRepository.RestoreBatch(ctx, namespaceID, ids); err != nil {
+		if err := s.runRepository.RestoreBatch(ctx, namespaceID, ids, middleware.GetActorFromContext(ctx)); err != nil {
 			return api.NewInternalError("error restoring runs: %s", err)
 		}
 	case BatchActionDelete:
-		if err := s.runRepository.DeleteBatch(ctx, namespaceID, ids); err != nil {
+		if err := s.runRepository.DeleteBatch(ctx, namespaceID, ids, middleware.GetActorFromContext(ctx)); err != nil {
 			return api.NewInternalError("error deleting runs: %s", err)
 		}
 	default:
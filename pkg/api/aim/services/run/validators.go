@@ -28,3 +28,28 @@ func ValidateGetRunInfoRequest(req *request.GetRunInfoRequest) error {
 	}
 	return nil
 }
+
+// ValidateSearchMetricPairsRequest validates `POST /runs/search/metric/pairs` request.
+func ValidateSearchMetricPairsRequest(req *request.SearchMetricPairsRequest) error {
+	if len(req.RunIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_ids'")
+	}
+	if req.XMetric == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'x_metric'")
+	}
+	if req.YMetric == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'y_metric'")
+	}
+	return nil
+}
+
+// ValidateSearchLatestMetricsRequest validates `POST /runs/search/metric/latest` request.
+func ValidateSearchLatestMetricsRequest(req *request.SearchLatestMetricsRequest) error {
+	if len(req.RunIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_ids'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
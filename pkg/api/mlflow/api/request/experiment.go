@@ -15,19 +15,28 @@ type CreateExperimentRequest struct {
 
 // UpdateExperimentRequest is a request object for `POST /mlflow/experiments/update` endpoint.
 type UpdateExperimentRequest struct {
-	ID   string `json:"experiment_id"`
-	Name string `json:"new_name"`
+	ID               string `json:"experiment_id"`
+	Name             string `json:"new_name"`
+	ArtifactLocation string `json:"artifact_location"`
+}
+
+// CloneExperimentRequest is a request object for `POST /mlflow/experiments/clone` endpoint.
+type CloneExperimentRequest struct {
+	SourceID string `json:"source_experiment_id"`
+	Name     string `json:"name"`
 }
 
 // GetExperimentRequest is a request object for `POST /mlflow/experiments/update` endpoint.
 type GetExperimentRequest struct {
-	ID   string `query:"experiment_id"`
-	Name string `query:"experiment_name"`
+	ID              string `query:"experiment_id"`
+	Name            string `query:"experiment_name"`
+	CaseInsensitive bool   `query:"case_insensitive"`
 }
 
 // DeleteExperimentRequest is a request object for `POST /mlflow/experiments/delete` endpoint.
 type DeleteExperimentRequest struct {
-	ID string `json:"experiment_id"`
+	ID     string `json:"experiment_id"`
+	DryRun bool   `json:"dry_run" query:"dry_run"`
 }
 
 // RestoreExperimentRequest is a request object for `POST /mlflow/experiments/restore` endpoint.
@@ -35,6 +44,16 @@ type RestoreExperimentRequest struct {
 	ID string `json:"experiment_id"`
 }
 
+// GetExperimentsBatchRequest is a request object for `POST /mlflow/experiments/batch-get` endpoint.
+type GetExperimentsBatchRequest struct {
+	IDs []string `json:"experiment_ids"`
+}
+
+// RestoreExperimentsBatchRequest is a request object for `POST /mlflow/experiments/batch-restore` endpoint.
+type RestoreExperimentsBatchRequest struct {
+	IDs []string `json:"experiment_ids"`
+}
+
 // SetExperimentTagRequest is a request object for `POST /mlflow/experiments/set-experiment-tag` endpoint.
 type SetExperimentTagRequest struct {
 	ID    string `json:"experiment_id"`
@@ -42,6 +61,12 @@ type SetExperimentTagRequest struct {
 	Value string `json:"value"`
 }
 
+// SetExperimentTagsRequest is a request object for `POST /mlflow/experiments/set-experiment-tags` endpoint.
+type SetExperimentTagsRequest struct {
+	ID   string                        `json:"experiment_id"`
+	Tags []ExperimentTagPartialRequest `json:"tags"`
+}
+
 // SearchExperimentsRequest is a request object for
 // `POST /mlflow/experiments/list` or `POST /mlflow/experiments/search` or `GET /mlflow/experiments/search` endpoints.
 type SearchExperimentsRequest struct {
@@ -21,6 +21,7 @@ type MetricPartialRequest struct {
 	Timestamp int64          `json:"timestamp"`
 	Step      int64          `json:"step"`
 	Context   map[string]any `json:"context"`
+	System    bool           `json:"system"`
 }
 
 // LogParamRequest is a request object for `POST mlflow/runs/log-parameter` endpoint.
@@ -41,6 +42,12 @@ func (r LogParamRequest) GetRunID() string {
 	return r.RunUUID
 }
 
+// LogParamsRequest is a request object for `POST mlflow/runs/log-params` endpoint.
+type LogParamsRequest struct {
+	RunID  string                `json:"run_id"`
+	Params []ParamPartialRequest `json:"params"`
+}
+
 // LogMetricRequest is a request object for `POST mlflow/runs/log-metric` endpoint.
 type LogMetricRequest struct {
 	RunID     string         `json:"run_id"`
@@ -50,6 +57,7 @@ type LogMetricRequest struct {
 	Timestamp int64          `json:"timestamp"`
 	Step      int64          `json:"step"`
 	Context   map[string]any `json:"context"`
+	System    bool           `json:"system"`
 }
 
 // GetRunID returns Run ID.
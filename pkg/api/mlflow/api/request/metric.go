@@ -1,10 +1,40 @@
 package request
 
+// MetricHistoryFill represents the way gaps between steps of metric history are filled.
+type MetricHistoryFill string
+
+// Supported list of MetricHistoryFill.
+const (
+	MetricHistoryFillNone    MetricHistoryFill = "none"
+	MetricHistoryFillForward MetricHistoryFill = "forward"
+)
+
+// MetricHistoryXAxis represents the axis metric history is plotted against.
+type MetricHistoryXAxis string
+
+// Supported list of MetricHistoryXAxis.
+const (
+	MetricHistoryXAxisStep MetricHistoryXAxis = "step"
+	MetricHistoryXAxisTime MetricHistoryXAxis = "time"
+)
+
 // GetMetricHistoryRequest is a request object for `GET /mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryRequest struct {
-	RunID     string `query:"run_id"`
-	RunUUID   string `query:"run_uuid"`
-	MetricKey string `query:"metric_key"`
+	RunID     string            `query:"run_id"`
+	RunUUID   string            `query:"run_uuid"`
+	MetricKey string            `query:"metric_key"`
+	StartStep *int64            `query:"start_step"`
+	EndStep   *int64            `query:"end_step"`
+	StartTime *int64            `query:"start_time"`
+	EndTime   *int64            `query:"end_time"`
+	Smoothing *float64          `query:"smoothing"`
+	MaxPoints int               `query:"max_points"`
+	Precision int               `query:"precision"`
+	Fill      MetricHistoryFill `query:"fill"`
+	// GroupByContext groups JSON history into a separate series per metric context.
+	GroupByContext bool `query:"group_by_context"`
+	// XAxis orders metric history by step or by timestamp, which matters when several values share a step.
+	XAxis MetricHistoryXAxis `query:"x_axis"`
 }
 
 // GetRunID returns Run RunID.
@@ -20,6 +50,7 @@ type GetMetricHistoryBulkRequest struct {
 	RunIDs     []string `query:"run_id"`
 	MetricKey  string   `query:"metric_key"`
 	MaxResults int      `query:"max_results"`
+	Precision  int      `query:"precision"`
 }
 
 // GetMetricHistoriesRequest is a request object for `POST /mlflow/metrics/get-histories` endpoint.
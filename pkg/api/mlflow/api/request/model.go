@@ -0,0 +1,30 @@
+package request
+
+// CreateRegisteredModelRequest is a request object for `POST /mlflow/registered-models/create` endpoint.
+type CreateRegisteredModelRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetRegisteredModelRequest is a request object for `GET /mlflow/registered-models/get` endpoint.
+type GetRegisteredModelRequest struct {
+	Name string `query:"name"`
+}
+
+// CreateModelVersionRequest is a request object for `POST /mlflow/model-versions/create` endpoint.
+// Source is either `runs:/<run_id>/<path>` URI, path relative to the run artifact location,
+// or absolute URI inside the run artifact location.
+type CreateModelVersionRequest struct {
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	RunID       string `json:"run_id"`
+	Description string `json:"description"`
+}
+
+// TransitionModelVersionStageRequest is a request object for `POST /mlflow/model-versions/transition-stage` endpoint.
+type TransitionModelVersionStageRequest struct {
+	Name                    string `json:"name"`
+	Version                 string `json:"version"`
+	Stage                   string `json:"stage"`
+	ArchiveExistingVersions bool   `json:"archive_existing_versions"`
+}
@@ -1,5 +1,10 @@
 package request
 
+import (
+	"slices"
+	"strings"
+)
+
 // RunTagPartialRequest is a partial request object for different requests.
 type RunTagPartialRequest struct {
 	Key   string `json:"key"`
@@ -10,6 +15,33 @@ type RunTagPartialRequest struct {
 type GetRunRequest struct {
 	RunID   string `query:"run_id"`
 	RunUUID string `query:"run_uuid"`
+	Fields  string `query:"fields"`
+}
+
+// Supported values of the GetRunRequest `fields` parameter.
+const (
+	RunFieldInfo    = "info"
+	RunFieldParams  = "params"
+	RunFieldTags    = "tags"
+	RunFieldMetrics = "metrics"
+)
+
+// GetFields returns the list of requested fields.
+func (r GetRunRequest) GetFields() []string {
+	if strings.TrimSpace(r.Fields) == "" {
+		return nil
+	}
+	fields := strings.Split(r.Fields, ",")
+	for i, field := range fields {
+		fields[i] = strings.TrimSpace(field)
+	}
+	return fields
+}
+
+// HasField checks if the field has been requested. All the fields are requested by default.
+func (r GetRunRequest) HasField(field string) bool {
+	fields := r.GetFields()
+	return fields == nil || slices.Contains(fields, field)
 }
 
 // GetRunID returns Run RunID.
@@ -22,11 +54,13 @@ func (r GetRunRequest) GetRunID() string {
 
 // CreateRunRequest is a request object for `POST /mlflow/runs/create` endpoint.
 type CreateRunRequest struct {
-	ExperimentID string                 `json:"experiment_id"`
-	UserID       string                 `json:"user_id"`
-	Name         string                 `json:"run_name"`
-	StartTime    int64                  `json:"start_time"`
-	Tags         []RunTagPartialRequest `json:"tags"`
+	ExperimentID   string                 `json:"experiment_id"`
+	ExperimentName string                 `json:"experiment_name"`
+	UserID         string                 `json:"user_id"`
+	Name           string                 `json:"run_name"`
+	StartTime      int64                  `json:"start_time"`
+	Tags           []RunTagPartialRequest `json:"tags"`
+	IdempotencyKey string                 `json:"idempotency_key"`
 }
 
 // UpdateRunRequest is a request object for `POST /mlflow/runs/update` endpoint.
@@ -46,6 +80,23 @@ func (r UpdateRunRequest) GetRunID() string {
 	return r.RunUUID
 }
 
+// FinalizeRunRequest is a request object for `POST /mlflow/runs/finalize` endpoint.
+type FinalizeRunRequest struct {
+	RunID   string                 `json:"run_id"`
+	RunUUID string                 `json:"run_uuid"`
+	Status  string                 `json:"status"`
+	EndTime int64                  `json:"end_time"`
+	Tags    []RunTagPartialRequest `json:"tags"`
+}
+
+// GetRunID returns Run RunID.
+func (r FinalizeRunRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
 // SearchRunsRequest is a request object for `POST /mlflow/runs/search` endpoint.
 type SearchRunsRequest struct {
 	ExperimentIDs []string `json:"experiment_ids"`
@@ -63,7 +114,8 @@ type RestoreRunRequest struct {
 
 // DeleteRunRequest is a request object for `POST /mlflow/runs/delete` endpoint.
 type DeleteRunRequest struct {
-	RunID string `json:"run_id"`
+	RunID  string `json:"run_id"`
+	DryRun bool   `json:"dry_run" query:"dry_run"`
 }
 
 // SetRunTagRequest is a request object for `POST /mlflow/runs/set-tag` endpoint.
@@ -87,3 +139,17 @@ type DeleteRunTagRequest struct {
 	RunID string `json:"run_id"`
 	Key   string `json:"key"`
 }
+
+// GetRunLineageRequest is a request object for `GET /mlflow/runs/lineage` endpoint.
+type GetRunLineageRequest struct {
+	RunID   string `query:"run_id"`
+	RunUUID string `query:"run_uuid"`
+}
+
+// GetRunID returns Run RunID.
+func (r GetRunLineageRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
@@ -26,6 +26,7 @@ type ExperimentPartialResponse struct {
 	LifecycleStage   string                         `json:"lifecycle_stage"`
 	LastUpdateTime   int64                          `json:"last_update_time"`
 	CreationTime     int64                          `json:"creation_time"`
+	LastActivity     int64                          `json:"last_activity,omitempty"`
 	Tags             []ExperimentTagPartialResponse `json:"tags"`
 }
 
@@ -41,6 +42,24 @@ func NewCreateExperimentResponse(experiment *models.Experiment) *CreateExperimen
 	}
 }
 
+// DeleteExperimentDryRunResponse is a response object for `POST /mlflow/experiments/delete?dry_run=true` endpoint.
+type DeleteExperimentDryRunResponse struct {
+	DryRun      bool  `json:"dry_run"`
+	Experiments int64 `json:"experiments"`
+	Runs        int64 `json:"runs"`
+	Metrics     int64 `json:"metrics"`
+}
+
+// NewDeleteExperimentDryRunResponse creates new DeleteExperimentDryRunResponse object.
+func NewDeleteExperimentDryRunResponse(impact *models.DeletionImpact) *DeleteExperimentDryRunResponse {
+	return &DeleteExperimentDryRunResponse{
+		DryRun:      true,
+		Experiments: impact.Experiments,
+		Runs:        impact.Runs,
+		Metrics:     impact.Metrics,
+	}
+}
+
 // GetExperimentResponse is a response object for `GET /mlflow/experiments/get` endpoint.
 type GetExperimentResponse struct {
 	Experiment *ExperimentPartialResponse `json:"experiment"`
@@ -53,6 +72,22 @@ func NewExperimentResponse(experiment *models.Experiment) *GetExperimentResponse
 	}
 }
 
+// GetExperimentsBatchResponse is a response object for `POST /mlflow/experiments/batch-get` endpoint.
+type GetExperimentsBatchResponse struct {
+	Experiments []*ExperimentPartialResponse `json:"experiments"`
+}
+
+// NewGetExperimentsBatchResponse creates new GetExperimentsBatchResponse object.
+func NewGetExperimentsBatchResponse(experiments []models.Experiment) *GetExperimentsBatchResponse {
+	resp := GetExperimentsBatchResponse{
+		Experiments: make([]*ExperimentPartialResponse, len(experiments)),
+	}
+	for n := range experiments {
+		resp.Experiments[n] = NewExperimentPartialResponse(&experiments[n])
+	}
+	return &resp
+}
+
 // SearchExperimentsResponse is a response object for `GET /mlflow/experiments/search` endpoint.
 type SearchExperimentsResponse struct {
 	Experiments   []*ExperimentPartialResponse `json:"experiments"`
@@ -106,6 +141,19 @@ func NewExperimentPartialResponse(experiment *models.Experiment) *ExperimentPart
 		LifecycleStage:   string(experiment.LifecycleStage),
 		LastUpdateTime:   experiment.LastUpdateTime.Int64,
 		CreationTime:     experiment.CreationTime.Int64,
+		LastActivity:     experiment.LastActivity.Int64,
 		Tags:             tags,
 	}
 }
+
+// RestoreExperimentResultPartialResponse is a partial response object for the result of the experiment restore.
+type RestoreExperimentResultPartialResponse struct {
+	ID       string `json:"experiment_id"`
+	Restored bool   `json:"restored"`
+	Error    string `json:"error,omitempty"`
+}
+
+// RestoreExperimentsBatchResponse is a response object for `POST /mlflow/experiments/batch-restore` endpoint.
+type RestoreExperimentsBatchResponse struct {
+	Results []RestoreExperimentResultPartialResponse `json:"results"`
+}
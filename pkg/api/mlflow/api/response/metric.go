@@ -1,10 +1,14 @@
 package response
 
 import (
+	"cmp"
 	"encoding/json"
+	"math"
+	"slices"
 
 	"github.com/rotisserie/eris"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -30,11 +34,16 @@ type MetricPartialResponse struct {
 
 // GetMetricHistoryResponse is a response object for `GET mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryResponse struct {
-	Metrics []MetricPartialResponse `json:"metrics"`
+	Metrics         []MetricPartialResponse `json:"metrics"`
+	SmoothedMetrics []MetricPartialResponse `json:"smoothed_metrics,omitempty"`
 }
 
 // NewMetricHistoryResponse creates new GetMetricHistoryResponse object.
-func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryResponse, error) {
+// When `smoothing` is provided, the response additionally contains EMA smoothed series.
+// When `precision` is positive, values are rounded to that number of significant digits.
+func NewMetricHistoryResponse(
+	metrics []models.Metric, smoothing *float64, precision int,
+) (*GetMetricHistoryResponse, error) {
 	resp := GetMetricHistoryResponse{
 		Metrics: make([]MetricPartialResponse, len(metrics)),
 	}
@@ -44,7 +53,7 @@ func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryRespons
 		resp.Metrics[n] = MetricPartialResponse{
 			Key:       m.Key,
 			Step:      m.Step,
-			Value:     m.Value,
+			Value:     common.RoundToSignificantDigits(m.Value, precision),
 			Timestamp: m.Timestamp,
 		}
 
@@ -64,6 +73,81 @@ func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryRespons
 			resp.Metrics[n].Value = common.NANValue
 		}
 	}
+
+	if smoothing != nil {
+		values := make([]float64, len(metrics))
+		for n, m := range metrics {
+			values[n] = m.Value
+			if m.IsNan {
+				values[n] = math.NaN()
+			}
+		}
+		resp.SmoothedMetrics = make([]MetricPartialResponse, len(metrics))
+		for n, value := range common.ExponentialMovingAverage(values, *smoothing) {
+			resp.SmoothedMetrics[n] = resp.Metrics[n]
+			resp.SmoothedMetrics[n].Value = common.RoundToSignificantDigits(value, precision)
+			if math.IsNaN(value) {
+				resp.SmoothedMetrics[n].Value = common.NANValue
+			}
+		}
+	}
+	return &resp, nil
+}
+
+// MetricHistorySeriesPartialResponse is a partial response object for GetMetricHistoryByContextResponse.
+type MetricHistorySeriesPartialResponse struct {
+	Context         map[string]any          `json:"context"`
+	Metrics         []MetricPartialResponse `json:"metrics"`
+	SmoothedMetrics []MetricPartialResponse `json:"smoothed_metrics,omitempty"`
+}
+
+// GetMetricHistoryByContextResponse is a response object for
+// `GET mlflow/metrics/get-history?group_by_context=true` endpoint.
+type GetMetricHistoryByContextResponse struct {
+	Series []MetricHistorySeriesPartialResponse `json:"series"`
+}
+
+// NewMetricHistoryByContextResponse creates new GetMetricHistoryByContextResponse object.
+// Series follow the order in which their contexts first appear, points of every series are ordered by step,
+// or by timestamp when `xAxis` is time.
+// `smoothing` and `precision` are applied to every series the same way as NewMetricHistoryResponse does.
+func NewMetricHistoryByContextResponse(
+	metrics []models.Metric, smoothing *float64, precision int, xAxis request.MetricHistoryXAxis,
+) (*GetMetricHistoryByContextResponse, error) {
+	var contexts []string
+	groups := map[string][]models.Metric{}
+	for _, m := range metrics {
+		hash := m.Context.GetJsonHash()
+		if _, ok := groups[hash]; !ok {
+			contexts = append(contexts, hash)
+		}
+		groups[hash] = append(groups[hash], m)
+	}
+
+	resp := GetMetricHistoryByContextResponse{
+		Series: make([]MetricHistorySeriesPartialResponse, len(contexts)),
+	}
+	for n, hash := range contexts {
+		group := groups[hash]
+		slices.SortStableFunc(group, func(a, b models.Metric) int {
+			if xAxis == request.MetricHistoryXAxisTime && a.Timestamp != b.Timestamp {
+				return cmp.Compare(a.Timestamp, b.Timestamp)
+			}
+			if a.Step != b.Step {
+				return cmp.Compare(a.Step, b.Step)
+			}
+			return cmp.Compare(a.Timestamp, b.Timestamp)
+		})
+		history, err := NewMetricHistoryResponse(group, smoothing, precision)
+		if err != nil {
+			return nil, err
+		}
+		resp.Series[n] = MetricHistorySeriesPartialResponse{
+			Context:         history.Metrics[0].Context,
+			Metrics:         history.Metrics,
+			SmoothedMetrics: history.SmoothedMetrics,
+		}
+	}
 	return &resp, nil
 }
 
@@ -73,7 +157,8 @@ type GetMetricHistoryBulkResponse struct {
 }
 
 // NewMetricHistoryBulkResponse creates new GetMetricHistoryBulkResponse object.
-func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulkResponse {
+// When `precision` is positive, values are rounded to that number of significant digits.
+func NewMetricHistoryBulkResponse(metrics []models.Metric, precision int) *GetMetricHistoryBulkResponse {
 	resp := GetMetricHistoryBulkResponse{
 		Metrics: make([]MetricPartialResponseBulk, len(metrics)),
 	}
@@ -83,7 +168,7 @@ func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulk
 			RunID:     m.RunID,
 			Key:       m.Key,
 			Step:      m.Step,
-			Value:     m.Value,
+			Value:     common.RoundToSignificantDigits(m.Value, precision),
 			Timestamp: m.Timestamp,
 		}
 		if m.IsNan {
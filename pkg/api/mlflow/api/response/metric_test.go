@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -14,6 +15,8 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 	testData := []struct {
 		name             string
 		metrics          []models.Metric
+		smoothing        *float64
+		precision        int
 		expectedResponse *GetMetricHistoryResponse
 	}{
 		{
@@ -99,11 +102,154 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithSmoothing",
+			metrics: []models.Metric{
+				{Key: "key", Value: 10, Timestamp: 1, Step: 1, Context: models.DefaultContext},
+				{Key: "key", Value: 0, Timestamp: 2, Step: 2, IsNan: true, Context: models.DefaultContext},
+				{Key: "key", Value: 20, Timestamp: 3, Step: 3, Context: models.DefaultContext},
+			},
+			smoothing: common.GetPointer(0.5),
+			expectedResponse: &GetMetricHistoryResponse{
+				Metrics: []MetricPartialResponse{
+					{Key: "key", Timestamp: 1, Step: 1, Value: 10.0, Context: map[string]any{}},
+					{Key: "key", Timestamp: 2, Step: 2, Value: common.NANValue, Context: map[string]any{}},
+					{Key: "key", Timestamp: 3, Step: 3, Value: 20.0, Context: map[string]any{}},
+				},
+				SmoothedMetrics: []MetricPartialResponse{
+					{Key: "key", Timestamp: 1, Step: 1, Value: 10.0, Context: map[string]any{}},
+					{Key: "key", Timestamp: 2, Step: 2, Value: common.NANValue, Context: map[string]any{}},
+					{Key: "key", Timestamp: 3, Step: 3, Value: 15.0, Context: map[string]any{}},
+				},
+			},
+		},
+		{
+			name: "WithPrecision",
+			metrics: []models.Metric{
+				{Key: "key", Value: 0.123456789, Timestamp: 1, Step: 1, Context: models.DefaultContext},
+				{Key: "key", Value: 0.987654321, Timestamp: 2, Step: 2, Context: models.DefaultContext},
+			},
+			smoothing: common.GetPointer(0.5),
+			precision: 4,
+			expectedResponse: &GetMetricHistoryResponse{
+				Metrics: []MetricPartialResponse{
+					{Key: "key", Timestamp: 1, Step: 1, Value: 0.1235, Context: map[string]any{}},
+					{Key: "key", Timestamp: 2, Step: 2, Value: 0.9877, Context: map[string]any{}},
+				},
+				SmoothedMetrics: []MetricPartialResponse{
+					{Key: "key", Timestamp: 1, Step: 1, Value: 0.1235, Context: map[string]any{}},
+					{Key: "key", Timestamp: 2, Step: 2, Value: 0.5556, Context: map[string]any{}},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse, err := NewMetricHistoryResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryResponse(tt.metrics, tt.smoothing, tt.precision)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedResponse, actualResponse)
+		})
+	}
+}
+
+func TestNewMetricHistoryByContextResponse_Ok(t *testing.T) {
+	trainContext := models.Context{ID: 1, Json: []byte(`{"subset": "train"}`)}
+	testContext := models.Context{ID: 2, Json: []byte(`{"subset": "test"}`)}
+
+	testData := []struct {
+		name             string
+		metrics          []models.Metric
+		smoothing        *float64
+		xAxis            request.MetricHistoryXAxis
+		expectedResponse *GetMetricHistoryByContextResponse
+	}{
+		{
+			name: "WithTwoContexts",
+			metrics: []models.Metric{
+				{Key: "key", Value: 2, Timestamp: 2, Step: 2, Context: trainContext},
+				{Key: "key", Value: 3, Timestamp: 1, Step: 1, Context: testContext},
+				{Key: "key", Value: 1, Timestamp: 1, Step: 1, Context: trainContext},
+			},
+			expectedResponse: &GetMetricHistoryByContextResponse{
+				Series: []MetricHistorySeriesPartialResponse{
+					{
+						Context: map[string]any{"subset": "train"},
+						Metrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 1.0, Context: map[string]any{"subset": "train"}},
+							{Key: "key", Timestamp: 2, Step: 2, Value: 2.0, Context: map[string]any{"subset": "train"}},
+						},
+					},
+					{
+						Context: map[string]any{"subset": "test"},
+						Metrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 3.0, Context: map[string]any{"subset": "test"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WithSmoothing",
+			metrics: []models.Metric{
+				{Key: "key", Value: 10, Timestamp: 1, Step: 1, Context: trainContext},
+				{Key: "key", Value: 100, Timestamp: 1, Step: 1, Context: testContext},
+				{Key: "key", Value: 20, Timestamp: 2, Step: 2, Context: trainContext},
+			},
+			smoothing: common.GetPointer(0.5),
+			expectedResponse: &GetMetricHistoryByContextResponse{
+				Series: []MetricHistorySeriesPartialResponse{
+					{
+						Context: map[string]any{"subset": "train"},
+						Metrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 10.0, Context: map[string]any{"subset": "train"}},
+							{Key: "key", Timestamp: 2, Step: 2, Value: 20.0, Context: map[string]any{"subset": "train"}},
+						},
+						SmoothedMetrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 10.0, Context: map[string]any{"subset": "train"}},
+							{Key: "key", Timestamp: 2, Step: 2, Value: 15.0, Context: map[string]any{"subset": "train"}},
+						},
+					},
+					{
+						Context: map[string]any{"subset": "test"},
+						Metrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 100.0, Context: map[string]any{"subset": "test"}},
+						},
+						SmoothedMetrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 1, Value: 100.0, Context: map[string]any{"subset": "test"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WithTimeXAxis",
+			metrics: []models.Metric{
+				{Key: "key", Value: 1, Timestamp: 2, Step: 1, Context: trainContext},
+				{Key: "key", Value: 2, Timestamp: 1, Step: 2, Context: trainContext},
+			},
+			xAxis: request.MetricHistoryXAxisTime,
+			expectedResponse: &GetMetricHistoryByContextResponse{
+				Series: []MetricHistorySeriesPartialResponse{
+					{
+						Context: map[string]any{"subset": "train"},
+						Metrics: []MetricPartialResponse{
+							{Key: "key", Timestamp: 1, Step: 2, Value: 2.0, Context: map[string]any{"subset": "train"}},
+							{Key: "key", Timestamp: 2, Step: 1, Value: 1.0, Context: map[string]any{"subset": "train"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:             "WithoutMetrics",
+			expectedResponse: &GetMetricHistoryByContextResponse{Series: []MetricHistorySeriesPartialResponse{}},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			actualResponse, err := NewMetricHistoryByContextResponse(tt.metrics, tt.smoothing, 0, tt.xAxis)
 			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
@@ -114,6 +260,7 @@ func TestNewMetricHistoryBulkResponse_Ok(t *testing.T) {
 	testData := []struct {
 		name             string
 		metrics          []models.Metric
+		precision        int
 		expectedResponse *GetMetricHistoryBulkResponse
 	}{
 		{
@@ -166,11 +313,36 @@ func TestNewMetricHistoryBulkResponse_Ok(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithPrecision",
+			metrics: []models.Metric{
+				{
+					Key:       "key",
+					Value:     123.456789,
+					Timestamp: 1234567890,
+					RunID:     "run_id",
+					Step:      1,
+					Iter:      1,
+				},
+			},
+			precision: 4,
+			expectedResponse: &GetMetricHistoryBulkResponse{
+				Metrics: []MetricPartialResponseBulk{
+					{
+						RunID:     "run_id",
+						Key:       "key",
+						Timestamp: 1234567890,
+						Step:      1,
+						Value:     123.5,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse := NewMetricHistoryBulkResponse(tt.metrics)
+			actualResponse := NewMetricHistoryBulkResponse(tt.metrics, tt.precision)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
 	}
@@ -0,0 +1,94 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelVersionPartialResponse is a partial response object for different responses.
+type ModelVersionPartialResponse struct {
+	Name                 string `json:"name"`
+	Version              string `json:"version"`
+	CreationTimestamp    int64  `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64  `json:"last_updated_timestamp"`
+	Description          string `json:"description,omitempty"`
+	CurrentStage         string `json:"current_stage"`
+	Source               string `json:"source"`
+	RunID                string `json:"run_id"`
+	Status               string `json:"status"`
+}
+
+// NewModelVersionPartialResponse creates new ModelVersionPartialResponse object.
+func NewModelVersionPartialResponse(name string, modelVersion *models.ModelVersion) *ModelVersionPartialResponse {
+	return &ModelVersionPartialResponse{
+		Name:                 name,
+		Version:              fmt.Sprint(modelVersion.Version),
+		CreationTimestamp:    modelVersion.CreationTime,
+		LastUpdatedTimestamp: modelVersion.LastUpdatedTime,
+		Description:          modelVersion.Description,
+		CurrentStage:         string(modelVersion.CurrentStage),
+		Source:               modelVersion.Source,
+		RunID:                modelVersion.RunID,
+		Status:               string(modelVersion.Status),
+	}
+}
+
+// RegisteredModelPartialResponse is a partial response object for different responses.
+type RegisteredModelPartialResponse struct {
+	Name                 string                         `json:"name"`
+	CreationTimestamp    int64                          `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                          `json:"last_updated_timestamp"`
+	Description          string                         `json:"description,omitempty"`
+	LatestVersions       []*ModelVersionPartialResponse `json:"latest_versions,omitempty"`
+}
+
+// NewRegisteredModelPartialResponse creates new RegisteredModelPartialResponse object.
+// Latest versions contain the latest version of each stage, ordered by version.
+func NewRegisteredModelPartialResponse(registeredModel *models.RegisteredModel) *RegisteredModelPartialResponse {
+	resp := RegisteredModelPartialResponse{
+		Name:                 registeredModel.Name,
+		CreationTimestamp:    registeredModel.CreationTime,
+		LastUpdatedTimestamp: registeredModel.LastUpdatedTime,
+		Description:          registeredModel.Description,
+	}
+	stages := map[models.ModelVersionStage]struct{}{}
+	for i := len(registeredModel.Versions) - 1; i >= 0; i-- {
+		modelVersion := registeredModel.Versions[i]
+		if _, ok := stages[modelVersion.CurrentStage]; ok {
+			continue
+		}
+		stages[modelVersion.CurrentStage] = struct{}{}
+		resp.LatestVersions = append(
+			[]*ModelVersionPartialResponse{NewModelVersionPartialResponse(registeredModel.Name, &modelVersion)},
+			resp.LatestVersions...,
+		)
+	}
+	return &resp
+}
+
+// RegisteredModelResponse is a response object for
+// `POST /mlflow/registered-models/create` and `GET /mlflow/registered-models/get` endpoints.
+type RegisteredModelResponse struct {
+	RegisteredModel *RegisteredModelPartialResponse `json:"registered_model"`
+}
+
+// NewRegisteredModelResponse creates new RegisteredModelResponse object.
+func NewRegisteredModelResponse(registeredModel *models.RegisteredModel) *RegisteredModelResponse {
+	return &RegisteredModelResponse{
+		RegisteredModel: NewRegisteredModelPartialResponse(registeredModel),
+	}
+}
+
+// ModelVersionResponse is a response object for
+// `POST /mlflow/model-versions/create` and `POST /mlflow/model-versions/transition-stage` endpoints.
+type ModelVersionResponse struct {
+	ModelVersion *ModelVersionPartialResponse `json:"model_version"`
+}
+
+// NewModelVersionResponse creates new ModelVersionResponse object.
+func NewModelVersionResponse(name string, modelVersion *models.ModelVersion) *ModelVersionResponse {
+	return &ModelVersionResponse{
+		ModelVersion: NewModelVersionPartialResponse(name, modelVersion),
+	}
+}
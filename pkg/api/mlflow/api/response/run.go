@@ -50,6 +50,8 @@ type RunInfoPartialResponse struct {
 	Status         string `json:"status"`
 	StartTime      int64  `json:"start_time"`
 	EndTime        int64  `json:"end_time,omitempty"`
+	CreationTime   int64  `json:"creation_time"`
+	LastUpdateTime int64  `json:"last_update_time"`
 	ArtifactURI    string `json:"artifact_uri,omitempty"`
 	LifecycleStage string `json:"lifecycle_stage"`
 }
@@ -77,6 +79,8 @@ func NewCreateRunResponse(run *models.Run) *CreateRunResponse {
 				UserID:         run.UserID,
 				Status:         string(run.Status),
 				StartTime:      run.StartTime.Int64,
+				CreationTime:   run.CreationTime.Int64,
+				LastUpdateTime: run.LastUpdateTime.Int64,
 				ArtifactURI:    run.ArtifactURI,
 				LifecycleStage: string(run.LifecycleStage),
 			},
@@ -112,12 +116,42 @@ func NewUpdateRunResponse(run *models.Run) *UpdateRunResponse {
 			Status:         string(run.Status),
 			StartTime:      run.StartTime.Int64,
 			EndTime:        run.EndTime.Int64,
+			CreationTime:   run.CreationTime.Int64,
+			LastUpdateTime: run.LastUpdateTime.Int64,
 			ArtifactURI:    run.ArtifactURI,
 			LifecycleStage: string(run.LifecycleStage),
 		},
 	}
 }
 
+// FinalizeRunResponse is a response object for `POST mlflow/runs/finalize` endpoint.
+type FinalizeRunResponse struct {
+	RunInfo RunInfoPartialResponse `json:"run_info"`
+}
+
+// NewFinalizeRunResponse creates a new FinalizeRunResponse object.
+func NewFinalizeRunResponse(run *models.Run) *FinalizeRunResponse {
+	return &FinalizeRunResponse{
+		RunInfo: NewUpdateRunResponse(run).RunInfo,
+	}
+}
+
+// DeleteRunDryRunResponse is a response object for `POST mlflow/runs/delete?dry_run=true` endpoint.
+type DeleteRunDryRunResponse struct {
+	DryRun  bool  `json:"dry_run"`
+	Runs    int64 `json:"runs"`
+	Metrics int64 `json:"metrics"`
+}
+
+// NewDeleteRunDryRunResponse creates new DeleteRunDryRunResponse object.
+func NewDeleteRunDryRunResponse(impact *models.DeletionImpact) *DeleteRunDryRunResponse {
+	return &DeleteRunDryRunResponse{
+		DryRun:  true,
+		Runs:    impact.Runs,
+		Metrics: impact.Metrics,
+	}
+}
+
 // GetRunResponse is a response object for `GET mlflow/runs/get` endpoint.
 type GetRunResponse struct {
 	Run *RunPartialResponse `json:"run"`
@@ -130,6 +164,22 @@ func NewGetRunResponse(run *models.Run) *GetRunResponse {
 	}
 }
 
+// GetRunLineageResponse is a response object for `GET mlflow/runs/lineage` endpoint.
+type GetRunLineageResponse struct {
+	RunID    string   `json:"run_id"`
+	Parents  []string `json:"parents"`
+	Children []string `json:"children"`
+}
+
+// NewGetRunLineageResponse creates a new GetRunLineageResponse object.
+func NewGetRunLineageResponse(runID string, parents, children []string) *GetRunLineageResponse {
+	return &GetRunLineageResponse{
+		RunID:    runID,
+		Parents:  parents,
+		Children: children,
+	}
+}
+
 // SearchRunsResponse is a response object for `POST mlflow/runs/search` endpoint.
 type SearchRunsResponse struct {
 	Runs          []*RunPartialResponse `json:"runs"`
@@ -212,6 +262,8 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 			Status:         string(run.Status),
 			StartTime:      run.StartTime.Int64,
 			EndTime:        run.EndTime.Int64,
+			CreationTime:   run.CreationTime.Int64,
+			LastUpdateTime: run.LastUpdateTime.Int64,
 			ArtifactURI:    run.ArtifactURI,
 			LifecycleStage: string(run.LifecycleStage),
 		},
@@ -34,6 +34,14 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Valid: true,
 					Int64: 1234567890,
 				},
+				CreationTime: sql.NullInt64{
+					Valid: true,
+					Int64: 1234567880,
+				},
+				LastUpdateTime: sql.NullInt64{
+					Valid: true,
+					Int64: 1234567899,
+				},
 				SourceVersion:  "SourceVersion",
 				LifecycleStage: "LifecycleStage",
 				ArtifactURI:    "ArtifactURI",
@@ -75,6 +83,8 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Status:         "Status",
 					StartTime:      1234567890,
 					EndTime:        1234567890,
+					CreationTime:   1234567880,
+					LastUpdateTime: 1234567899,
 					ArtifactURI:    "ArtifactURI",
 					LifecycleStage: "LifecycleStage",
 				},
@@ -116,6 +126,14 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Valid: true,
 					Int64: 1234567890,
 				},
+				CreationTime: sql.NullInt64{
+					Valid: true,
+					Int64: 1234567880,
+				},
+				LastUpdateTime: sql.NullInt64{
+					Valid: true,
+					Int64: 1234567899,
+				},
 				SourceVersion:  "SourceVersion",
 				LifecycleStage: "LifecycleStage",
 				ArtifactURI:    "ArtifactURI",
@@ -157,6 +175,8 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Status:         "Status",
 					StartTime:      1234567890,
 					EndTime:        1234567890,
+					CreationTime:   1234567880,
+					LastUpdateTime: 1234567899,
 					ArtifactURI:    "ArtifactURI",
 					LifecycleStage: "LifecycleStage",
 				},
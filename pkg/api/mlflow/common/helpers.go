@@ -1,9 +1,11 @@
 package common
 
 import (
+	"math"
 	"mime"
 	"path"
 	"slices"
+	"strconv"
 )
 
 // textTypes used by GetContentType.
@@ -52,3 +54,38 @@ func GetContentType(filename string) string {
 	}
 	return "application/octet-stream"
 }
+
+// ExponentialMovingAverage returns the exponential moving average of the provided series.
+// `factor` in range [0, 1] is the weight of the previous smoothed value, so 0 returns the raw series.
+// The average is seeded with the first valid value and NaN values are skipped, they stay NaN in the result.
+func ExponentialMovingAverage(values []float64, factor float64) []float64 {
+	smoothed := make([]float64, len(values))
+	last, seeded := 0.0, false
+	for i, value := range values {
+		if math.IsNaN(value) {
+			smoothed[i] = value
+			continue
+		}
+		if seeded {
+			last = factor*last + (1-factor)*value
+		} else {
+			last, seeded = value, true
+		}
+		smoothed[i] = last
+	}
+	return smoothed
+}
+
+// RoundToSignificantDigits rounds the value to the provided number of significant digits.
+// Non-positive `digits` means full precision, NaN and infinite values are returned as is.
+func RoundToSignificantDigits(value float64, digits int) float64 {
+	if digits <= 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+	// formatting is used instead of math.Round to avoid artifacts like 0.30000000000000004.
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(value, 'g', digits, 64), 64)
+	if err != nil {
+		return value
+	}
+	return rounded
+}
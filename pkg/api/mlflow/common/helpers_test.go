@@ -1,6 +1,7 @@
 package common
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,3 +40,103 @@ func TestGetContentType(t *testing.T) {
 		assert.Equal(t, tt.expected, result, "Unexpected content type for filename: %s", tt.filename)
 	}
 }
+
+func TestExponentialMovingAverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		factor   float64
+		expected []float64
+	}{
+		{
+			name:     "Empty",
+			values:   []float64{},
+			factor:   0.5,
+			expected: []float64{},
+		},
+		{
+			name:     "ZeroFactorReturnsRawSeries",
+			values:   []float64{1, 2, 3},
+			factor:   0,
+			expected: []float64{1, 2, 3},
+		},
+		{
+			name:     "KnownSequence",
+			values:   []float64{10, 20, 30, 40},
+			factor:   0.5,
+			expected: []float64{10, 15, 22.5, 31.25},
+		},
+		{
+			name:     "SkipNaN",
+			values:   []float64{math.NaN(), 10, math.NaN(), 20},
+			factor:   0.5,
+			expected: []float64{math.NaN(), 10, math.NaN(), 15},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExponentialMovingAverage(tt.values, tt.factor)
+			assert.Equal(t, len(tt.expected), len(result))
+			for i := range tt.expected {
+				if math.IsNaN(tt.expected[i]) {
+					assert.True(t, math.IsNaN(result[i]))
+				} else {
+					assert.InDelta(t, tt.expected[i], result[i], 1e-9)
+				}
+			}
+		})
+	}
+}
+
+func TestRoundToSignificantDigits(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		digits   int
+		expected float64
+	}{
+		{
+			name:     "FullPrecision",
+			value:    0.123456789,
+			digits:   0,
+			expected: 0.123456789,
+		},
+		{
+			name:     "FractionalValue",
+			value:    0.123456789,
+			digits:   4,
+			expected: 0.1235,
+		},
+		{
+			name:     "LargeValue",
+			value:    123456.789,
+			digits:   4,
+			expected: 123500,
+		},
+		{
+			name:     "NegativeValue",
+			value:    -1.23456789,
+			digits:   4,
+			expected: -1.235,
+		},
+		{
+			name:     "ValueWithLessDigits",
+			value:    1.5,
+			digits:   4,
+			expected: 1.5,
+		},
+		{
+			name:     "Infinity",
+			value:    math.Inf(1),
+			digits:   4,
+			expected: math.Inf(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RoundToSignificantDigits(tt.value, tt.digits))
+		})
+	}
+}
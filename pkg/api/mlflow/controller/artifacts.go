@@ -2,9 +2,11 @@ package controller
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,6 +18,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/api/request"
 	"github.com/G-Research/fasttrackml/pkg/common/api/response"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact"
 )
 
 // ListArtifacts handles `GET /artifacts/list` endpoint.
@@ -92,3 +95,91 @@ func (c Controller) GetArtifact(ctx *fiber.Ctx) error {
 	})
 	return nil
 }
+
+// UploadArtifact handles `POST /artifacts/upload` endpoint.
+func (c Controller) UploadArtifact(ctx *fiber.Ctx) error {
+	req := request.UploadArtifactRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("uploadArtifact request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("uploadArtifact namespace: %s", ns.Code)
+
+	content := ctx.Body()
+	hash, err := c.artifactService.UploadArtifact(ctx.Context(), ns, &req, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewUploadArtifactResponse(req.Path, int64(len(content)), hash)
+	log.Debugf("uploadArtifact response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteArtifact handles `POST /artifacts/delete` endpoint.
+func (c Controller) DeleteArtifact(ctx *fiber.Ctx) error {
+	var req request.DeleteArtifactRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("deleteArtifact request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteArtifact namespace: %s", ns.Code)
+
+	if err := c.artifactService.DeleteArtifact(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// StreamRunLogs handles `GET /runs/logs/stream` endpoint.
+func (c Controller) StreamRunLogs(ctx *fiber.Ctx) error {
+	req := request.StreamRunLogsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("StreamRunLogs request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("streamRunLogs namespace: %s", ns.Code)
+
+	stream, err := c.artifactService.StreamRunLogs(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	// the body is streamed after the handler returns, when fiber context is already released,
+	// so only the underlying request context and copies of its values are used by the stream writer.
+	requestCtx, method, path := ctx.Context(), strings.Clone(ctx.Method()), strings.Clone(ctx.Path())
+	ctx.Set("Content-Type", "text/plain; charset=utf-8")
+	ctx.Set("X-Content-Type-Options", "nosniff")
+	requestCtx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
+		//nolint:errcheck
+		defer stream.Close()
+
+		streamCtx, cancel := artifact.NewConnectionContext(requestCtx, requestCtx.Conn())
+		defer cancel()
+
+		start := time.Now()
+		bytesWritten, err := stream.WriteTo(streamCtx, w)
+		if err != nil {
+			log.Errorf("error encountered in %s %s: error streaming run logs: %s", method, path, err)
+		}
+		log.Debugf("StreamRunLogs wrote bytes to output stream: %d", bytesWritten)
+		log.Infof("body - %s %s %s", time.Since(start), method, path)
+	})
+	return nil
+}
@@ -1,8 +1,6 @@
 package controller
 
 import (
-	"encoding/json"
-
 	"github.com/gofiber/fiber/v2"
 	log "github.com/sirupsen/logrus"
 
@@ -15,15 +13,8 @@ import (
 // CreateExperiment handles `POST /experiments/create` endpoint.
 func (c Controller) CreateExperiment(ctx *fiber.Ctx) error {
 	var req request.CreateExperimentRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				`Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+
-					`See the API docs for more information about request parameters.`,
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("createExperiment request: %#v", req)
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -42,11 +33,34 @@ func (c Controller) CreateExperiment(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// CloneExperiment handles `POST /experiments/clone` endpoint.
+func (c Controller) CloneExperiment(ctx *fiber.Ctx) error {
+	var req request.CloneExperimentRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("cloneExperiment request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("cloneExperiment namespace: %s", ns.Code)
+	experiment, err := c.experimentService.CloneExperiment(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewCreateExperimentResponse(experiment)
+	log.Debugf("cloneExperiment response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // UpdateExperiment handles `POST /experiments/update` endpoint.
 func (c Controller) UpdateExperiment(ctx *fiber.Ctx) error {
 	var req request.UpdateExperimentRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("updateExperiment request: %#v", req)
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -83,6 +97,28 @@ func (c Controller) GetExperiment(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// GetExperimentsBatch handles `POST /experiments/batch-get` endpoint.
+func (c Controller) GetExperimentsBatch(ctx *fiber.Ctx) error {
+	var req request.GetExperimentsBatchRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("getExperimentsBatch request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getExperimentsBatch namespace: %s", ns.Code)
+
+	experiments, err := c.experimentService.GetExperimentsBatch(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+	resp := response.NewGetExperimentsBatchResponse(experiments)
+	log.Debugf("getExperimentsBatch response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
 // GetExperimentByName handles `GET /experiments/get-by-name` endpoint.
 func (c Controller) GetExperimentByName(ctx *fiber.Ctx) error {
 	var req request.GetExperimentRequest
@@ -108,8 +144,11 @@ func (c Controller) GetExperimentByName(ctx *fiber.Ctx) error {
 // DeleteExperiment handles `POST /experiments/delete` endpoint.
 func (c Controller) DeleteExperiment(ctx *fiber.Ctx) error {
 	var req request.DeleteExperimentRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
 	}
 	log.Debugf("deleteExperiment request: %#v", req)
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -117,6 +156,15 @@ func (c Controller) DeleteExperiment(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting namespace from context")
 	}
 	log.Debugf("deleteExperiment namespace: %s", ns.Code)
+	if req.DryRun {
+		impact, err := c.experimentService.DeleteExperimentDryRun(ctx.Context(), ns, &req)
+		if err != nil {
+			return err
+		}
+		resp := response.NewDeleteExperimentDryRunResponse(impact)
+		log.Debugf("deleteExperiment response: %#v", resp)
+		return ctx.JSON(resp)
+	}
 	if err := c.experimentService.DeleteExperiment(ctx.Context(), ns, &req); err != nil {
 		return err
 	}
@@ -127,8 +175,8 @@ func (c Controller) DeleteExperiment(ctx *fiber.Ctx) error {
 // RestoreExperiment handles `POST /experiments/restore` endpoint.
 func (c Controller) RestoreExperiment(ctx *fiber.Ctx) error {
 	var req request.RestoreExperimentRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("restoreExperiment request: %#v", req)
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -142,11 +190,32 @@ func (c Controller) RestoreExperiment(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// RestoreExperimentsBatch handles `POST /experiments/batch-restore` endpoint.
+func (c Controller) RestoreExperimentsBatch(ctx *fiber.Ctx) error {
+	var req request.RestoreExperimentsBatchRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("restoreExperimentsBatch request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("restoreExperimentsBatch namespace: %s", ns.Code)
+	results, err := c.experimentService.RestoreExperimentsBatch(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(response.RestoreExperimentsBatchResponse{
+		Results: results,
+	})
+}
+
 // SetExperimentTag handles `POST /experiments/set-experiment-tag` endpoint.
 func (c Controller) SetExperimentTag(ctx *fiber.Ctx) error {
 	var req request.SetExperimentTagRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("setExperimentTag request: %#v", req)
 	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
@@ -160,13 +229,31 @@ func (c Controller) SetExperimentTag(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// SetExperimentTags handles `POST /experiments/set-experiment-tags` endpoint.
+func (c Controller) SetExperimentTags(ctx *fiber.Ctx) error {
+	var req request.SetExperimentTagsRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("setExperimentTags request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("setExperimentTags namespace: %s", ns.Code)
+	if err := c.experimentService.SetExperimentTags(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+	return ctx.JSON(fiber.Map{})
+}
+
 // SearchExperiments handles `GET /experiments/list`, `GET /experiments/search`, `POST /experiments/search` endpoints.
 func (c Controller) SearchExperiments(ctx *fiber.Ctx) error {
 	var req request.SearchExperimentsRequest
 	switch ctx.Method() {
 	case fiber.MethodPost:
-		if err := ctx.BodyParser(&req); err != nil {
-			return api.NewBadRequestError("Unable to decode request body: %s", err)
+		if err := ParseJSONBody(ctx, &req); err != nil {
+			return err
 		}
 	case fiber.MethodGet:
 		if err := ctx.QueryParser(&req); err != nil {
@@ -1,12 +1,79 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
+
 	"github.com/apache/arrow/go/v14/arrow"
 	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
+// ArrowStreamContentType is the media type of Arrow IPC streaming format.
+const ArrowStreamContentType = "application/vnd.apache.arrow.stream"
+
+// MaxRequestBodySize is the maximum size in bytes of JSON request body accepted by the endpoints.
+const MaxRequestBodySize = 8 * 1024 * 1024
+
+// NewMetricsArrowSchema creates Arrow schema used to stream metrics.
+// Columns after the common metric ones can be provided via `extraFields`.
+func NewMetricsArrowSchema(extraFields ...arrow.Field) *arrow.Schema {
+	return arrow.NewSchema(
+		append(
+			[]arrow.Field{
+				{Name: "run_id", Type: arrow.BinaryTypes.String},
+				{Name: "key", Type: arrow.BinaryTypes.String},
+				{Name: "step", Type: arrow.PrimitiveTypes.Int64},
+				{Name: "timestamp", Type: arrow.PrimitiveTypes.Int64},
+				{Name: "value", Type: arrow.PrimitiveTypes.Float64},
+				{Name: "context", Type: arrow.BinaryTypes.String},
+			},
+			extraFields...,
+		),
+		nil,
+	)
+}
+
 // WriteStreamingRecord writes record into stream.
 func WriteStreamingRecord(w *ipc.Writer, r arrow.Record) error {
 	defer r.Release()
 	return w.Write(r)
 }
+
+// ParseJSONBody decodes JSON request body into `req`.
+// Decode errors are returned as `INVALID_PARAMETER_VALUE` errors pointing to the position of the problem.
+func ParseJSONBody(ctx *fiber.Ctx, req any) error {
+	body := ctx.Body()
+	if len(body) > MaxRequestBodySize {
+		return api.NewInvalidParameterValueError(
+			"Request body is too large: got %d bytes, but no more than %d bytes are allowed",
+			len(body), MaxRequestBodySize,
+		)
+	}
+	// empty body is treated as an empty request, so required parameters are reported by validators.
+	if len(body) == 0 {
+		return nil
+	}
+
+	err := json.Unmarshal(body, req)
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &syntaxError):
+		return api.NewInvalidParameterValueError(
+			"Malformed JSON in request body at offset %d: %s", syntaxError.Offset, syntaxError,
+		)
+	case errors.As(err, &typeError):
+		return api.NewInvalidParameterValueError(
+			`Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+
+				`See the API docs for more information about request parameters.`,
+			typeError.Field, typeError.Value,
+		)
+	default:
+		return api.NewInvalidParameterValueError("Unable to decode request body: %s", err)
+	}
+}
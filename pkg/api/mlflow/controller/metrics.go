@@ -3,6 +3,8 @@ package controller
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"math"
 	"time"
 
 	"github.com/apache/arrow/go/v14/arrow"
@@ -15,6 +17,8 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	"github.com/G-Research/fasttrackml/pkg/database"
@@ -37,7 +41,25 @@ func (c Controller) GetMetricHistory(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	resp, err := response.NewMetricHistoryResponse(metrics)
+	ctx.Vary(fiber.HeaderAccept)
+	if ctx.Accepts(fiber.MIMEApplicationJSON, ArrowStreamContentType) == ArrowStreamContentType {
+		ctx.Set(fiber.HeaderContentType, ArrowStreamContentType)
+		if err := writeMetricHistoryArrowStream(ctx.Response().BodyWriter(), metrics, req.Smoothing); err != nil {
+			return api.NewInternalError("unable to write Arrow record batch: %s", err)
+		}
+		return nil
+	}
+
+	if req.GroupByContext {
+		resp, err := response.NewMetricHistoryByContextResponse(metrics, req.Smoothing, req.Precision, req.XAxis)
+		if err != nil {
+			return err
+		}
+		log.Debugf("getMetricHistory response: %#v", resp)
+		return ctx.JSON(resp)
+	}
+
+	resp, err := response.NewMetricHistoryResponse(metrics, req.Smoothing, req.Precision)
 	if err != nil {
 		return err
 	}
@@ -65,7 +87,7 @@ func (c Controller) GetMetricHistoryBulk(ctx *fiber.Ctx) error {
 		return err
 	}
 
-	resp := response.NewMetricHistoryBulkResponse(metrics)
+	resp := response.NewMetricHistoryBulkResponse(metrics, req.Precision)
 	log.Debugf("getMetricHistoryBulk response: %#v", resp)
 
 	return ctx.JSON(resp)
@@ -74,8 +96,8 @@ func (c Controller) GetMetricHistoryBulk(ctx *fiber.Ctx) error {
 // GetMetricHistories handles `POST /metrics/get-histories` endpoint.
 func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	var req request.GetMetricHistoriesRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("GetMetricHistories request: %#v", req)
 
@@ -101,17 +123,7 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 		start := time.Now()
 		if err := func() error {
 			pool := memory.NewGoAllocator()
-			schema := arrow.NewSchema(
-				[]arrow.Field{
-					{Name: "run_id", Type: arrow.BinaryTypes.String},
-					{Name: "key", Type: arrow.BinaryTypes.String},
-					{Name: "step", Type: arrow.PrimitiveTypes.Int64},
-					{Name: "timestamp", Type: arrow.PrimitiveTypes.Int64},
-					{Name: "value", Type: arrow.PrimitiveTypes.Float64},
-					{Name: "context", Type: arrow.BinaryTypes.String},
-				},
-				nil,
-			)
+			schema := NewMetricsArrowSchema()
 			writer := ipc.NewWriter(w, ipc.WithAllocator(pool), ipc.WithSchema(schema))
 			//nolint:errcheck
 			defer writer.Close()
@@ -154,3 +166,51 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	})
 	return nil
 }
+
+// writeMetricHistoryArrowStream writes metric history as a single Arrow record batch.
+// When `smoothing` is provided, EMA smoothed values are written into additional `smoothed_value` column.
+func writeMetricHistoryArrowStream(w io.Writer, metrics []models.Metric, smoothing *float64) error {
+	var extraFields []arrow.Field
+	if smoothing != nil {
+		extraFields = append(extraFields, arrow.Field{Name: "smoothed_value", Type: arrow.PrimitiveTypes.Float64})
+	}
+
+	pool := memory.NewGoAllocator()
+	schema := NewMetricsArrowSchema(extraFields...)
+	writer := ipc.NewWriter(w, ipc.WithAllocator(pool), ipc.WithSchema(schema))
+	//nolint:errcheck
+	defer writer.Close()
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	values := make([]float64, len(metrics))
+	for n, m := range metrics {
+		b.Field(0).(*array.StringBuilder).Append(m.RunID)
+		b.Field(1).(*array.StringBuilder).Append(m.Key)
+		b.Field(2).(*array.Int64Builder).Append(m.Step)
+		b.Field(3).(*array.Int64Builder).Append(m.Timestamp)
+		if m.IsNan {
+			b.Field(4).(*array.Float64Builder).AppendNull()
+			values[n] = math.NaN()
+		} else {
+			b.Field(4).(*array.Float64Builder).Append(m.Value)
+			values[n] = m.Value
+		}
+		b.Field(5).(*array.StringBuilder).Append(string(m.Context.Json))
+	}
+	if smoothing != nil {
+		for _, value := range common.ExponentialMovingAverage(values, *smoothing) {
+			if math.IsNaN(value) {
+				b.Field(6).(*array.Float64Builder).AppendNull()
+			} else {
+				b.Field(6).(*array.Float64Builder).Append(value)
+			}
+		}
+	}
+
+	if err := WriteStreamingRecord(writer, b.NewRecord()); err != nil {
+		return err
+	}
+	return writer.Close()
+}
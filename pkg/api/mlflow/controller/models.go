@@ -1,6 +1,106 @@
 package controller
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
+)
+
+// CreateRegisteredModel handles `POST /registered-models/create` endpoint.
+func (c Controller) CreateRegisteredModel(ctx *fiber.Ctx) error {
+	var req request.CreateRegisteredModelRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("createRegisteredModel request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createRegisteredModel namespace: %s", ns.Code)
+
+	registeredModel, err := c.modelService.CreateRegisteredModel(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewRegisteredModelResponse(registeredModel)
+	log.Debugf("createRegisteredModel response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetRegisteredModel handles `GET /registered-models/get` endpoint.
+func (c Controller) GetRegisteredModel(ctx *fiber.Ctx) error {
+	var req request.GetRegisteredModelRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("getRegisteredModel request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRegisteredModel namespace: %s", ns.Code)
+
+	registeredModel, err := c.modelService.GetRegisteredModel(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewRegisteredModelResponse(registeredModel)
+	log.Debugf("getRegisteredModel response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// CreateModelVersion handles `POST /model-versions/create` endpoint.
+func (c Controller) CreateModelVersion(ctx *fiber.Ctx) error {
+	var req request.CreateModelVersionRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("createModelVersion request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createModelVersion namespace: %s", ns.Code)
+
+	modelVersion, err := c.modelService.CreateModelVersion(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewModelVersionResponse(req.Name, modelVersion)
+	log.Debugf("createModelVersion response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// TransitionModelVersionStage handles `POST /model-versions/transition-stage` endpoint.
+func (c Controller) TransitionModelVersionStage(ctx *fiber.Ctx) error {
+	var req request.TransitionModelVersionStageRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("transitionModelVersionStage request: %#v", req)
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("transitionModelVersionStage namespace: %s", ns.Code)
+
+	modelVersion, err := c.modelService.TransitionModelVersionStage(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewModelVersionResponse(req.Name, modelVersion)
+	log.Debugf("transitionModelVersionStage response: %#v", resp)
+	return ctx.JSON(resp)
+}
 
 // SearchModelVersions handles `GET /model-versions/search` endpoint.
 func (c Controller) SearchModelVersions(ctx *fiber.Ctx) error {
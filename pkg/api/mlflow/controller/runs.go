@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"encoding/json"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,8 +15,8 @@ import (
 // CreateRun handles `POST /runs/create` endpoint.
 func (c Controller) CreateRun(ctx *fiber.Ctx) error {
 	var req request.CreateRunRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 
 	log.Debugf("createRun request: %#v", &req)
@@ -39,8 +38,8 @@ func (c Controller) CreateRun(ctx *fiber.Ctx) error {
 // UpdateRun handles `POST /runs/update` endpoint.
 func (c Controller) UpdateRun(ctx *fiber.Ctx) error {
 	var req request.UpdateRunRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("updateRun request: %#v", &req)
 
@@ -60,6 +59,30 @@ func (c Controller) UpdateRun(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// FinalizeRun handles `POST /runs/finalize` endpoint.
+func (c Controller) FinalizeRun(ctx *fiber.Ctx) error {
+	var req request.FinalizeRunRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("finalizeRun request: %#v", &req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("finalizeRun namespace: %s", ns.Code)
+
+	run, err := c.runService.FinalizeRun(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+	resp := response.NewFinalizeRunResponse(run)
+	log.Debugf("finalizeRun response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // GetRun handles `GET /runs/get` endpoint.
 func (c Controller) GetRun(ctx *fiber.Ctx) error {
 	req := request.GetRunRequest{}
@@ -86,11 +109,37 @@ func (c Controller) GetRun(ctx *fiber.Ctx) error {
 	return ctx.JSON(resp)
 }
 
+// GetRunLineage handles `GET /runs/lineage` endpoint.
+func (c Controller) GetRunLineage(ctx *fiber.Ctx) error {
+	req := request.GetRunLineageRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+
+	log.Debugf("getRunLineage request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunLineage namespace: %s", ns.Code)
+
+	parents, children, err := c.runService.GetRunLineage(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetRunLineageResponse(req.GetRunID(), parents, children)
+	log.Debugf("getRunLineage response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // SearchRuns handles `POST /runs/search` endpoint.
 func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 	var req request.SearchRunsRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("searchRuns request: %#v", req)
 
@@ -117,8 +166,11 @@ func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 // DeleteRun handles `POST /runs/delete` endpoint.
 func (c Controller) DeleteRun(ctx *fiber.Ctx) error {
 	var req request.DeleteRunRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
 	}
 	log.Debugf("deleteRun request: %#v", req)
 
@@ -128,6 +180,16 @@ func (c Controller) DeleteRun(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("deleteRun namespace: %s", ns.Code)
 
+	if req.DryRun {
+		impact, err := c.runService.DeleteRunDryRun(ctx.Context(), ns, &req)
+		if err != nil {
+			return err
+		}
+		resp := response.NewDeleteRunDryRunResponse(impact)
+		log.Debugf("deleteRun response: %#v", resp)
+		return ctx.JSON(resp)
+	}
+
 	if err := c.runService.DeleteRun(ctx.Context(), ns, &req); err != nil {
 		return err
 	}
@@ -138,8 +200,8 @@ func (c Controller) DeleteRun(ctx *fiber.Ctx) error {
 // RestoreRun handles `POST /runs/restore` endpoint.
 func (c Controller) RestoreRun(ctx *fiber.Ctx) error {
 	var req request.RestoreRunRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("restoreRun request: %#v", req)
 
@@ -159,15 +221,8 @@ func (c Controller) RestoreRun(ctx *fiber.Ctx) error {
 // LogMetric handles `POST /runs/log-metric` endpoint.
 func (c Controller) LogMetric(ctx *fiber.Ctx) error {
 	var req request.LogMetricRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				`Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+
-					`See the API docs for more information about request parameters.`,
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("logMetric request: %#v", req)
 
@@ -187,15 +242,8 @@ func (c Controller) LogMetric(ctx *fiber.Ctx) error {
 // LogParam handles `POST /runs/log-parameter` endpoint.
 func (c Controller) LogParam(ctx *fiber.Ctx) error {
 	var req request.LogParamRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				`Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+
-					`See the API docs for more information about request parameters.`,
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("logParam request: %#v", req)
 
@@ -215,15 +263,8 @@ func (c Controller) LogParam(ctx *fiber.Ctx) error {
 // SetRunTag handles `POST /runs/set-tag` endpoint.
 func (c Controller) SetRunTag(ctx *fiber.Ctx) error {
 	var req request.SetRunTagRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				`Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+
-					`See the API docs for more information about request parameters.`,
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("setRunTag request: %#v", req)
 
@@ -243,8 +284,8 @@ func (c Controller) SetRunTag(ctx *fiber.Ctx) error {
 // DeleteRunTag handles `POST /runs/delete-tag` endpoint.
 func (c Controller) DeleteRunTag(ctx *fiber.Ctx) error {
 	var req request.DeleteRunTagRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("deleteRunTag request: %#v", req)
 
@@ -260,18 +301,32 @@ func (c Controller) DeleteRunTag(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// LogParams handles `POST /runs/log-params` endpoint.
+func (c Controller) LogParams(ctx *fiber.Ctx) error {
+	var req request.LogParamsRequest
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
+	}
+	log.Debugf("logParams request: %#v", req)
+
+	ns, err := middleware.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logParams namespace: %s", ns.Code)
+
+	if err := c.runService.LogParams(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
 // LogBatch handles `POST /runs/log-batch` endpoint.
 func (c Controller) LogBatch(ctx *fiber.Ctx) error {
 	var req request.LogBatchRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				"Invalid value for parameter '%s' supplied. Hint: Value was of type '%s'. `+"+
-					"`See the API docs for more information about request parameters.",
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("logBatch request: %#v", req)
 
@@ -291,15 +346,8 @@ func (c Controller) LogBatch(ctx *fiber.Ctx) error {
 // LogOutput handles `POST /runs/log-output` endpoint.
 func (c Controller) LogOutput(ctx *fiber.Ctx) error {
 	var req request.LogOutputRequest
-	if err := ctx.BodyParser(&req); err != nil {
-		if err, ok := err.(*json.UnmarshalTypeError); ok {
-			return api.NewInvalidParameterValueError(
-				`Invalid value for log output field '%s'. Hint: Value was of type '%s'. `+
-					`See the API docs for more information about request parameters.`,
-				err.Field, err.Value,
-			)
-		}
-		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	if err := ParseJSONBody(ctx, &req); err != nil {
+		return err
 	}
 	log.Debugf("LogOutput request: %#v", req)
 
@@ -25,6 +25,21 @@ func ConvertLogParamRequestToDBModel(runID string, req *request.LogParamRequest)
 	}
 }
 
+// ConvertLogParamsRequestToDBModel converts request.LogParamsRequest into actual []models.Param models.
+func ConvertLogParamsRequestToDBModel(runID string, req *request.LogParamsRequest) []models.Param {
+	params := make([]models.Param, len(req.Params))
+	for i, param := range req.Params {
+		params[i] = models.Param{
+			Key:        param.Key,
+			RunID:      runID,
+			ValueInt:   param.ValueInt,
+			ValueFloat: param.ValueFloat,
+			ValueStr:   param.ValueStr,
+		}
+	}
+	return params
+}
+
 // ConvertLogOutputRequestToDBModel converts request.LogOutRequest into actual models.Log model.
 func ConvertLogOutputRequestToDBModel(runID string, req *request.LogOutputRequest) *models.Log {
 	return &models.Log{
@@ -65,6 +80,7 @@ func ConvertLogBatchRequestToDBModel(
 			Timestamp: metric.Timestamp,
 			Step:      metric.Step,
 			RunID:     runID,
+			Kind:      models.GetMetricKind(metric.Key, metric.System),
 		}
 		if v, ok := metric.Value.(float64); ok {
 			m.Value = v
@@ -76,6 +76,7 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 					RunID:     "run_id",
 					Step:      1,
 					Context:   models.DefaultContext,
+					Kind:      models.MetricKindUser,
 				},
 			},
 		},
@@ -124,6 +125,7 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 					RunID:     "run_id",
 					Step:      1,
 					Context:   models.DefaultContext,
+					Kind:      models.MetricKindUser,
 				},
 			},
 		},
@@ -171,6 +173,7 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 					RunID:     "run_id",
 					Step:      1,
 					Context:   models.DefaultContext,
+					Kind:      models.MetricKindUser,
 				},
 			},
 		},
@@ -218,6 +221,7 @@ func TestConvertLogBatchRequestToDBModel_Ok(t *testing.T) {
 					RunID:     "run_id",
 					Step:      1,
 					Context:   models.DefaultContext,
+					Kind:      models.MetricKindUser,
 				},
 			},
 		},
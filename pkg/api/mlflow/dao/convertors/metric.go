@@ -18,6 +18,7 @@ func ConvertLogMetricRequestToDBModel(runID string, req *request.LogMetricReques
 		Timestamp: req.Timestamp,
 		Step:      req.Step,
 		RunID:     runID,
+		Kind:      models.GetMetricKind(req.Key, req.System),
 	}
 	if req.Context == nil || len(req.Context) == 0 {
 		metric.Context = models.DefaultContext
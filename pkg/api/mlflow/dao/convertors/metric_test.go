@@ -34,6 +34,7 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				RunID:     "run_id",
 				Step:      1,
 				Context:   models.DefaultContext,
+				Kind:      models.MetricKindUser,
 			},
 		},
 		{
@@ -53,6 +54,7 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				RunID:     "run_id",
 				Step:      1,
 				Context:   models.DefaultContext,
+				Kind:      models.MetricKindUser,
 			},
 		},
 		{
@@ -71,6 +73,7 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				RunID:     "run_id",
 				Step:      1,
 				Context:   models.DefaultContext,
+				Kind:      models.MetricKindUser,
 			},
 		},
 		{
@@ -89,6 +92,7 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				RunID:     "run_id",
 				Step:      1,
 				Context:   models.DefaultContext,
+				Kind:      models.MetricKindUser,
 			},
 		},
 		{
@@ -114,6 +118,7 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				Context: models.Context{
 					Json: []byte(`{"key1":"value1","key2":2}`),
 				},
+				Kind: models.MetricKindUser,
 			},
 		},
 		{
@@ -139,6 +144,46 @@ func TestConvertMetricParamRequestToDBModel_Ok(t *testing.T) {
 				Context: models.Context{
 					Json: []byte(`{"key1":"value1","key2":2}`),
 				},
+				Kind: models.MetricKindUser,
+			},
+		},
+		{
+			name: "WithSystemMetricKeyPrefix",
+			request: &request.LogMetricRequest{
+				Key:       "__system__gpu",
+				Step:      1,
+				Value:     1.1,
+				RunID:     "run_id",
+				Timestamp: 1234567890,
+			},
+			expectedMetric: &models.Metric{
+				Key:       "__system__gpu",
+				Value:     1.1,
+				Timestamp: 1234567890,
+				RunID:     "run_id",
+				Step:      1,
+				Context:   models.DefaultContext,
+				Kind:      models.MetricKindSystem,
+			},
+		},
+		{
+			name: "WithSystemFlag",
+			request: &request.LogMetricRequest{
+				Key:       "gpu",
+				Step:      1,
+				Value:     1.1,
+				RunID:     "run_id",
+				Timestamp: 1234567890,
+				System:    true,
+			},
+			expectedMetric: &models.Metric{
+				Key:       "gpu",
+				Value:     1.1,
+				Timestamp: 1234567890,
+				RunID:     "run_id",
+				Step:      1,
+				Context:   models.DefaultContext,
+				Kind:      models.MetricKindSystem,
 			},
 		},
 	}
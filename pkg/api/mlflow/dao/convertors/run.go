@@ -3,12 +3,12 @@ package convertors
 import (
 	"database/sql"
 	"net/url"
+	"time"
 
 	"github.com/rotisserie/eris"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
-	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 // supported tag keys.
@@ -17,17 +17,23 @@ const (
 	TagKeyRunName    = "mlflow.runName"
 	TagKeySourceName = "mlflow.source.name"
 	TagKeySourceType = "mlflow.source.type"
+	// TagKeyParentRunID is set by MLflow client on the nested runs.
+	TagKeyParentRunID = "mlflow.parentRunId"
+	// TagKeyStrictSteps enables rejection of metric steps lower than the last logged one.
+	TagKeyStrictSteps = "fasttrackml.strictSteps"
+	// TagKeyLogArtifact designates the artifact path, which the run writes its output logs into.
+	TagKeyLogArtifact = "fasttrackml.logArtifact"
 )
 
 // ConvertCreateRunRequestToDBModel converts request.CreateRunRequest into actual models.Run model.
 func ConvertCreateRunRequestToDBModel(
-	experiment *models.Experiment, req *request.CreateRunRequest,
+	runID string, experiment *models.Experiment, req *request.CreateRunRequest,
 ) (*models.Run, error) {
-	runID := database.NewUUID()
 	artifactURI, err := url.JoinPath(experiment.ArtifactLocation, runID, "artifacts")
 	if err != nil {
 		return nil, eris.Wrap(err, "error constructing artifact_uri")
 	}
+	now := time.Now().UTC().UnixMilli()
 	run := models.Run{
 		ID:     runID,
 		Name:   req.Name,
@@ -38,6 +44,14 @@ func ConvertCreateRunRequestToDBModel(
 			Int64: req.StartTime,
 			Valid: true,
 		},
+		CreationTime: sql.NullInt64{
+			Int64: now,
+			Valid: true,
+		},
+		LastUpdateTime: sql.NullInt64{
+			Int64: now,
+			Valid: true,
+		},
 		ArtifactURI:    artifactURI,
 		ExperimentID:   *experiment.ID,
 		LifecycleStage: models.LifecycleStageActive,
@@ -96,5 +110,47 @@ func ConvertUpdateRunRequestToDBModel(run *models.Run, req *request.UpdateRunReq
 			Valid: true,
 		}
 	}
+	run.LastUpdateTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
 	return run
 }
+
+// ConvertFinalizeRunRequestToDBModel converts request.FinalizeRunRequest into actual models.Run model
+// and the final list of models.Tag. Status defaults to `FINISHED` and end time defaults to now.
+func ConvertFinalizeRunRequestToDBModel(
+	run *models.Run, req *request.FinalizeRunRequest,
+) (*models.Run, []models.Tag) {
+	run.Status = models.StatusFinished
+	if req.Status != "" {
+		run.Status = models.Status(req.Status)
+	}
+	run.EndTime = sql.NullInt64{
+		Int64: req.EndTime,
+		Valid: true,
+	}
+	if req.EndTime == 0 {
+		run.EndTime.Int64 = time.Now().UTC().UnixMilli()
+	}
+	run.LastUpdateTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+
+	tags := make([]models.Tag, len(req.Tags))
+	for n, tag := range req.Tags {
+		switch tag.Key {
+		case TagKeyUser:
+			run.UserID = tag.Value
+		case TagKeyRunName:
+			run.Name = tag.Value
+		}
+		tags[n] = models.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+			RunID: run.ID,
+		}
+	}
+	return run, tags
+}
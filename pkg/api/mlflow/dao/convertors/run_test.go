@@ -181,7 +181,7 @@ func TestConvertCreateRunRequestToDBModel(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			experimentID := int32(123)
-			result, err := ConvertCreateRunRequestToDBModel(&models.Experiment{
+			result, err := ConvertCreateRunRequestToDBModel("id", &models.Experiment{
 				ID:               &experimentID,
 				ArtifactLocation: "artifact_location",
 			}, tt.req)
@@ -202,3 +202,29 @@ func TestConvertUpdateRunRequestToDBModel(t *testing.T) {
 	assert.Equal(t, models.Status("status"), result.Status)
 	assert.Equal(t, int64(1234567890), result.EndTime.Int64)
 }
+
+func TestConvertFinalizeRunRequestToDBModel(t *testing.T) {
+	req := request.FinalizeRunRequest{
+		Status:  string(models.StatusFailed),
+		EndTime: 1234567890,
+		Tags: []request.RunTagPartialRequest{
+			{Key: "key", Value: "value"},
+			{Key: TagKeyRunName, Value: "name"},
+		},
+	}
+	run, tags := ConvertFinalizeRunRequestToDBModel(&models.Run{ID: "id"}, &req)
+	assert.Equal(t, models.StatusFailed, run.Status)
+	assert.Equal(t, sql.NullInt64{Int64: 1234567890, Valid: true}, run.EndTime)
+	assert.Equal(t, "name", run.Name)
+	assert.Equal(t, []models.Tag{
+		{Key: "key", Value: "value", RunID: "id"},
+		{Key: TagKeyRunName, Value: "name", RunID: "id"},
+	}, tags)
+
+	// status and end time have defaults.
+	run, tags = ConvertFinalizeRunRequestToDBModel(&models.Run{ID: "id"}, &request.FinalizeRunRequest{})
+	assert.Equal(t, models.StatusFinished, run.Status)
+	assert.True(t, run.EndTime.Valid)
+	assert.NotZero(t, run.EndTime.Int64)
+	assert.Empty(t, tags)
+}
@@ -25,3 +25,26 @@ func ConvertSetExperimentTagRequestToDBModel(
 		ExperimentID: experimentID,
 	}
 }
+
+// ConvertSetExperimentTagsRequestToDBModels converts
+// request.SetExperimentTagsRequest into actual []models.ExperimentTag models.
+// The same key could be provided more than once, in which case the last value wins.
+func ConvertSetExperimentTagsRequestToDBModels(
+	experimentID int32, req *request.SetExperimentTagsRequest,
+) []models.ExperimentTag {
+	positions := make(map[string]int, len(req.Tags))
+	experimentTags := make([]models.ExperimentTag, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		if position, ok := positions[tag.Key]; ok {
+			experimentTags[position].Value = tag.Value
+			continue
+		}
+		positions[tag.Key] = len(experimentTags)
+		experimentTags = append(experimentTags, models.ExperimentTag{
+			Key:          tag.Key,
+			Value:        tag.Value,
+			ExperimentID: experimentID,
+		})
+	}
+	return experimentTags
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
 func TestConvertSetRunTagRequestToDBModel_Ok(t *testing.T) {
@@ -28,3 +29,18 @@ func TestConvertSetExperimentTagRequestToDBModel_Ok(t *testing.T) {
 	assert.Equal(t, "value", result.Value)
 	assert.Equal(t, int32(1), result.ExperimentID)
 }
+
+func TestConvertSetExperimentTagsRequestToDBModels_Ok(t *testing.T) {
+	req := request.SetExperimentTagsRequest{
+		Tags: []request.ExperimentTagPartialRequest{
+			{Key: "key1", Value: "value1"},
+			{Key: "key2", Value: "value2"},
+			{Key: "key1", Value: "value3"},
+		},
+	}
+	result := ConvertSetExperimentTagsRequestToDBModels(1, &req)
+	assert.Equal(t, []models.ExperimentTag{
+		{Key: "key1", Value: "value3", ExperimentID: 1},
+		{Key: "key2", Value: "value2", ExperimentID: 1},
+	}, result)
+}
@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// AuditLogEntityType represents the type of entity an AuditLog entry refers to.
+type AuditLogEntityType string
+
+// Supported list of audited entity types.
+const (
+	AuditLogEntityTypeRun        AuditLogEntityType = "run"
+	AuditLogEntityTypeExperiment AuditLogEntityType = "experiment"
+	AuditLogEntityTypeNamespace  AuditLogEntityType = "namespace"
+)
+
+// AuditLogAction represents the action recorded by an AuditLog entry.
+type AuditLogAction string
+
+// Supported list of audited actions.
+const (
+	AuditLogActionDelete  AuditLogAction = "delete"
+	AuditLogActionRestore AuditLogAction = "restore"
+	AuditLogActionPurge   AuditLogAction = "purge"
+)
+
+// AuditLogActorSystem is the actor of the actions made by the server itself, e.g. purge of expired entities.
+const AuditLogActorSystem = "system"
+
+// AuditLog represents model to work with `audit_logs` table.
+// Entries intentionally don't reference the audited entities and namespaces,
+// so they outlive them when those are purged.
+type AuditLog struct {
+	ID          uint               `gorm:"primaryKey;autoIncrement"`
+	NamespaceID uint               `gorm:"not null;index"`
+	Actor       string             `gorm:"not null"`
+	EntityType  AuditLogEntityType `gorm:"type:varchar(16);not null;index:,composite:entity"`
+	EntityID    string             `gorm:"not null;index:,composite:entity"`
+	Action      AuditLogAction     `gorm:"type:varchar(16);not null"`
+	CreatedAt   time.Time          `gorm:"not null;index"`
+}
+
+// NewAuditLogs creates AuditLog entries of the action the actor made on the entities of the namespace.
+func NewAuditLogs(
+	namespaceID uint, actor string, entityType AuditLogEntityType, action AuditLogAction, entityIDs ...string,
+) []AuditLog {
+	now := time.Now().UTC()
+	auditLogs := make([]AuditLog, len(entityIDs))
+	for i, entityID := range entityIDs {
+		auditLogs[i] = AuditLog{
+			NamespaceID: namespaceID,
+			Actor:       actor,
+			EntityType:  entityType,
+			EntityID:    entityID,
+			Action:      action,
+			CreatedAt:   now,
+		}
+	}
+	return auditLogs
+}
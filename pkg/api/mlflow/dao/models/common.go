@@ -28,3 +28,10 @@ func (b *Base) BeforeCreate(tx *gorm.DB) error {
 	b.ID = uuid.New()
 	return nil
 }
+
+// DeletionImpact represents the number of entities affected by deletion of an Experiment or a Run.
+type DeletionImpact struct {
+	Experiments int64
+	Runs        int64
+	Metrics     int64
+}
@@ -18,10 +18,13 @@ type Experiment struct {
 	LifecycleStage   LifecycleStage `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
 	CreationTime     sql.NullInt64  `gorm:"type:bigint"`
 	LastUpdateTime   sql.NullInt64  `gorm:"type:bigint"`
+	DeletedTime      sql.NullInt64  `gorm:"type:bigint"`
 	NamespaceID      uint           `gorm:"index:,unique,composite:name"`
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
 	Runs             []Run           `gorm:"constraint:OnDelete:CASCADE"`
+	// LastActivity is the newest last update time of the active experiment runs, it is only loaded by the search.
+	LastActivity sql.NullInt64 `gorm:"->;-:migration"`
 }
 
 // IsDefault makes check that Experiment is default.
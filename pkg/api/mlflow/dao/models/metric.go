@@ -3,6 +3,7 @@ package models
 import (
 	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 )
@@ -10,17 +11,38 @@ import (
 // DefaultContext is the default metric context
 var DefaultContext = Context{Json: types.JSONB("{}")}
 
+// MetricKind represents the kind of metric.
+type MetricKind string
+
+// Supported list of metric kinds.
+const (
+	MetricKindUser   MetricKind = "user"
+	MetricKindSystem MetricKind = "system"
+)
+
+// SystemMetricKeyPrefix is the key prefix of metrics, which are logged as system metrics, e.g. `__system__gpu`.
+const SystemMetricKeyPrefix = "__system__"
+
+// GetMetricKind returns the kind of metric by its key, unless the metric is explicitly flagged as system one.
+func GetMetricKind(key string, system bool) MetricKind {
+	if system || strings.HasPrefix(key, SystemMetricKeyPrefix) {
+		return MetricKindSystem
+	}
+	return MetricKindUser
+}
+
 // Metric represents model to work with `metrics` table.
 type Metric struct {
-	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Key       string  `gorm:"type:varchar(250);not null;primaryKey;uniqueIndex:idx_metrics_step,priority:2"`
 	Value     float64 `gorm:"type:double precision;not null;primaryKey"`
 	Timestamp int64   `gorm:"not null;primaryKey"`
-	RunID     string  `gorm:"column:run_uuid;not null;primaryKey;index"`
-	Step      int64   `gorm:"default:0;not null;primaryKey"`
+	RunID     string  `gorm:"column:run_uuid;not null;primaryKey;index;uniqueIndex:idx_metrics_step,priority:1"`
+	Step      int64   `gorm:"default:0;not null;primaryKey;uniqueIndex:idx_metrics_step,priority:3"`
 	IsNan     bool    `gorm:"default:false;not null;primaryKey"`
 	Iter      int64   `gorm:"index"`
-	ContextID uint    `gorm:"not null;primaryKey"`
+	ContextID uint    `gorm:"not null;primaryKey;uniqueIndex:idx_metrics_step,priority:4"`
 	Context   Context
+	Kind      MetricKind `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 // UniqueKey is a compound unique key for this metric series.
@@ -39,6 +61,7 @@ type LatestMetric struct {
 	LastIter  int64
 	ContextID uint `gorm:"not null;primaryKey"`
 	Context   Context
+	Kind      MetricKind `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 // UniqueKey is a compound unique key for this metric series.
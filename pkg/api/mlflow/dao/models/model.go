@@ -0,0 +1,58 @@
+package models
+
+// ModelVersionStatus represents status of the model version.
+type ModelVersionStatus string
+
+// Supported list of ModelVersionStatus.
+const (
+	ModelVersionStatusReady ModelVersionStatus = "READY"
+)
+
+// ModelVersionStage represents stage of the model version.
+type ModelVersionStage string
+
+// Supported list of ModelVersionStage.
+const (
+	ModelVersionStageNone       ModelVersionStage = "None"
+	ModelVersionStageStaging    ModelVersionStage = "Staging"
+	ModelVersionStageProduction ModelVersionStage = "Production"
+	ModelVersionStageArchived   ModelVersionStage = "Archived"
+)
+
+// RegisteredModel represents model to work with `registry_models` table.
+// MLflow databases imported into FastTrackML keep the legacy `registered_models` table, so it isn't reused.
+type RegisteredModel struct {
+	ID              uint           `gorm:"primaryKey;autoIncrement"`
+	Name            string         `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string         `gorm:"type:varchar(5000)"`
+	CreationTime    int64          `gorm:"type:bigint"`
+	LastUpdatedTime int64          `gorm:"type:bigint"`
+	NamespaceID     uint           `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace      `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the name of the table backing RegisteredModel.
+func (RegisteredModel) TableName() string {
+	return "registry_models"
+}
+
+// ModelVersion represents model to work with `registry_model_versions` table.
+// MLflow databases imported into FastTrackML keep the legacy `model_versions` table, so it isn't reused.
+type ModelVersion struct {
+	ID                uint               `gorm:"primaryKey;autoIncrement"`
+	RegisteredModelID uint               `gorm:"not null;index:,unique,composite:version"`
+	Version           int64              `gorm:"not null;index:,unique,composite:version"`
+	Description       string             `gorm:"type:varchar(5000)"`
+	Source            string             `gorm:"type:varchar(500);not null"`
+	Status            ModelVersionStatus `gorm:"type:varchar(20);not null"`
+	CurrentStage      ModelVersionStage  `gorm:"type:varchar(20);not null;default:None"`
+	CreationTime      int64              `gorm:"type:bigint"`
+	LastUpdatedTime   int64              `gorm:"type:bigint"`
+	RunID             string             `gorm:"column:run_uuid;type:varchar(32);not null;index"`
+}
+
+// TableName returns the name of the table backing ModelVersion.
+func (ModelVersion) TableName() string {
+	return "registry_model_versions"
+}
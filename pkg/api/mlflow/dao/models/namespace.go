@@ -15,6 +15,7 @@ type Namespace struct {
 	ID                  uint           `gorm:"primaryKey;autoIncrement" json:"id"`
 	Code                string         `gorm:"unique;index;not null" json:"code"`
 	Description         string         `json:"description"`
+	ArtifactRoot        string         `json:"artifact_root"`
 	CreatedAt           time.Time      `json:"created_at"`
 	UpdatedAt           time.Time      `json:"updated_at"`
 	DeletedAt           gorm.DeletedAt `gorm:"index" json:"deleted_at"`
@@ -30,7 +31,23 @@ func (ns Namespace) DisplayName() string {
 	return ns.Code
 }
 
+// GetArtifactRoot returns the root of the Namespace experiments artifact locations.
+// The provided default root is used, when the Namespace doesn't have its own one.
+func (ns Namespace) GetArtifactRoot(defaultArtifactRoot string) string {
+	if ns.ArtifactRoot != "" {
+		return ns.ArtifactRoot
+	}
+	return defaultArtifactRoot
+}
+
 // IsDefault makes check that Namespace is default.
 func (ns Namespace) IsDefault() bool {
 	return ns.Code == DefaultNamespaceCode
 }
+
+// NamespaceUsage represents the number of entities belonging to the Namespace.
+type NamespaceUsage struct {
+	NamespaceID     uint
+	ExperimentCount int64
+	ActiveRunCount  int64
+}
@@ -24,27 +24,30 @@ const (
 //
 //nolint:lll
 type Run struct {
-	ID             string         `gorm:"<-:create;column:run_uuid;type:varchar(32);not null;primaryKey"`
-	Name           string         `gorm:"type:varchar(250)"`
-	SourceType     string         `gorm:"<-:create;type:varchar(20);check:source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"`
-	SourceName     string         `gorm:"<-:create;type:varchar(500)"`
-	EntryPointName string         `gorm:"<-:create;type:varchar(50)"`
-	UserID         string         `gorm:"<-:create;type:varchar(256)"`
-	Status         Status         `gorm:"type:varchar(9);check:status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"`
-	StartTime      sql.NullInt64  `gorm:"<-:create;type:bigint"`
-	EndTime        sql.NullInt64  `gorm:"type:bigint"`
-	SourceVersion  string         `gorm:"<-:create;type:varchar(50)"`
-	LifecycleStage LifecycleStage `gorm:"type:varchar(20);check:lifecycle_stage IN ('active', 'deleted')"`
-	ArtifactURI    string         `gorm:"<-:create;type:varchar(200)"`
-	ExperimentID   int32
-	Experiment     Experiment
-	DeletedTime    sql.NullInt64  `gorm:"type:bigint"`
-	RowNum         RowNum         `gorm:"<-:create;index"`
-	Params         []Param        `gorm:"constraint:OnDelete:CASCADE"`
-	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
-	Logs           []Log          `gorm:"constraint:OnDelete:CASCADE"`
-	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
-	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	ID                    string         `gorm:"<-:create;column:run_uuid;type:varchar(32);not null;primaryKey"`
+	Name                  string         `gorm:"type:varchar(250);index:,composite:experiment_name,priority:2"`
+	SourceType            string         `gorm:"<-:create;type:varchar(20);check:source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"`
+	SourceName            string         `gorm:"<-:create;type:varchar(500)"`
+	EntryPointName        string         `gorm:"<-:create;type:varchar(50)"`
+	UserID                string         `gorm:"<-:create;type:varchar(256)"`
+	Status                Status         `gorm:"type:varchar(9);check:status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"`
+	StartTime             sql.NullInt64  `gorm:"<-:create;type:bigint"`
+	EndTime               sql.NullInt64  `gorm:"type:bigint"`
+	CreationTime          sql.NullInt64  `gorm:"<-:create;type:bigint"`
+	LastUpdateTime        sql.NullInt64  `gorm:"type:bigint"`
+	SourceVersion         string         `gorm:"<-:create;type:varchar(50)"`
+	LifecycleStage        LifecycleStage `gorm:"type:varchar(20);check:lifecycle_stage IN ('active', 'deleted')"`
+	ArtifactURI           string         `gorm:"<-:create;type:varchar(200)"`
+	ExperimentID          int32          `gorm:"index:,composite:experiment_name,priority:1"`
+	Experiment            Experiment
+	DeletedTime           sql.NullInt64  `gorm:"type:bigint"`
+	DeletedWithExperiment bool           `gorm:"not null;default:false"`
+	RowNum                RowNum         `gorm:"<-:create;index"`
+	Params                []Param        `gorm:"constraint:OnDelete:CASCADE"`
+	Tags                  []Tag          `gorm:"constraint:OnDelete:CASCADE"`
+	Logs                  []Log          `gorm:"constraint:OnDelete:CASCADE"`
+	Metrics               []Metric       `gorm:"constraint:OnDelete:CASCADE"`
+	LatestMetrics         []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 // RowNum represents custom data type.
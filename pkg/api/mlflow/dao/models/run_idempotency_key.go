@@ -0,0 +1,9 @@
+package models
+
+// RunIdempotencyKey represents model to work with `run_idempotency_keys` table.
+// The key is unique in the namespace and refers to the run, which has been created with it.
+type RunIdempotencyKey struct {
+	NamespaceID uint   `gorm:"primaryKey"`
+	Key         string `gorm:"type:varchar(256);primaryKey"`
+	RunID       string `gorm:"column:run_uuid;not null;index"`
+}
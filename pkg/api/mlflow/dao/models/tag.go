@@ -2,7 +2,8 @@ package models
 
 // Tag represents model to work with `tags` table.
 type Tag struct {
-	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
-	Value string `gorm:"type:varchar(5000)"`
-	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	// idx_tags_run_key_value covers only the value prefix, whole values exceed the Postgres btree row size.
+	Key   string `gorm:"type:varchar(250);not null;primaryKey;index:idx_tags_run_key_value,priority:2"`
+	Value string `gorm:"type:varchar(5000);index:idx_tags_run_key_value,priority:3,expression:substr(value\\,1\\,256)"`
+	RunID string `gorm:"column:run_uuid;not null;primaryKey;index;index:idx_tags_run_key_value,priority:1"`
 }
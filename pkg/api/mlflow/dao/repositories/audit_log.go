@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+)
+
+// AuditLogFilter represents the criteria to select models.AuditLog entities.
+// Zero values mean that the criteria isn't applied.
+type AuditLogFilter struct {
+	NamespaceID uint
+	EntityType  models.AuditLogEntityType
+	EntityID    string
+	Action      models.AuditLogAction
+	Limit       int
+}
+
+// AuditLogRepositoryProvider provides an interface to work with models.AuditLog entity.
+type AuditLogRepositoryProvider interface {
+	repositories.BaseRepositoryProvider
+	// List returns the newest models.AuditLog entities matching the filter first.
+	List(ctx context.Context, filter AuditLogFilter) ([]models.AuditLog, error)
+}
+
+// AuditLogRepository repository to work with models.AuditLog entity.
+type AuditLogRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewAuditLogRepository creates repository to work with models.AuditLog entity.
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// List returns the newest models.AuditLog entities matching the filter first.
+func (r AuditLogRepository) List(ctx context.Context, filter AuditLogFilter) ([]models.AuditLog, error) {
+	query := r.GetDB().WithContext(ctx).Order("created_at DESC").Order("id DESC")
+	if filter.NamespaceID != 0 {
+		query = query.Where("namespace_id = ?", filter.NamespaceID)
+	}
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var auditLogs []models.AuditLog
+	if err := query.Find(&auditLogs).Error; err != nil {
+		return nil, eris.Wrap(err, "error listing audit log entries")
+	}
+	return auditLogs, nil
+}
+
+// createAuditLogs creates []models.AuditLog entities in scope of the transaction, which makes the audited change,
+// so the change is never made without its audit log entries and vice versa.
+func createAuditLogs(tx *gorm.DB, auditLogs []models.AuditLog) error {
+	if len(auditLogs) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(&auditLogs, 100).Error; err != nil {
+		return eris.Wrap(err, "error creating audit log entries")
+	}
+	return nil
+}
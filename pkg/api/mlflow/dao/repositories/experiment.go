@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
@@ -11,24 +13,57 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
+// ExperimentConflictError is returned when an experiment with the same name already exists in the namespace.
+type ExperimentConflictError struct {
+	Name string
+}
+
+// Error returns the ExperimentConflictError message.
+func (e ExperimentConflictError) Error() string {
+	return fmt.Sprintf("experiment(name=%s) already exists", e.Name)
+}
+
 // ExperimentRepositoryProvider provides an interface to work with `experiment` entity.
 type ExperimentRepositoryProvider interface {
 	// Create creates new models.Experiment entity.
 	Create(ctx context.Context, experiment *models.Experiment) error
 	// Update updates existing models.Experiment entity.
 	Update(ctx context.Context, experiment *models.Experiment) error
+	// Archive marks existing models.Experiment entity as archived together with its active runs
+	// and records it in the audit log on behalf of the actor.
+	Archive(ctx context.Context, experiment *models.Experiment, actor string) error
+	// Restore marks existing models.Experiment entity as active together with the runs archived with it
+	// and records it in the audit log on behalf of the actor.
+	Restore(ctx context.Context, experiment *models.Experiment, actor string) error
 	// Delete removes the existing models.Experiment from the db.
 	Delete(ctx context.Context, experiment *models.Experiment) error
 	// DeleteBatch removes existing []models.Experiment in batch from the db.
 	DeleteBatch(ctx context.Context, ids []*int32) error
+	// RestoreBatch restores deleted []models.Experiment in batch together with the runs archived with them
+	// and records it in the audit log on behalf of the actor.
+	RestoreBatch(ctx context.Context, experiments []*models.Experiment, lastUpdateTime int64, actor string) error
+	// PurgeDeleted removes experiments which were deleted more than period ago from the db
+	// and records it in the audit log.
+	PurgeDeleted(ctx context.Context, period time.Duration) (int64, error)
 	// GetByNamespaceIDAndName returns experiment by Namespace ID and Experiment name.
 	GetByNamespaceIDAndName(ctx context.Context, namespaceID uint, name string) (*models.Experiment, error)
+	// GetByNamespaceIDAndNameCaseInsensitive returns experiment by Namespace ID and Experiment name ignoring case.
+	GetByNamespaceIDAndNameCaseInsensitive(
+		ctx context.Context, namespaceID uint, name string,
+	) (*models.Experiment, error)
 	// GetByNamespaceIDAndExperimentID returns experiment by Namespace ID and Experiment ID.
 	GetByNamespaceIDAndExperimentID(
 		ctx context.Context, namespaceID uint, experimentID int32,
 	) (*models.Experiment, error)
+	// GetByNamespaceIDAndExperimentIDs returns existing experiments by Namespace ID and Experiment IDs.
+	GetByNamespaceIDAndExperimentIDs(
+		ctx context.Context, namespaceID uint, experimentIDs []int32,
+	) ([]models.Experiment, error)
+	// GetDeletionImpact returns the number of entities which would be affected by deletion of the experiment.
+	GetDeletionImpact(ctx context.Context, experiment *models.Experiment) (*models.DeletionImpact, error)
 	// UpdateWithTransaction updates existing models.Experiment entity in scope of transaction.
 	UpdateWithTransaction(ctx context.Context, tx *gorm.DB, experiment *models.Experiment) error
 }
@@ -48,6 +83,9 @@ func NewExperimentRepository(db *gorm.DB) *ExperimentRepository {
 // Create creates new models.Experiment entity.
 func (r ExperimentRepository) Create(ctx context.Context, experiment *models.Experiment) error {
 	if err := r.GetDB().WithContext(ctx).Create(&experiment).Error; err != nil {
+		if database.IsUniqueConstraintError(err) {
+			return ExperimentConflictError{Name: experiment.Name}
+		}
 		return eris.Wrap(err, "error creating experiment entity")
 	}
 	if experiment.ArtifactLocation == "" {
@@ -79,6 +117,23 @@ func (r ExperimentRepository) GetByNamespaceIDAndExperimentID(
 	return &experiment, nil
 }
 
+// GetByNamespaceIDAndExperimentIDs returns existing experiments by Namespace ID and Experiment IDs.
+func (r ExperimentRepository) GetByNamespaceIDAndExperimentIDs(
+	ctx context.Context, namespaceID uint, experimentIDs []int32,
+) ([]models.Experiment, error) {
+	var experiments []models.Experiment
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Tags",
+	).Where(
+		"experiments.experiment_id IN ?", experimentIDs,
+	).Where(
+		"experiments.namespace_id = ?", namespaceID,
+	).Find(&experiments).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting experiments by ids: %v", experimentIDs)
+	}
+	return experiments, nil
+}
+
 // GetByNamespaceIDAndName returns experiment by Namespace ID and Experiment name.
 func (r ExperimentRepository) GetByNamespaceIDAndName(
 	ctx context.Context, namespaceID uint, name string,
@@ -99,38 +154,182 @@ func (r ExperimentRepository) GetByNamespaceIDAndName(
 	return &experiment, nil
 }
 
+// GetByNamespaceIDAndNameCaseInsensitive returns experiment by Namespace ID and Experiment name ignoring case.
+// When there are several experiments which names differ only by case, the oldest one is returned.
+func (r ExperimentRepository) GetByNamespaceIDAndNameCaseInsensitive(
+	ctx context.Context, namespaceID uint, name string,
+) (*models.Experiment, error) {
+	var experiment models.Experiment
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Tags",
+	).Where(
+		"LOWER(experiments.name) = LOWER(?)", name,
+	).Where(
+		"experiments.namespace_id = ?", namespaceID,
+	).Order(
+		"experiments.creation_time ASC",
+	).Order(
+		"experiments.experiment_id ASC",
+	).First(&experiment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting experiment by name ignoring case: %s", name)
+	}
+	return &experiment, nil
+}
+
 // Update updates existing models.Experiment entity.
 func (r ExperimentRepository) Update(ctx context.Context, experiment *models.Experiment) error {
-	if err := r.GetDB().Transaction(func(tx *gorm.DB) error {
-		if err := tx.WithContext(ctx).Model(&experiment).Updates(experiment).Error; err != nil {
+	if err := r.GetDB().WithContext(ctx).Model(&experiment).Updates(experiment).Error; err != nil {
+		return eris.Wrapf(err, "error updating experiment with id: %d", *experiment.ID)
+	}
+	return nil
+}
+
+// Archive marks existing models.Experiment entity as archived together with its active runs
+// and records it in the audit log on behalf of the actor.
+func (r ExperimentRepository) Archive(ctx context.Context, experiment *models.Experiment, actor string) error {
+	experiment.LifecycleStage = models.LifecycleStageDeleted
+	experiment.LastUpdateTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	experiment.DeletedTime = experiment.LastUpdateTime
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&experiment).Updates(experiment).Error; err != nil {
 			return eris.Wrapf(err, "error updating experiment with id: %d", *experiment.ID)
 		}
 
-		// also archive experiment runs if experiment is being archived
-		if experiment.LifecycleStage == models.LifecycleStageDeleted {
-			run := models.Run{
-				LifecycleStage: experiment.LifecycleStage,
-				DeletedTime:    experiment.LastUpdateTime,
-			}
+		// already deleted runs are not marked, so they stay deleted when the experiment is restored.
+		if err := tx.Model(
+			&models.Run{},
+		).Where(
+			"experiment_id = ?", experiment.ID,
+		).Where(
+			"lifecycle_stage = ?", models.LifecycleStageActive,
+		).Updates(&models.Run{
+			LifecycleStage:        experiment.LifecycleStage,
+			DeletedTime:           experiment.DeletedTime,
+			DeletedWithExperiment: true,
+		}).Error; err != nil {
+			return eris.Wrapf(err, "error updating existing runs with experiment id: %d", *experiment.ID)
+		}
+
+		return createAuditLogs(tx, models.NewAuditLogs(
+			experiment.NamespaceID,
+			actor,
+			models.AuditLogEntityTypeExperiment,
+			models.AuditLogActionDelete,
+			fmt.Sprintf("%d", *experiment.ID),
+		))
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Restore marks existing models.Experiment entity as active together with the runs archived with it
+// and records it in the audit log on behalf of the actor.
+func (r ExperimentRepository) Restore(ctx context.Context, experiment *models.Experiment, actor string) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return restoreExperiment(tx, experiment, time.Now().UTC().UnixMilli(), actor)
+	}); err != nil {
+		return err
+	}
 
-			if err := tx.WithContext(
-				ctx,
-			).Model(
-				&run,
-			).Where(
-				"experiment_id = ?", experiment.ID,
-			).Updates(&run).Error; err != nil {
-				return eris.Wrapf(err, "error updating existing runs with experiment id: %d", *experiment.ID)
+	return nil
+}
+
+// GetDeletionImpact returns the number of entities which would be affected by deletion of the experiment.
+// Only active runs are counted, as they are the ones archived together with the experiment.
+func (r ExperimentRepository) GetDeletionImpact(
+	ctx context.Context, experiment *models.Experiment,
+) (*models.DeletionImpact, error) {
+	impact := models.DeletionImpact{Experiments: 1}
+	runs := r.GetDB().WithContext(ctx).Model(
+		&models.Run{},
+	).Where(
+		"experiment_id = ?", experiment.ID,
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if err := runs.Session(&gorm.Session{}).Count(&impact.Runs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error counting runs of experiment with id: %d", *experiment.ID)
+	}
+	if err := r.GetDB().WithContext(ctx).Model(
+		&models.Metric{},
+	).Where(
+		"run_uuid IN (?)", runs.Session(&gorm.Session{}).Select("run_uuid"),
+	).Count(&impact.Metrics).Error; err != nil {
+		return nil, eris.Wrapf(err, "error counting metrics of experiment with id: %d", *experiment.ID)
+	}
+	return &impact, nil
+}
+
+// RestoreBatch restores deleted []models.Experiment in batch together with the runs archived with them
+// and records it in the audit log on behalf of the actor.
+func (r ExperimentRepository) RestoreBatch(
+	ctx context.Context, experiments []*models.Experiment, lastUpdateTime int64, actor string,
+) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, experiment := range experiments {
+			if err := restoreExperiment(tx, experiment, lastUpdateTime, actor); err != nil {
+				return err
 			}
 		}
 		return nil
 	}); err != nil {
 		return err
 	}
-
 	return nil
 }
 
+// restoreExperiment marks the experiment as active in scope of transaction. Only the runs marked as
+// deleted together with the experiment are restored, while runs deleted before the experiment stay deleted.
+func restoreExperiment(tx *gorm.DB, experiment *models.Experiment, lastUpdateTime int64, actor string) error {
+	// Use UpdateColumns so we can reset DeletedTime to null
+	if err := tx.Model(
+		&models.Run{},
+	).Where(
+		"experiment_id = ?", experiment.ID,
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"deleted_with_experiment = ?", true,
+	).UpdateColumns(map[string]any{
+		"LifecycleStage":        models.LifecycleStageActive,
+		"DeletedTime":           sql.NullInt64{},
+		"DeletedWithExperiment": false,
+		"LastUpdateTime":        sql.NullInt64{Int64: lastUpdateTime, Valid: true},
+	}).Error; err != nil {
+		return eris.Wrapf(err, "error restoring runs of experiment with id: %d", *experiment.ID)
+	}
+
+	experiment.LifecycleStage = models.LifecycleStageActive
+	experiment.LastUpdateTime = sql.NullInt64{
+		Int64: lastUpdateTime,
+		Valid: true,
+	}
+	experiment.DeletedTime = sql.NullInt64{}
+	if err := tx.Model(&experiment).UpdateColumns(map[string]any{
+		"LifecycleStage": experiment.LifecycleStage,
+		"LastUpdateTime": experiment.LastUpdateTime,
+		"DeletedTime":    experiment.DeletedTime,
+	}).Error; err != nil {
+		return eris.Wrapf(err, "error restoring experiment with id: %d", *experiment.ID)
+	}
+
+	return createAuditLogs(tx, models.NewAuditLogs(
+		experiment.NamespaceID,
+		actor,
+		models.AuditLogEntityTypeExperiment,
+		models.AuditLogActionRestore,
+		fmt.Sprintf("%d", *experiment.ID),
+	))
+}
+
 // Delete removes the existing models.Experiment from the db.
 func (r ExperimentRepository) Delete(ctx context.Context, experiment *models.Experiment) error {
 	return r.DeleteBatch(ctx, []*int32{experiment.ID})
@@ -139,44 +338,109 @@ func (r ExperimentRepository) Delete(ctx context.Context, experiment *models.Exp
 // DeleteBatch removes existing []models.Experiment in batch from the db.
 func (r ExperimentRepository) DeleteBatch(ctx context.Context, ids []*int32) error {
 	if err := r.GetDB().Transaction(func(tx *gorm.DB) error {
-		// finding all the runs
-		var minRowNum sql.NullInt64
-		if err := tx.Model(
-			&models.Run{},
-		).Where(
-			"experiment_id IN (?)", ids,
-		).Pluck("MIN(row_num)", &minRowNum).Error; err != nil {
-			return err
+		return r.deleteBatch(tx, ids)
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting experiments")
+	}
+
+	return nil
+}
+
+// deleteBatch removes existing []models.Experiment in batch from the db in scope of transaction.
+func (r ExperimentRepository) deleteBatch(tx *gorm.DB, ids []*int32) error {
+	// finding all the runs
+	var minRowNum sql.NullInt64
+	if err := tx.Model(
+		&models.Run{},
+	).Where(
+		"experiment_id IN (?)", ids,
+	).Pluck("MIN(row_num)", &minRowNum).Error; err != nil {
+		return err
+	}
+
+	// sqlite does not cascade the deletion of experiments, so their runs and tags are deleted explicitly.
+	runIDs := tx.Model(
+		&models.Run{},
+	).Select(
+		"run_uuid",
+	).Where(
+		"experiment_id IN (?)", ids,
+	)
+	if err := deleteRunsData(tx, runIDs); err != nil {
+		return eris.Wrapf(err, "error deleting data of runs of experiments with ids: %d", ids)
+	}
+	if err := tx.Where("experiment_id IN (?)", ids).Delete(&models.Run{}).Error; err != nil {
+		return eris.Wrapf(err, "error deleting runs of experiments with ids: %d", ids)
+	}
+	if err := tx.Where("experiment_id IN (?)", ids).Delete(&models.ExperimentTag{}).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tags of experiments with ids: %d", ids)
+	}
+
+	experiments := make([]models.Experiment, 0, len(ids))
+	if err := tx.Clauses(
+		clause.Returning{Columns: []clause.Column{{Name: "experiment_id"}}},
+	).Where(
+		"experiment_id IN ?", ids,
+	).Delete(&experiments).Error; err != nil {
+		return eris.Wrapf(err, "error deleting existing experiments with ids: %d", ids)
+	}
+
+	// verify deletion
+	if len(experiments) != len(ids) {
+		return eris.New("count of deleted experiments does not match length of ids input (invalid experiment ID?)")
+	}
+
+	// renumbering the remainder runs
+	if minRowNum.Valid {
+		runRepo := NewRunRepository(tx)
+		if err := runRepo.renumberRows(tx, models.RowNum(minRowNum.Int64)); err != nil {
+			return eris.Wrapf(err, "error renumbering runs.row_num")
 		}
+	}
 
-		experiments := make([]models.Experiment, 0, len(ids))
-		if err := tx.Clauses(
-			clause.Returning{Columns: []clause.Column{{Name: "experiment_id"}}},
+	return nil
+}
+
+// PurgeDeleted removes experiments which were deleted more than period ago from the db
+// and records it in the audit log. Experiment runs together with their metrics, params and tags
+// are removed as well.
+func (r ExperimentRepository) PurgeDeleted(ctx context.Context, period time.Duration) (int64, error) {
+	var experiments []models.Experiment
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select(
+			"experiment_id", "namespace_id",
+		).Where(
+			"lifecycle_stage = ?", models.LifecycleStageDeleted,
 		).Where(
-			"experiment_id IN ?", ids,
-		).Delete(&experiments).Error; err != nil {
-			return eris.Wrapf(err, "error deleting existing experiments with ids: %d", ids)
+			"deleted_time < ?", time.Now().Add(-period).UTC().UnixMilli(),
+		).Find(&experiments).Error; err != nil {
+			return eris.Wrap(err, "error getting expired deleted experiments")
 		}
 
-		// verify deletion
-		if len(experiments) != len(ids) {
-			return eris.New("count of deleted experiments does not match length of ids input (invalid experiment ID?)")
+		if len(experiments) == 0 {
+			return nil
 		}
 
-		// renumbering the remainder runs
-		if minRowNum.Valid {
-			runRepo := NewRunRepository(tx)
-			if err := runRepo.renumberRows(tx, models.RowNum(minRowNum.Int64)); err != nil {
-				return eris.Wrapf(err, "error renumbering runs.row_num")
-			}
+		ids := make([]*int32, len(experiments))
+		auditLogs := make([]models.AuditLog, 0, len(experiments))
+		for i, experiment := range experiments {
+			ids[i] = experiment.ID
+			auditLogs = append(auditLogs, models.NewAuditLogs(
+				experiment.NamespaceID,
+				models.AuditLogActorSystem,
+				models.AuditLogEntityTypeExperiment,
+				models.AuditLogActionPurge,
+				fmt.Sprintf("%d", *experiment.ID),
+			)...)
 		}
-
-		return nil
+		if err := r.deleteBatch(tx, ids); err != nil {
+			return err
+		}
+		return createAuditLogs(tx, auditLogs)
 	}); err != nil {
-		return eris.Wrapf(err, "error deleting experiments")
+		return 0, eris.Wrap(err, "error purging expired deleted experiments")
 	}
-
-	return nil
+	return int64(len(experiments)), nil
 }
 
 // UpdateWithTransaction updates existing models.Experiment entity in scope of transaction.
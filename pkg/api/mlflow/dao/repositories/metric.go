@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
@@ -19,11 +20,49 @@ const (
 	MetricHistoryBulkDefaultLimit = 25000
 )
 
+// MetricKeyNotFoundError is returned when the Run has no metric with the key.
+type MetricKeyNotFoundError struct {
+	RunID string
+	Key   string
+}
+
+// Error returns the MetricKeyNotFoundError message.
+func (e MetricKeyNotFoundError) Error() string {
+	return fmt.Sprintf("metric(key=%s) not found for run(id=%s)", e.Key, e.RunID)
+}
+
+// MetricKeyConflictError is returned when the Run already has a metric with the key.
+type MetricKeyConflictError struct {
+	RunID string
+	Key   string
+}
+
+// Error returns the MetricKeyConflictError message.
+func (e MetricKeyConflictError) Error() string {
+	return fmt.Sprintf("metric(key=%s) already exists for run(id=%s)", e.Key, e.RunID)
+}
+
+// MetricStepOrderError is returned in the strict step mode, when the metric step is less than the last logged one.
+type MetricStepOrderError struct {
+	Key      string
+	Step     int64
+	LastStep int64
+}
+
+// Error returns the MetricStepOrderError message.
+func (e MetricStepOrderError) Error() string {
+	return fmt.Sprintf(
+		"step %d of metric(key=%s) is less than its last logged step %d, which strict step mode rejects",
+		e.Step, e.Key, e.LastStep,
+	)
+}
+
 // MetricRepositoryProvider provides an interface to work with models.Metric entity.
 type MetricRepositoryProvider interface {
 	repositories.BaseRepositoryProvider
-	// CreateBatch creates []models.Metric entities in batch.
-	CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric) error
+	// CreateBatch creates []models.Metric entities in batch. In the strict step mode metrics are rejected with
+	// MetricStepOrderError, when their step is less than the last logged step of the same metric and context.
+	CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric, strictSteps bool) error
 	// GetMetricHistories returns metric histories by request parameters.
 	GetMetricHistories(
 		ctx context.Context,
@@ -38,7 +77,14 @@ type MetricRepositoryProvider interface {
 		ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
 	) ([]models.Metric, error)
 	// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
-	GetMetricHistoryByRunIDAndKey(ctx context.Context, runID, key string) ([]models.Metric, error)
+	// Positive limit restricts the number of returned metrics.
+	GetMetricHistoryByRunIDAndKey(
+		ctx context.Context, runID string, req *request.GetMetricHistoryRequest, limit int,
+	) ([]models.Metric, error)
+	// GetMetricHistoryVersion returns version of metric history, which changes whenever the metric is logged.
+	GetMetricHistoryVersion(ctx context.Context, runID string, key string) (int64, error)
+	// RenameKey renames the metric key of the Run together with its whole history.
+	RenameKey(ctx context.Context, runID, key, newKey string) error
 }
 
 // MetricRepository repository to work with models.Metric entity.
@@ -53,12 +99,13 @@ func NewMetricRepository(db *gorm.DB) *MetricRepository {
 	}
 }
 
-// CreateBatch creates []models.Metric entities in batch.
+// CreateBatch creates []models.Metric entities in batch. In the strict step mode metrics are rejected with
+// MetricStepOrderError, when their step is less than the last logged step of the same metric and context.
 // TODO:get back and fix `gocyclo` problem.
 //
 //nolint:gocyclo
 func (r MetricRepository) CreateBatch(
-	ctx context.Context, run *models.Run, batchSize int, metrics []models.Metric,
+	ctx context.Context, run *models.Run, batchSize int, metrics []models.Metric, strictSteps bool,
 ) error {
 	if len(metrics) == 0 {
 		return nil
@@ -111,64 +158,167 @@ func (r MetricRepository) CreateBatch(
 	for n := range metrics {
 		metrics[n].ContextID = allContexts[n].ID
 		metrics[n].Context = *allContexts[n]
-		metrics[n].Iter = lastIters[metrics[n].UniqueKey()] + 1
-		lastIters[metrics[n].UniqueKey()] = metrics[n].Iter
-		lm, ok := latestMetrics[metrics[n].UniqueKey()]
-		if !ok ||
-			metrics[n].Step > lm.Step ||
-			(metrics[n].Step == lm.Step && metrics[n].Timestamp > lm.Timestamp) ||
-			(metrics[n].Step == lm.Step && metrics[n].Timestamp == lm.Timestamp && metrics[n].Value > lm.Value) {
-			latestMetrics[metrics[n].UniqueKey()] = models.LatestMetric{
-				RunID:     metrics[n].RunID,
-				Key:       metrics[n].Key,
-				Value:     metrics[n].Value,
-				Timestamp: metrics[n].Timestamp,
-				Step:      metrics[n].Step,
-				IsNan:     metrics[n].IsNan,
-				LastIter:  metrics[n].Iter,
-				ContextID: allContexts[n].ID,
-				Context:   *allContexts[n],
+	}
+
+	// validate and store metrics in the same transaction, so the steps can't be changed in between.
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// retried log calls must not create the same metric step twice.
+		metrics, err := r.filterLoggedMetricSteps(tx, run.ID, metricKeys, metrics)
+		if err != nil {
+			return eris.Wrapf(err, "error filtering already logged metrics for run: %s", run.ID)
+		}
+		if len(metrics) == 0 {
+			return nil
+		}
+
+		if strictSteps {
+			if err := r.validateStepOrder(tx, run.ID, metricKeys, metrics); err != nil {
+				return err
 			}
 		}
-	}
 
-	if err := r.GetDB().WithContext(ctx).Clauses(
-		clause.OnConflict{DoNothing: true},
-	).CreateInBatches(&metrics, batchSize).Error; err != nil {
-		return eris.Wrapf(err, "error creating metrics for run: %s", run.ID)
-	}
+		for n := range metrics {
+			metrics[n].Iter = lastIters[metrics[n].UniqueKey()] + 1
+			lastIters[metrics[n].UniqueKey()] = metrics[n].Iter
+			lm, ok := latestMetrics[metrics[n].UniqueKey()]
+			if !ok ||
+				metrics[n].Step > lm.Step ||
+				(metrics[n].Step == lm.Step && metrics[n].Timestamp > lm.Timestamp) ||
+				(metrics[n].Step == lm.Step && metrics[n].Timestamp == lm.Timestamp && metrics[n].Value > lm.Value) {
+				latestMetrics[metrics[n].UniqueKey()] = models.LatestMetric{
+					RunID:     metrics[n].RunID,
+					Key:       metrics[n].Key,
+					Value:     metrics[n].Value,
+					Timestamp: metrics[n].Timestamp,
+					Step:      metrics[n].Step,
+					IsNan:     metrics[n].IsNan,
+					LastIter:  metrics[n].Iter,
+					ContextID: metrics[n].ContextID,
+					Context:   metrics[n].Context,
+					Kind:      metrics[n].Kind,
+				}
+			}
+		}
 
-	// TODO update latest metrics in the background?
-	currentLatestMetricsMap := make(map[string]models.LatestMetric, len(latestMetrics))
-	for k, m := range latestMetrics {
-		currentLatestMetricsMap[k] = m
-	}
+		if err := tx.Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}, {Name: "step"}, {Name: "context_id"}},
+				DoNothing: true,
+			},
+		).CreateInBatches(&metrics, batchSize).Error; err != nil {
+			return eris.Wrapf(err, "error creating metrics for run: %s", run.ID)
+		}
 
-	updatedLatestMetrics := make([]models.LatestMetric, 0, len(latestMetrics))
-	for k, m := range latestMetrics {
-		lm, ok := currentLatestMetricsMap[k]
-		if !ok ||
-			m.Step > lm.Step ||
-			(m.Step == lm.Step && m.Timestamp > lm.Timestamp) ||
-			(m.Step == lm.Step && m.Timestamp == lm.Timestamp && m.Value > lm.Value) {
-			updatedLatestMetrics = append(updatedLatestMetrics, m)
-		} else {
-			lm.LastIter = lastIters[k]
-			updatedLatestMetrics = append(updatedLatestMetrics, lm)
+		// TODO update latest metrics in the background?
+		currentLatestMetricsMap := make(map[string]models.LatestMetric, len(latestMetrics))
+		for k, m := range latestMetrics {
+			currentLatestMetricsMap[k] = m
 		}
+
+		updatedLatestMetrics := make([]models.LatestMetric, 0, len(latestMetrics))
+		for k, m := range latestMetrics {
+			lm, ok := currentLatestMetricsMap[k]
+			if !ok ||
+				m.Step > lm.Step ||
+				(m.Step == lm.Step && m.Timestamp > lm.Timestamp) ||
+				(m.Step == lm.Step && m.Timestamp == lm.Timestamp && m.Value > lm.Value) {
+				updatedLatestMetrics = append(updatedLatestMetrics, m)
+			} else {
+				lm.LastIter = lastIters[k]
+				updatedLatestMetrics = append(updatedLatestMetrics, lm)
+			}
+		}
+
+		if len(updatedLatestMetrics) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}, {Name: "context_id"}},
+				UpdateAll: true,
+			}).CreateInBatches(&updatedLatestMetrics, batchSize).Error; err != nil {
+				return eris.Wrapf(err, "error updating latest metrics for run: %s", run.ID)
+			}
+		}
+		return nil
+	})
+}
+
+// validateStepOrder makes sure that steps of every metric series never go back, neither against the already
+// logged values nor within the batch itself.
+func (r MetricRepository) validateStepOrder(
+	tx *gorm.DB, runID string, metricKeys []string, metrics []models.Metric,
+) error {
+	var maxSteps []struct {
+		Key       string
+		ContextID uint
+		Step      int64
+	}
+	if err := tx.Model(
+		&models.Metric{},
+	).Select(
+		"key, context_id, MAX(step) AS step",
+	).Where(
+		"run_uuid = ?", runID,
+	).Where(
+		"key IN ?", metricKeys,
+	).Group(
+		"key, context_id",
+	).Scan(&maxSteps).Error; err != nil {
+		return eris.Wrapf(err, "error getting last logged steps of metrics for run: %s", runID)
 	}
 
-	if len(updatedLatestMetrics) > 0 {
-		if err := r.GetDB().Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}, {Name: "context_id"}},
-			UpdateAll: true,
-		}).CreateInBatches(&updatedLatestMetrics, batchSize).Error; err != nil {
-			return eris.Wrapf(err, "error updating latest metrics for run: %s", run.ID)
+	lastSteps := make(map[string]int64, len(maxSteps))
+	for _, maxStep := range maxSteps {
+		metric := models.Metric{RunID: runID, Key: maxStep.Key, ContextID: maxStep.ContextID}
+		lastSteps[metric.UniqueKey()] = maxStep.Step
+	}
+	for _, metric := range metrics {
+		lastStep, ok := lastSteps[metric.UniqueKey()]
+		if ok && metric.Step < lastStep {
+			return MetricStepOrderError{Key: metric.Key, Step: metric.Step, LastStep: lastStep}
 		}
+		lastSteps[metric.UniqueKey()] = metric.Step
 	}
 	return nil
 }
 
+// filterLoggedMetricSteps skips metrics which steps have been already logged for the same key and context,
+// either before or earlier in the same batch, so only the first logged value of the step is kept.
+func (r MetricRepository) filterLoggedMetricSteps(
+	tx *gorm.DB, runID string, metricKeys []string, metrics []models.Metric,
+) ([]models.Metric, error) {
+	steps := make([]int64, len(metrics))
+	for n := range metrics {
+		steps[n] = metrics[n].Step
+	}
+	var loggedMetrics []models.Metric
+	if err := tx.Select(
+		"key", "step", "context_id",
+	).Where(
+		"run_uuid = ?", runID,
+	).Where(
+		"key IN ?", metricKeys,
+	).Where(
+		"step IN ?", steps,
+	).Find(&loggedMetrics).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting logged steps of metrics for run: %s", runID)
+	}
+
+	loggedSteps := make(map[string]struct{}, len(loggedMetrics)+len(metrics))
+	for _, m := range loggedMetrics {
+		m.RunID = runID
+		loggedSteps[fmt.Sprintf("%s-%d", m.UniqueKey(), m.Step)] = struct{}{}
+	}
+	filteredMetrics := make([]models.Metric, 0, len(metrics))
+	for _, m := range metrics {
+		stepKey := fmt.Sprintf("%s-%d", m.UniqueKey(), m.Step)
+		if _, ok := loggedSteps[stepKey]; ok {
+			continue
+		}
+		loggedSteps[stepKey] = struct{}{}
+		filteredMetrics = append(filteredMetrics, m)
+	}
+	return filteredMetrics, nil
+}
+
 // GetMetricHistories returns metric histories by request parameters.
 // TODO think about to use interface instead of underlying type for -> func(*sql.Rows, interface{})
 func (r MetricRepository) GetMetricHistories(
@@ -283,24 +433,115 @@ func (r MetricRepository) getLatestMetricsByRunIDAndKeys(
 }
 
 // GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
+// Optional step and time ranges of the request are inclusive. Positive limit restricts the number of returned metrics.
 func (r MetricRepository) GetMetricHistoryByRunIDAndKey(
-	ctx context.Context, runID, key string,
+	ctx context.Context, runID string, req *request.GetMetricHistoryRequest, limit int,
 ) ([]models.Metric, error) {
-	var metrics []models.Metric
-	if err := r.GetDB().WithContext(
+	query := r.GetDB().WithContext(
 		ctx,
 	).Joins(
 		"Context",
 	).Where(
 		"run_uuid = ?", runID,
 	).Where(
-		"key = ?", key,
-	).Find(&metrics).Error; err != nil {
-		return nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, key)
+		"key = ?", req.MetricKey,
+	)
+	if req.StartStep != nil {
+		query.Where("step >= ?", *req.StartStep)
+	}
+	if req.EndStep != nil {
+		query.Where("step <= ?", *req.EndStep)
+	}
+	if req.StartTime != nil {
+		query.Where("timestamp >= ?", *req.StartTime)
+	}
+	if req.EndTime != nil {
+		query.Where("timestamp <= ?", *req.EndTime)
+	}
+	if req.XAxis == request.MetricHistoryXAxisTime {
+		query.Order("metrics.timestamp").Order("metrics.step")
+	} else {
+		query.Order("metrics.step").Order("metrics.timestamp")
+	}
+	if limit > 0 {
+		query.Limit(limit)
+	}
+
+	var metrics []models.Metric
+	if err := query.Find(&metrics).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, req.MetricKey)
 	}
 	return metrics, nil
 }
 
+// GetMetricHistoryVersion returns version of metric history, which changes whenever the metric is logged.
+// The version is the sum of the last iterations of all the metric contexts, so it only ever grows.
+// Renaming of metric keys doesn't change the version, so the callers have to take care of it themselves.
+func (r MetricRepository) GetMetricHistoryVersion(ctx context.Context, runID string, key string) (int64, error) {
+	var version int64
+	if err := r.GetDB().WithContext(
+		ctx,
+	).Model(
+		&models.LatestMetric{},
+	).Where(
+		"run_uuid = ?", runID,
+	).Where(
+		"key = ?", key,
+	).Pluck(
+		"COALESCE(SUM(last_iter), 0)", &version,
+	).Error; err != nil {
+		return 0, eris.Wrapf(err, "error getting metric history version by run id: %s and key: %s", runID, key)
+	}
+	return version, nil
+}
+
+// RenameKey renames the metric key of the Run together with its whole history.
+func (r MetricRepository) RenameKey(ctx context.Context, runID, key, newKey string) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(
+			&models.LatestMetric{},
+		).Where(
+			"run_uuid = ?", runID,
+		).Where(
+			"key = ?", newKey,
+		).Count(&count).Error; err != nil {
+			return eris.Wrapf(err, "error checking metric key: %s of run: %s", newKey, runID)
+		}
+		if count > 0 {
+			return MetricKeyConflictError{RunID: runID, Key: newKey}
+		}
+
+		result := tx.Model(
+			&models.LatestMetric{},
+		).Where(
+			"run_uuid = ?", runID,
+		).Where(
+			"key = ?", key,
+		).Update("key", newKey)
+		if result.Error != nil {
+			return eris.Wrapf(result.Error, "error renaming latest metric key: %s of run: %s", key, runID)
+		}
+		if result.RowsAffected == 0 {
+			return MetricKeyNotFoundError{RunID: runID, Key: key}
+		}
+
+		if err := tx.Model(
+			&models.Metric{},
+		).Where(
+			"run_uuid = ?", runID,
+		).Where(
+			"key = ?", key,
+		).Update("key", newKey).Error; err != nil {
+			return eris.Wrapf(err, "error renaming metric key: %s of run: %s", key, runID)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetMetricHistoryBulk returns metrics history bulk.
 func (r MetricRepository) GetMetricHistoryBulk(
 	ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
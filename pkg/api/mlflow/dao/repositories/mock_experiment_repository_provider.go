@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+
+	time "time"
 )
 
 // MockExperimentRepositoryProvider is an autogenerated mock type for the ExperimentRepositoryProvider type
@@ -17,6 +19,20 @@ type MockExperimentRepositoryProvider struct {
 	mock.Mock
 }
 
+// Archive provides a mock function with given fields: ctx, experiment, actor
+func (_m *MockExperimentRepositoryProvider) Archive(ctx context.Context, experiment *models.Experiment, actor string) error {
+	ret := _m.Called(ctx, experiment, actor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Experiment, string) error); ok {
+		r0 = rf(ctx, experiment, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, experiment
 func (_m *MockExperimentRepositoryProvider) Create(ctx context.Context, experiment *models.Experiment) error {
 	ret := _m.Called(ctx, experiment)
@@ -85,6 +101,32 @@ func (_m *MockExperimentRepositoryProvider) GetByNamespaceIDAndExperimentID(ctx
 	return r0, r1
 }
 
+// GetByNamespaceIDAndExperimentIDs provides a mock function with given fields: ctx, namespaceID, experimentIDs
+func (_m *MockExperimentRepositoryProvider) GetByNamespaceIDAndExperimentIDs(ctx context.Context, namespaceID uint, experimentIDs []int32) ([]models.Experiment, error) {
+	ret := _m.Called(ctx, namespaceID, experimentIDs)
+
+	var r0 []models.Experiment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []int32) ([]models.Experiment, error)); ok {
+		return rf(ctx, namespaceID, experimentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []int32) []models.Experiment); ok {
+		r0 = rf(ctx, namespaceID, experimentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Experiment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []int32) error); ok {
+		r1 = rf(ctx, namespaceID, experimentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByNamespaceIDAndName provides a mock function with given fields: ctx, namespaceID, name
 func (_m *MockExperimentRepositoryProvider) GetByNamespaceIDAndName(ctx context.Context, namespaceID uint, name string) (*models.Experiment, error) {
 	ret := _m.Called(ctx, namespaceID, name)
@@ -111,6 +153,110 @@ func (_m *MockExperimentRepositoryProvider) GetByNamespaceIDAndName(ctx context.
 	return r0, r1
 }
 
+// GetByNamespaceIDAndNameCaseInsensitive provides a mock function with given fields: ctx, namespaceID, name
+func (_m *MockExperimentRepositoryProvider) GetByNamespaceIDAndNameCaseInsensitive(ctx context.Context, namespaceID uint, name string) (*models.Experiment, error) {
+	ret := _m.Called(ctx, namespaceID, name)
+
+	var r0 *models.Experiment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (*models.Experiment, error)); ok {
+		return rf(ctx, namespaceID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) *models.Experiment); ok {
+		r0 = rf(ctx, namespaceID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Experiment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, namespaceID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletionImpact provides a mock function with given fields: ctx, experiment
+func (_m *MockExperimentRepositoryProvider) GetDeletionImpact(ctx context.Context, experiment *models.Experiment) (*models.DeletionImpact, error) {
+	ret := _m.Called(ctx, experiment)
+
+	var r0 *models.DeletionImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Experiment) (*models.DeletionImpact, error)); ok {
+		return rf(ctx, experiment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Experiment) *models.DeletionImpact); ok {
+		r0 = rf(ctx, experiment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeletionImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Experiment) error); ok {
+		r1 = rf(ctx, experiment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, period
+func (_m *MockExperimentRepositoryProvider) PurgeDeleted(ctx context.Context, period time.Duration) (int64, error) {
+	ret := _m.Called(ctx, period)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, period)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, experiment, actor
+func (_m *MockExperimentRepositoryProvider) Restore(ctx context.Context, experiment *models.Experiment, actor string) error {
+	ret := _m.Called(ctx, experiment, actor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Experiment, string) error); ok {
+		r0 = rf(ctx, experiment, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreBatch provides a mock function with given fields: ctx, experiments, lastUpdateTime, actor
+func (_m *MockExperimentRepositoryProvider) RestoreBatch(ctx context.Context, experiments []*models.Experiment, lastUpdateTime int64, actor string) error {
+	ret := _m.Called(ctx, experiments, lastUpdateTime, actor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.Experiment, int64, string) error); ok {
+		r0 = rf(ctx, experiments, lastUpdateTime, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, experiment
 func (_m *MockExperimentRepositoryProvider) Update(ctx context.Context, experiment *models.Experiment) error {
 	ret := _m.Called(ctx, experiment)
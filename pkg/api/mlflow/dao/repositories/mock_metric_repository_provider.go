@@ -21,13 +21,13 @@ type MockMetricRepositoryProvider struct {
 	mock.Mock
 }
 
-// CreateBatch provides a mock function with given fields: ctx, run, batchSize, params
-func (_m *MockMetricRepositoryProvider) CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric) error {
-	ret := _m.Called(ctx, run, batchSize, params)
+// CreateBatch provides a mock function with given fields: ctx, run, batchSize, params, strictSteps
+func (_m *MockMetricRepositoryProvider) CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric, strictSteps bool) error {
+	ret := _m.Called(ctx, run, batchSize, params, strictSteps)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int, []models.Metric) error); ok {
-		r0 = rf(ctx, run, batchSize, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int, []models.Metric, bool) error); ok {
+		r0 = rf(ctx, run, batchSize, params, strictSteps)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -112,23 +112,47 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context
 	return r0, r1
 }
 
-// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string) ([]models.Metric, error) {
-	ret := _m.Called(ctx, runID, key)
+// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, req, limit
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, req *request.GetMetricHistoryRequest, limit int) ([]models.Metric, error) {
+	ret := _m.Called(ctx, runID, req, limit)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Metric, error)); ok {
-		return rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *request.GetMetricHistoryRequest, int) ([]models.Metric, error)); ok {
+		return rf(ctx, runID, req, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Metric); ok {
-		r0 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *request.GetMetricHistoryRequest, int) []models.Metric); ok {
+		r0 = rf(ctx, runID, req, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, *request.GetMetricHistoryRequest, int) error); ok {
+		r1 = rf(ctx, runID, req, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetricHistoryVersion provides a mock function with given fields: ctx, runID, key
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryVersion(ctx context.Context, runID string, key string) (int64, error) {
+	ret := _m.Called(ctx, runID, key)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, runID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, runID, key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
 		r1 = rf(ctx, runID, key)
 	} else {
@@ -138,6 +162,20 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx contex
 	return r0, r1
 }
 
+// RenameKey provides a mock function with given fields: ctx, runID, key, newKey
+func (_m *MockMetricRepositoryProvider) RenameKey(ctx context.Context, runID string, key string, newKey string) error {
+	ret := _m.Called(ctx, runID, key, newKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, runID, key, newKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockMetricRepositoryProvider creates a new instance of MockMetricRepositoryProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMetricRepositoryProvider(t interface {
@@ -31,13 +31,13 @@ func (_m *MockNamespaceRepositoryProvider) Create(ctx context.Context, namespace
 	return r0
 }
 
-// Delete provides a mock function with given fields: ctx, namespace
-func (_m *MockNamespaceRepositoryProvider) Delete(ctx context.Context, namespace *models.Namespace) error {
-	ret := _m.Called(ctx, namespace)
+// Delete provides a mock function with given fields: ctx, namespace, actor
+func (_m *MockNamespaceRepositoryProvider) Delete(ctx context.Context, namespace *models.Namespace, actor string) error {
+	ret := _m.Called(ctx, namespace, actor)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Namespace) error); ok {
-		r0 = rf(ctx, namespace)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Namespace, string) error); ok {
+		r0 = rf(ctx, namespace, actor)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -139,6 +139,32 @@ func (_m *MockNamespaceRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
+// GetUsage provides a mock function with given fields: ctx
+func (_m *MockNamespaceRepositoryProvider) GetUsage(ctx context.Context) (map[uint]models.NamespaceUsage, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[uint]models.NamespaceUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[uint]models.NamespaceUsage, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[uint]models.NamespaceUsage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]models.NamespaceUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx
 func (_m *MockNamespaceRepositoryProvider) List(ctx context.Context) ([]models.Namespace, error) {
 	ret := _m.Called(ctx)
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+
+	time "time"
 )
 
 // MockRunRepositoryProvider is an autogenerated mock type for the RunRepositoryProvider type
@@ -17,13 +19,13 @@ type MockRunRepositoryProvider struct {
 	mock.Mock
 }
 
-// Archive provides a mock function with given fields: ctx, run
-func (_m *MockRunRepositoryProvider) Archive(ctx context.Context, run *models.Run) error {
-	ret := _m.Called(ctx, run)
+// Archive provides a mock function with given fields: ctx, namespaceID, run, actor
+func (_m *MockRunRepositoryProvider) Archive(ctx context.Context, namespaceID uint, run *models.Run, actor string) error {
+	ret := _m.Called(ctx, namespaceID, run, actor)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) error); ok {
-		r0 = rf(ctx, run)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *models.Run, string) error); ok {
+		r0 = rf(ctx, namespaceID, run, actor)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -59,6 +61,46 @@ func (_m *MockRunRepositoryProvider) Create(ctx context.Context, run *models.Run
 	return r0
 }
 
+// CreateWithIdempotencyKey provides a mock function with given fields: ctx, namespaceID, idempotencyKey, run, uniqueName
+func (_m *MockRunRepositoryProvider) CreateWithIdempotencyKey(ctx context.Context, namespaceID uint, idempotencyKey string, run *models.Run, uniqueName bool) (*models.Run, error) {
+	ret := _m.Called(ctx, namespaceID, idempotencyKey, run, uniqueName)
+
+	var r0 *models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *models.Run, bool) (*models.Run, error)); ok {
+		return rf(ctx, namespaceID, idempotencyKey, run, uniqueName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *models.Run, bool) *models.Run); ok {
+		r0 = rf(ctx, namespaceID, idempotencyKey, run, uniqueName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, *models.Run, bool) error); ok {
+		r1 = rf(ctx, namespaceID, idempotencyKey, run, uniqueName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWithUniqueName provides a mock function with given fields: ctx, run
+func (_m *MockRunRepositoryProvider) CreateWithUniqueName(ctx context.Context, run *models.Run) error {
+	ret := _m.Called(ctx, run)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: ctx, namespaceID, run
 func (_m *MockRunRepositoryProvider) Delete(ctx context.Context, namespaceID uint, run *models.Run) error {
 	ret := _m.Called(ctx, namespaceID, run)
@@ -139,6 +181,32 @@ func (_m *MockRunRepositoryProvider) GetByNamespaceIDAndRunID(ctx context.Contex
 	return r0, r1
 }
 
+// GetByNamespaceIDAndRunIDWithRelations provides a mock function with given fields: ctx, namespaceID, runID, relations
+func (_m *MockRunRepositoryProvider) GetByNamespaceIDAndRunIDWithRelations(ctx context.Context, namespaceID uint, runID string, relations []string) (*models.Run, error) {
+	ret := _m.Called(ctx, namespaceID, runID, relations)
+
+	var r0 *models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, []string) (*models.Run, error)); ok {
+		return rf(ctx, namespaceID, runID, relations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, []string) *models.Run); ok {
+		r0 = rf(ctx, namespaceID, runID, relations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, []string) error); ok {
+		r1 = rf(ctx, namespaceID, runID, relations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByNamespaceIDRunIDAndLifecycleStage provides a mock function with given fields: ctx, namespaceID, runID, lifecycleStage
 func (_m *MockRunRepositoryProvider) GetByNamespaceIDRunIDAndLifecycleStage(ctx context.Context, namespaceID uint, runID string, lifecycleStage models.LifecycleStage) (*models.Run, error) {
 	ret := _m.Called(ctx, namespaceID, runID, lifecycleStage)
@@ -181,13 +249,63 @@ func (_m *MockRunRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
-// Restore provides a mock function with given fields: ctx, run
-func (_m *MockRunRepositoryProvider) Restore(ctx context.Context, run *models.Run) error {
+// GetDeletionImpact provides a mock function with given fields: ctx, run
+func (_m *MockRunRepositoryProvider) GetDeletionImpact(ctx context.Context, run *models.Run) (*models.DeletionImpact, error) {
 	ret := _m.Called(ctx, run)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) error); ok {
+	var r0 *models.DeletionImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) (*models.DeletionImpact, error)); ok {
+		return rf(ctx, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) *models.DeletionImpact); ok {
 		r0 = rf(ctx, run)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DeletionImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Run) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, period
+func (_m *MockRunRepositoryProvider) PurgeDeleted(ctx context.Context, period time.Duration) (int64, error) {
+	ret := _m.Called(ctx, period)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (int64, error)); ok {
+		return rf(ctx, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) int64); ok {
+		r0 = rf(ctx, period)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Restore provides a mock function with given fields: ctx, namespaceID, run, actor
+func (_m *MockRunRepositoryProvider) Restore(ctx context.Context, namespaceID uint, run *models.Run, actor string) error {
+	ret := _m.Called(ctx, namespaceID, run, actor)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *models.Run, string) error); ok {
+		r0 = rf(ctx, namespaceID, run, actor)
 	} else {
 		r0 = ret.Error(0)
 	}
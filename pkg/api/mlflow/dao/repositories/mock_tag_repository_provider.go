@@ -31,6 +31,20 @@ func (_m *MockTagRepositoryProvider) CreateExperimentTag(ctx context.Context, ex
 	return r0
 }
 
+// CreateExperimentTags provides a mock function with given fields: ctx, experimentID, experimentTags
+func (_m *MockTagRepositoryProvider) CreateExperimentTags(ctx context.Context, experimentID int32, experimentTags []models.ExperimentTag) error {
+	ret := _m.Called(ctx, experimentID, experimentTags)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []models.ExperimentTag) error); ok {
+		r0 = rf(ctx, experimentID, experimentTags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateRunTagWithTransaction provides a mock function with given fields: ctx, tx, runID, key, value
 func (_m *MockTagRepositoryProvider) CreateRunTagWithTransaction(ctx context.Context, tx *gorm.DB, runID string, key string, value string) error {
 	ret := _m.Called(ctx, tx, runID, key, value)
@@ -59,6 +73,32 @@ func (_m *MockTagRepositoryProvider) Delete(ctx context.Context, tag *models.Tag
 	return r0
 }
 
+// GetByNamespaceIDAndKey provides a mock function with given fields: ctx, namespaceID, key
+func (_m *MockTagRepositoryProvider) GetByNamespaceIDAndKey(ctx context.Context, namespaceID uint, key string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, namespaceID, key)
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) ([]models.Tag, error)); ok {
+		return rf(ctx, namespaceID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) []models.Tag); ok {
+		r0 = rf(ctx, namespaceID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, namespaceID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByRunIDAndKey provides a mock function with given fields: ctx, runID, key
 func (_m *MockTagRepositoryProvider) GetByRunIDAndKey(ctx context.Context, runID string, key string) (*models.Tag, error) {
 	ret := _m.Called(ctx, runID, key)
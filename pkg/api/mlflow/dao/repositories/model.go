@@ -0,0 +1,183 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// RegisteredModelConflictError is returned when a registered model with the same name already exists in the namespace.
+type RegisteredModelConflictError struct {
+	Name string
+}
+
+// Error returns the RegisteredModelConflictError message.
+func (e RegisteredModelConflictError) Error() string {
+	return fmt.Sprintf("registered model(name=%s) already exists", e.Name)
+}
+
+// ModelRepositoryProvider provides an interface to work with `registered model` and `model version` entities.
+type ModelRepositoryProvider interface {
+	// CreateRegisteredModel creates new models.RegisteredModel entity.
+	CreateRegisteredModel(ctx context.Context, registeredModel *models.RegisteredModel) error
+	// GetRegisteredModelByNamespaceIDAndName returns models.RegisteredModel entity with its versions
+	// by Namespace ID and name.
+	GetRegisteredModelByNamespaceIDAndName(
+		ctx context.Context, namespaceID uint, name string,
+	) (*models.RegisteredModel, error)
+	// CreateModelVersion creates new models.ModelVersion entity with the next version number of the registered model.
+	CreateModelVersion(ctx context.Context, modelVersion *models.ModelVersion) error
+	// GetModelVersionByRegisteredModelIDAndVersion returns models.ModelVersion entity
+	// by Registered Model ID and version number.
+	GetModelVersionByRegisteredModelIDAndVersion(
+		ctx context.Context, registeredModelID uint, version int64,
+	) (*models.ModelVersion, error)
+	// TransitionModelVersionStage moves models.ModelVersion entity to its current stage,
+	// optionally archiving the other versions of the registered model in that stage.
+	TransitionModelVersionStage(
+		ctx context.Context, modelVersion *models.ModelVersion, archiveExistingVersions bool,
+	) error
+}
+
+// ModelRepository repository to work with `registered model` and `model version` entities.
+type ModelRepository struct {
+	repositories.BaseRepositoryProvider
+}
+
+// NewModelRepository creates repository to work with `registered model` and `model version` entities.
+func NewModelRepository(db *gorm.DB) *ModelRepository {
+	return &ModelRepository{
+		repositories.NewBaseRepository(db),
+	}
+}
+
+// CreateRegisteredModel creates new models.RegisteredModel entity.
+func (r ModelRepository) CreateRegisteredModel(ctx context.Context, registeredModel *models.RegisteredModel) error {
+	if err := r.GetDB().WithContext(ctx).Omit("Namespace").Create(registeredModel).Error; err != nil {
+		if database.IsUniqueConstraintError(err) {
+			return RegisteredModelConflictError{Name: registeredModel.Name}
+		}
+		return eris.Wrap(err, "error creating registered model entity")
+	}
+	return nil
+}
+
+// GetRegisteredModelByNamespaceIDAndName returns models.RegisteredModel entity with its versions
+// by Namespace ID and name.
+func (r ModelRepository) GetRegisteredModelByNamespaceIDAndName(
+	ctx context.Context, namespaceID uint, name string,
+) (*models.RegisteredModel, error) {
+	var registeredModel models.RegisteredModel
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Versions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("registry_model_versions.version")
+		},
+	).Where(
+		"registry_models.namespace_id = ? AND registry_models.name = ?", namespaceID, name,
+	).First(&registeredModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting registered model by name: %s", name)
+	}
+	return &registeredModel, nil
+}
+
+// CreateModelVersion creates new models.ModelVersion entity with the next version number of the registered model.
+func (r ModelRepository) CreateModelVersion(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// lock the registered model row, so concurrent requests don't get the same version number.
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec(
+				"SELECT id FROM registry_models WHERE id = ? FOR UPDATE", modelVersion.RegisteredModelID,
+			).Error; err != nil {
+				return eris.Wrap(err, "error locking registered model")
+			}
+		}
+		if err := tx.Model(
+			&models.ModelVersion{},
+		).Select(
+			"COALESCE(MAX(version), 0) + 1",
+		).Where(
+			"registered_model_id = ?", modelVersion.RegisteredModelID,
+		).Scan(&modelVersion.Version).Error; err != nil {
+			return eris.Wrap(err, "error getting next model version")
+		}
+		if err := tx.Create(modelVersion).Error; err != nil {
+			return eris.Wrap(err, "error creating model version entity")
+		}
+		if err := tx.Model(
+			&models.RegisteredModel{ID: modelVersion.RegisteredModelID},
+		).Update(
+			"last_updated_time", modelVersion.CreationTime,
+		).Error; err != nil {
+			return eris.Wrap(err, "error updating registered model")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetModelVersionByRegisteredModelIDAndVersion returns models.ModelVersion entity
+// by Registered Model ID and version number.
+func (r ModelRepository) GetModelVersionByRegisteredModelIDAndVersion(
+	ctx context.Context, registeredModelID uint, version int64,
+) (*models.ModelVersion, error) {
+	var modelVersion models.ModelVersion
+	if err := r.GetDB().WithContext(ctx).Where(
+		"registry_model_versions.registered_model_id = ? AND registry_model_versions.version = ?",
+		registeredModelID, version,
+	).First(&modelVersion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting model version: %d", version)
+	}
+	return &modelVersion, nil
+}
+
+// TransitionModelVersionStage moves models.ModelVersion entity to its current stage,
+// optionally archiving the other versions of the registered model in that stage.
+func (r ModelRepository) TransitionModelVersionStage(
+	ctx context.Context, modelVersion *models.ModelVersion, archiveExistingVersions bool,
+) error {
+	return r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if archiveExistingVersions {
+			if err := tx.Model(
+				&models.ModelVersion{},
+			).Where(
+				"registered_model_id = ? AND current_stage = ? AND id != ?",
+				modelVersion.RegisteredModelID, modelVersion.CurrentStage, modelVersion.ID,
+			).Updates(map[string]any{
+				"current_stage":     models.ModelVersionStageArchived,
+				"last_updated_time": modelVersion.LastUpdatedTime,
+			}).Error; err != nil {
+				return eris.Wrap(err, "error archiving existing model versions")
+			}
+		}
+		if err := tx.Model(
+			modelVersion,
+		).Select(
+			"CurrentStage", "LastUpdatedTime",
+		).Updates(modelVersion).Error; err != nil {
+			return eris.Wrap(err, "error updating model version stage")
+		}
+		if err := tx.Model(
+			&models.RegisteredModel{ID: modelVersion.RegisteredModelID},
+		).Update(
+			"last_updated_time", modelVersion.LastUpdatedTime,
+		).Error; err != nil {
+			return eris.Wrap(err, "error updating registered model")
+		}
+		return nil
+	})
+}
@@ -2,7 +2,9 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
@@ -18,8 +20,8 @@ type NamespaceRepositoryProvider interface {
 	Create(ctx context.Context, namespace *models.Namespace) error
 	// Update modifies the existing models.Namespace entity.
 	Update(ctx context.Context, namespace *models.Namespace) error
-	// Delete removes a namespace and it's associated experiments by its ID.
-	Delete(ctx context.Context, namespace *models.Namespace) error
+	// Delete removes a namespace along with all of its data and records it in the audit log on behalf of the actor.
+	Delete(ctx context.Context, namespace *models.Namespace, actor string) error
 	// GetByCode returns namespace by its Code.
 	GetByCode(ctx context.Context, code string) (*models.Namespace, error)
 	// GetByID returns namespace by its ID.
@@ -28,6 +30,8 @@ type NamespaceRepositoryProvider interface {
 	GetByRoles(ctx context.Context, roles []string) ([]models.Namespace, error)
 	// List returns all namespaces.
 	List(ctx context.Context) ([]models.Namespace, error)
+	// GetUsage returns the number of experiments and active runs of each namespace.
+	GetUsage(ctx context.Context) (map[uint]models.NamespaceUsage, error)
 }
 
 // NamespaceRepository repository to work with `namespace` entity.
@@ -58,10 +62,104 @@ func (r NamespaceRepository) Update(ctx context.Context, namespace *models.Names
 	return nil
 }
 
-// Delete removes a namespace and it's associated experiments by its ID.
-func (r NamespaceRepository) Delete(ctx context.Context, namespace *models.Namespace) error {
-	if err := r.GetDB().WithContext(ctx).Delete(namespace).Error; err != nil {
-		return eris.Wrap(err, "error deleting namespace entity")
+// Delete removes a namespace along with all of its data: experiments, runs with their metrics, params, tags,
+// logs and artifacts, registered models, apps with their dashboards, shared tags and role relations.
+// Everything is deleted in a single transaction, so the namespace is either removed completely or kept intact.
+// The deletion is recorded in the audit log on behalf of the actor, which entries outlive the namespace.
+func (r NamespaceRepository) Delete(ctx context.Context, namespace *models.Namespace, actor string) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		experimentIDs := tx.Model(
+			&models.Experiment{},
+		).Select(
+			"experiment_id",
+		).Where(
+			"namespace_id = ?", namespace.ID,
+		)
+		runIDs := tx.Model(
+			&models.Run{},
+		).Select(
+			"run_uuid",
+		).Where(
+			"experiment_id IN (?)", experimentIDs,
+		)
+		registeredModelIDs := tx.Model(
+			&models.RegisteredModel{},
+		).Select(
+			"id",
+		).Where(
+			"namespace_id = ?", namespace.ID,
+		)
+		appIDs := tx.Table("apps").Select("id").Where("namespace_id = ?", namespace.ID)
+		sharedTagIDs := tx.Table("shared_tags").Select("id").Where("namespace_id = ?", namespace.ID)
+
+		// remember the first row number of deleted runs to renumber the remainder runs afterwards.
+		var minRowNum sql.NullInt64
+		if err := tx.Model(
+			&models.Run{},
+		).Where(
+			"experiment_id IN (?)", experimentIDs,
+		).Pluck("MIN(row_num)", &minRowNum).Error; err != nil {
+			return eris.Wrap(err, "error getting first row number of namespace runs")
+		}
+
+		// dependent entities are deleted before the entities they refer to,
+		// so foreign keys never block the deletion, whether they cascade or not.
+		for _, step := range []struct {
+			table string
+			query string
+			args  []any
+		}{
+			{
+				table: "registry_model_versions",
+				query: "registered_model_id IN (?) OR run_uuid IN (?)",
+				args:  []any{registeredModelIDs, runIDs},
+			},
+			{table: "registry_models", query: "namespace_id = ?", args: []any{namespace.ID}},
+			{table: "metrics", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{table: "latest_metrics", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{table: "params", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{table: "tags", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{table: "logs", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{table: "artifacts", query: "run_uuid IN (?)", args: []any{runIDs}},
+			{
+				table: "run_shared_tags",
+				query: "run_id IN (?) OR shared_tag_id IN (?)",
+				args:  []any{runIDs, sharedTagIDs},
+			},
+			{table: "shared_tags", query: "namespace_id = ?", args: []any{namespace.ID}},
+			{table: "run_idempotency_keys", query: "namespace_id = ?", args: []any{namespace.ID}},
+			{table: "runs", query: "experiment_id IN (?)", args: []any{experimentIDs}},
+			{table: "experiment_tags", query: "experiment_id IN (?)", args: []any{experimentIDs}},
+			{table: "experiments", query: "namespace_id = ?", args: []any{namespace.ID}},
+			{table: "dashboards", query: "app_id IN (?)", args: []any{appIDs}},
+			{table: "apps", query: "namespace_id = ?", args: []any{namespace.ID}},
+			{table: "role_namespaces", query: "namespace_id = ?", args: []any{namespace.ID}},
+		} {
+			if err := tx.Exec(
+				fmt.Sprintf("DELETE FROM %s WHERE %s", step.table, step.query), step.args...,
+			).Error; err != nil {
+				return eris.Wrapf(err, "error deleting %s of namespace", step.table)
+			}
+		}
+
+		if err := tx.Unscoped().Delete(namespace).Error; err != nil {
+			return eris.Wrap(err, "error deleting namespace entity")
+		}
+
+		if minRowNum.Valid {
+			if err := NewRunRepository(tx).renumberRows(tx, models.RowNum(minRowNum.Int64)); err != nil {
+				return eris.Wrap(err, "error renumbering runs.row_num")
+			}
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			namespace.ID,
+			actor,
+			models.AuditLogEntityTypeNamespace,
+			models.AuditLogActionPurge,
+			fmt.Sprintf("%d", namespace.ID),
+		))
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting namespace with id: %d", namespace.ID)
 	}
 	return nil
 }
@@ -118,3 +216,53 @@ func (r NamespaceRepository) List(ctx context.Context) ([]models.Namespace, erro
 	}
 	return namespaces, nil
 }
+
+// GetUsage returns the number of experiments and active runs of each namespace.
+func (r NamespaceRepository) GetUsage(ctx context.Context) (map[uint]models.NamespaceUsage, error) {
+	var experimentCounts []struct {
+		NamespaceID uint
+		Count       int64
+	}
+	if err := r.GetDB().WithContext(ctx).Model(
+		&models.Experiment{},
+	).Select(
+		"namespace_id, COUNT(*) AS count",
+	).Group(
+		"namespace_id",
+	).Scan(&experimentCounts).Error; err != nil {
+		return nil, eris.Wrap(err, "error counting experiments of namespaces")
+	}
+
+	var runCounts []struct {
+		NamespaceID uint
+		Count       int64
+	}
+	if err := r.GetDB().WithContext(ctx).Model(
+		&models.Run{},
+	).Select(
+		"experiments.namespace_id, COUNT(*) AS count",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id",
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	).Group(
+		"experiments.namespace_id",
+	).Scan(&runCounts).Error; err != nil {
+		return nil, eris.Wrap(err, "error counting active runs of namespaces")
+	}
+
+	usage := make(map[uint]models.NamespaceUsage, len(experimentCounts))
+	for _, count := range experimentCounts {
+		usage[count.NamespaceID] = models.NamespaceUsage{
+			NamespaceID:     count.NamespaceID,
+			ExperimentCount: count.Count,
+		}
+	}
+	for _, count := range runCounts {
+		namespaceUsage := usage[count.NamespaceID]
+		namespaceUsage.NamespaceID = count.NamespaceID
+		namespaceUsage.ActiveRunCount = count.Count
+		usage[count.NamespaceID] = namespaceUsage
+	}
+	return usage, nil
+}
@@ -125,8 +125,8 @@ func (r NamespaceCachedRepository) GetByID(ctx context.Context, id uint) (*model
 }
 
 // Delete deletes existing models.Namespace entity.
-func (r NamespaceCachedRepository) Delete(ctx context.Context, namespace *models.Namespace) error {
-	if err := r.namespaceRepository.Delete(ctx, namespace); err != nil {
+func (r NamespaceCachedRepository) Delete(ctx context.Context, namespace *models.Namespace, actor string) error {
+	if err := r.namespaceRepository.Delete(ctx, namespace, actor); err != nil {
 		return eris.Wrap(err, "error deleting cached namespace entity")
 	}
 
@@ -143,6 +143,11 @@ func (r NamespaceCachedRepository) List(ctx context.Context) ([]models.Namespace
 	return r.namespaceRepository.List(ctx)
 }
 
+// GetUsage returns the number of experiments and active runs of each namespace.
+func (r NamespaceCachedRepository) GetUsage(ctx context.Context) (map[uint]models.NamespaceUsage, error) {
+	return r.namespaceRepository.GetUsage(ctx)
+}
+
 // processEvent process incoming event from database.
 func (r NamespaceCachedRepository) processEvent(data string) error {
 	log.Debugf("got incoming namespace event: %s", data)
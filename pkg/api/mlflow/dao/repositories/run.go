@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/rotisserie/eris"
@@ -15,6 +16,16 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
+// RunNameConflictError is returned when a run with the same name already exists in the experiment.
+type RunNameConflictError struct {
+	Name string
+}
+
+// Error returns the RunNameConflictError message.
+func (e RunNameConflictError) Error() string {
+	return fmt.Sprintf("run(name=%s) already exists in the experiment", e.Name)
+}
+
 // RunRepositoryProvider provides an interface to work with models.Run entity.
 type RunRepositoryProvider interface {
 	repositories.BaseRepositoryProvider
@@ -28,20 +39,38 @@ type RunRepositoryProvider interface {
 	GetByNamespaceIDAndRunID(
 		ctx context.Context, namespaceID uint, runID string,
 	) (*models.Run, error)
+	// GetByNamespaceIDAndRunIDWithRelations returns models.Run entity by Namespace ID and its ID
+	// with only the requested relations preloaded.
+	GetByNamespaceIDAndRunIDWithRelations(
+		ctx context.Context, namespaceID uint, runID string, relations []string,
+	) (*models.Run, error)
 	// Create creates new models.Run entity.
 	Create(ctx context.Context, run *models.Run) error
+	// CreateWithUniqueName creates new models.Run entity unless the experiment already has a run with the same name.
+	CreateWithUniqueName(ctx context.Context, run *models.Run) error
+	// CreateWithIdempotencyKey creates new models.Run entity or returns the existing active one
+	// with the same idempotency key in the namespace. When uniqueName is set, the new run name has to be
+	// unique in the experiment.
+	CreateWithIdempotencyKey(
+		ctx context.Context, namespaceID uint, idempotencyKey string, run *models.Run, uniqueName bool,
+	) (*models.Run, error)
 	// Update updates existing models.Experiment entity.
 	Update(ctx context.Context, run *models.Run) error
-	// Archive marks existing models.Run entity as archived.
-	Archive(ctx context.Context, run *models.Run) error
+	// Archive marks existing models.Run entity as archived and records it in the audit log on behalf of the actor.
+	Archive(ctx context.Context, namespaceID uint, run *models.Run, actor string) error
 	// Delete removes the existing models.Run
 	Delete(ctx context.Context, namespaceID uint, run *models.Run) error
-	// Restore marks existing models.Run entity as active.
-	Restore(ctx context.Context, run *models.Run) error
+	// Restore marks existing models.Run entity as active and records it in the audit log on behalf of the actor.
+	Restore(ctx context.Context, namespaceID uint, run *models.Run, actor string) error
 	// ArchiveBatch marks existing models.Run entities as archived.
 	ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error
 	// DeleteBatch removes the existing models.Run from the db.
 	DeleteBatch(ctx context.Context, namespaceID uint, ids []string) error
+	// GetDeletionImpact returns the number of entities which would be affected by deletion of the run.
+	GetDeletionImpact(ctx context.Context, run *models.Run) (*models.DeletionImpact, error)
+	// PurgeDeleted removes runs which were deleted more than period ago from the db
+	// and records it in the audit log.
+	PurgeDeleted(ctx context.Context, period time.Duration) (int64, error)
 	// RestoreBatch marks existing models.Run entities as active.
 	RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error
 	// SetRunTagsBatch sets Run tags in batch.
@@ -50,6 +79,13 @@ type RunRepositoryProvider interface {
 	UpdateWithTransaction(ctx context.Context, tx *gorm.DB, run *models.Run) error
 }
 
+// Relations of models.Run entity which could be preloaded.
+const (
+	RunRelationLatestMetrics = "LatestMetrics"
+	RunRelationParams        = "Params"
+	RunRelationTags          = "Tags"
+)
+
 // RunRepository repository to work with models.Run entity.
 type RunRepository struct {
 	repositories.BaseRepositoryProvider
@@ -103,17 +139,23 @@ func (r RunRepository) GetByNamespaceIDRunIDAndLifecycleStage(
 // GetByNamespaceIDAndRunID returns models.Run entity by Namespace ID and its ID.
 func (r RunRepository) GetByNamespaceIDAndRunID(
 	ctx context.Context, namespaceID uint, runID string,
+) (*models.Run, error) {
+	return r.GetByNamespaceIDAndRunIDWithRelations(
+		ctx, namespaceID, runID, []string{RunRelationLatestMetrics, RunRelationParams, RunRelationTags},
+	)
+}
+
+// GetByNamespaceIDAndRunIDWithRelations returns models.Run entity by Namespace ID and its ID
+// with only the requested relations preloaded.
+func (r RunRepository) GetByNamespaceIDAndRunIDWithRelations(
+	ctx context.Context, namespaceID uint, runID string, relations []string,
 ) (*models.Run, error) {
 	run := models.Run{ID: runID}
-	if err := r.GetDB().WithContext(
-		ctx,
-	).Preload(
-		"LatestMetrics",
-	).Preload(
-		"Params",
-	).Preload(
-		"Tags",
-	).Joins(
+	query := r.GetDB().WithContext(ctx)
+	for _, relation := range relations {
+		query = query.Preload(relation)
+	}
+	if err := query.Joins(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
 	).First(&run).Error; err != nil {
@@ -141,6 +183,118 @@ func (r RunRepository) Create(ctx context.Context, run *models.Run) error {
 	return nil
 }
 
+// CreateWithUniqueName creates new models.Run entity unless the experiment already has a run with the same name.
+func (r RunRepository) CreateWithUniqueName(ctx context.Context, run *models.Run) error {
+	// Lock need to calculate row_num and to make lookup and insert atomic.
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("LOCK TABLE runs").Error; err != nil {
+				return err
+			}
+		}
+		return r.createWithUniqueName(tx, run)
+	}); err != nil {
+		if errors.As(err, &RunNameConflictError{}) {
+			return err
+		}
+		return eris.Wrapf(err, "error creating new 'run' entity with unique name: %s", run.Name)
+	}
+	return nil
+}
+
+// CreateWithIdempotencyKey creates new models.Run entity or returns the existing active one
+// with the same idempotency key in the namespace. The key of the deleted run is released, so the new run
+// is created with it. When uniqueName is set, the new run name has to be unique in the experiment.
+func (r RunRepository) CreateWithIdempotencyKey(
+	ctx context.Context, namespaceID uint, idempotencyKey string, run *models.Run, uniqueName bool,
+) (*models.Run, error) {
+	existingRun, err := r.getByIdempotencyKey(ctx, namespaceID, idempotencyKey)
+	if err != nil || existingRun != nil {
+		return existingRun, err
+	}
+
+	// Lock need to calculate row_num
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("LOCK TABLE runs").Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where(
+			"namespace_id = ? AND key = ?", namespaceID, idempotencyKey,
+		).Where(
+			"run_uuid IN (?)",
+			tx.Model(&models.Run{}).Select("run_uuid").Where("lifecycle_stage = ?", models.LifecycleStageDeleted),
+		).Delete(&models.RunIdempotencyKey{}).Error; err != nil {
+			return eris.Wrap(err, "error releasing idempotency key of deleted run")
+		}
+		if uniqueName {
+			if err := r.createWithUniqueName(tx, run); err != nil {
+				return err
+			}
+		} else if err := tx.Create(&run).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.RunIdempotencyKey{
+			NamespaceID: namespaceID,
+			Key:         idempotencyKey,
+			RunID:       run.ID,
+		}).Error
+	}); err != nil {
+		if errors.As(err, &RunNameConflictError{}) {
+			return nil, err
+		}
+		// the concurrent request with the same key has created its run first, so that run is returned.
+		if database.IsUniqueConstraintError(err) {
+			existingRun, getErr := r.getByIdempotencyKey(ctx, namespaceID, idempotencyKey)
+			if getErr == nil && existingRun != nil {
+				return existingRun, nil
+			}
+		}
+		return nil, eris.Wrapf(err, "error creating new 'run' entity with idempotency key: %s", idempotencyKey)
+	}
+	return run, nil
+}
+
+// getByIdempotencyKey returns the active models.Run entity created with the idempotency key in the namespace.
+func (r RunRepository) getByIdempotencyKey(
+	ctx context.Context, namespaceID uint, idempotencyKey string,
+) (*models.Run, error) {
+	run := models.Run{}
+	if err := r.GetDB().WithContext(ctx).Preload(
+		"Tags",
+	).Joins(
+		"INNER JOIN run_idempotency_keys ON run_idempotency_keys.run_uuid = runs.run_uuid",
+	).Where(
+		"run_idempotency_keys.namespace_id = ? AND run_idempotency_keys.key = ?", namespaceID, idempotencyKey,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting 'run' entity by idempotency key: %s", idempotencyKey)
+	}
+	return &run, nil
+}
+
+// createWithUniqueName creates new models.Run entity in scope of transaction,
+// unless the experiment already has a run with the same name.
+func (r RunRepository) createWithUniqueName(tx *gorm.DB, run *models.Run) error {
+	var count int64
+	if err := tx.Model(
+		&models.Run{},
+	).Where(
+		"experiment_id = ? AND name = ?", run.ExperimentID, run.Name,
+	).Count(&count).Error; err != nil {
+		return eris.Wrapf(err, "error counting runs with name: %s", run.Name)
+	}
+	if count > 0 {
+		return RunNameConflictError{Name: run.Name}
+	}
+	return tx.Create(&run).Error
+}
+
 // Update updates existing models.Run entity.
 func (r RunRepository) Update(ctx context.Context, run *models.Run) error {
 	if err := r.GetDB().WithContext(ctx).Model(&run).Updates(run).Error; err != nil {
@@ -149,20 +303,41 @@ func (r RunRepository) Update(ctx context.Context, run *models.Run) error {
 	return nil
 }
 
-// Archive marks existing models.Run entity as archived.
-func (r RunRepository) Archive(ctx context.Context, run *models.Run) error {
+// Archive marks existing models.Run entity as archived and records it in the audit log on behalf of the actor.
+func (r RunRepository) Archive(ctx context.Context, namespaceID uint, run *models.Run, actor string) error {
 	run.DeletedTime = sql.NullInt64{
 		Int64: time.Now().UTC().UnixMilli(),
 		Valid: true,
 	}
+	run.LastUpdateTime = run.DeletedTime
 	run.LifecycleStage = models.LifecycleStageDeleted
-	if err := r.GetDB().WithContext(ctx).Model(&run).Updates(run).Error; err != nil {
-		return eris.Wrapf(err, "error updating existing run with id: %s", run.ID)
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&run).Updates(run).Error; err != nil {
+			return eris.Wrapf(err, "error updating existing run with id: %s", run.ID)
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			namespaceID, actor, models.AuditLogEntityTypeRun, models.AuditLogActionDelete, run.ID,
+		))
+	}); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// GetDeletionImpact returns the number of entities which would be affected by deletion of the run.
+func (r RunRepository) GetDeletionImpact(ctx context.Context, run *models.Run) (*models.DeletionImpact, error) {
+	impact := models.DeletionImpact{Runs: 1}
+	if err := r.GetDB().WithContext(ctx).Model(
+		&models.Metric{},
+	).Where(
+		"run_uuid = ?", run.ID,
+	).Count(&impact.Metrics).Error; err != nil {
+		return nil, eris.Wrapf(err, "error counting metrics of run with id: %s", run.ID)
+	}
+	return &impact, nil
+}
+
 // ArchiveBatch marks existing models.Run entities as archived.
 func (r RunRepository) ArchiveBatch(ctx context.Context, namespaceID uint, ids []string) error {
 	if err := r.GetDB().WithContext(
@@ -241,14 +416,104 @@ func (r RunRepository) DeleteBatch(ctx context.Context, namespaceID uint, ids []
 	return nil
 }
 
-// Restore marks existing models.Run entity as active.
-func (r RunRepository) Restore(ctx context.Context, run *models.Run) error {
-	// Use UpdateColumns so we can reset DeletedTime to null
-	if err := r.GetDB().WithContext(ctx).Model(&run).UpdateColumns(map[string]any{
-		"DeletedTime":    sql.NullInt64{},
-		"LifecycleStage": database.LifecycleStageActive,
-	}).Error; err != nil {
-		return eris.Wrapf(err, "error updating existing run with id: %s", run.ID)
+// PurgeDeleted removes runs which were deleted more than period ago together with their metrics,
+// params and tags from the db and records it in the audit log.
+func (r RunRepository) PurgeDeleted(ctx context.Context, period time.Duration) (int64, error) {
+	var runs []models.Run
+	deletedBefore := time.Now().Add(-period).UTC().UnixMilli()
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteRunsData(tx, tx.Model(
+			&models.Run{},
+		).Select(
+			"run_uuid",
+		).Where(
+			"lifecycle_stage = ?", models.LifecycleStageDeleted,
+		).Where(
+			"deleted_time < ?", deletedBefore,
+		)); err != nil {
+			return eris.Wrap(err, "error deleting data of expired deleted runs")
+		}
+
+		if err := tx.Clauses(
+			clause.Returning{Columns: []clause.Column{{Name: "row_num"}, {Name: "run_uuid"}, {Name: "experiment_id"}}},
+		).Where(
+			"lifecycle_stage = ?", models.LifecycleStageDeleted,
+		).Where(
+			"deleted_time < ?", deletedBefore,
+		).Delete(
+			&runs,
+		).Error; err != nil {
+			return eris.Wrap(err, "error deleting expired deleted runs")
+		}
+
+		if len(runs) == 0 {
+			return nil
+		}
+
+		// renumber the remainder
+		if err := r.renumberRows(tx, getMinRowNum(runs)); err != nil {
+			return eris.Wrapf(err, "error renumbering runs.row_num")
+		}
+
+		// experiments of the purged runs still exist, as runs of the purged experiments are removed by the cascade.
+		experimentIDs := make([]int32, len(runs))
+		for i, run := range runs {
+			experimentIDs[i] = run.ExperimentID
+		}
+		var experiments []models.Experiment
+		if err := tx.Select(
+			"experiment_id", "namespace_id",
+		).Where(
+			"experiment_id IN ?", experimentIDs,
+		).Find(&experiments).Error; err != nil {
+			return eris.Wrap(err, "error getting experiments of purged runs")
+		}
+		namespaceIDs := make(map[int32]uint, len(experiments))
+		for _, experiment := range experiments {
+			namespaceIDs[*experiment.ID] = experiment.NamespaceID
+		}
+		auditLogs := make([]models.AuditLog, 0, len(runs))
+		for _, run := range runs {
+			auditLogs = append(auditLogs, models.NewAuditLogs(
+				namespaceIDs[run.ExperimentID],
+				models.AuditLogActorSystem,
+				models.AuditLogEntityTypeRun,
+				models.AuditLogActionPurge,
+				run.ID,
+			)...)
+		}
+		return createAuditLogs(tx, auditLogs)
+	}); err != nil {
+		return 0, eris.Wrap(err, "error purging runs")
+	}
+
+	return int64(len(runs)), nil
+}
+
+// Restore marks existing models.Run entity as active and records it in the audit log on behalf of the actor.
+func (r RunRepository) Restore(ctx context.Context, namespaceID uint, run *models.Run, actor string) error {
+	run.DeletedTime = sql.NullInt64{}
+	run.DeletedWithExperiment = false
+	run.LastUpdateTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	run.LifecycleStage = models.LifecycleStageActive
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Use UpdateColumns so we can reset DeletedTime to null
+		if err := tx.Model(&run).UpdateColumns(map[string]any{
+			"DeletedTime":           run.DeletedTime,
+			"DeletedWithExperiment": false,
+			"LastUpdateTime":        run.LastUpdateTime,
+			"LifecycleStage":        run.LifecycleStage,
+		}).Error; err != nil {
+			return eris.Wrapf(err, "error updating existing run with id: %s", run.ID)
+		}
+		return createAuditLogs(tx, models.NewAuditLogs(
+			namespaceID, actor, models.AuditLogEntityTypeRun, models.AuditLogActionRestore, run.ID,
+		))
+	}); err != nil {
+		return err
 	}
 
 	return nil
@@ -256,8 +521,11 @@ func (r RunRepository) Restore(ctx context.Context, run *models.Run) error {
 
 // RestoreBatch marks existing models.Run entities as active.
 func (r RunRepository) RestoreBatch(ctx context.Context, namespaceID uint, ids []string) error {
+	// Use UpdateColumns so we can reset DeletedTime to null
 	if err := r.GetDB().WithContext(
 		ctx,
+	).Model(
+		models.Run{},
 	).Where(
 		"run_uuid IN (?)",
 		r.GetDB().Model(
@@ -270,9 +538,10 @@ func (r RunRepository) RestoreBatch(ctx context.Context, namespaceID uint, ids [
 		).Where(
 			"run_uuid IN (?)", ids,
 		),
-	).Updates(models.Run{
-		DeletedTime:    sql.NullInt64{},
-		LifecycleStage: models.LifecycleStageActive,
+	).UpdateColumns(map[string]any{
+		"DeletedTime":           sql.NullInt64{},
+		"DeletedWithExperiment": false,
+		"LifecycleStage":        models.LifecycleStageActive,
 	}).Error; err != nil {
 		return eris.Wrapf(err, "error updating existing runs with ids: %s", ids)
 	}
@@ -318,6 +587,32 @@ func (r RunRepository) SetRunTagsBatch(ctx context.Context, run *models.Run, bat
 	return nil
 }
 
+// deleteRunsData removes metrics, params, tags and other dependent entities of the runs selected by runIDs.
+// They are deleted explicitly, as sqlite does not cascade the deletion of runs.
+func deleteRunsData(tx *gorm.DB, runIDs *gorm.DB) error {
+	for _, step := range []struct {
+		table string
+		query string
+	}{
+		{table: "registry_model_versions", query: "run_uuid IN (?)"},
+		{table: "metrics", query: "run_uuid IN (?)"},
+		{table: "latest_metrics", query: "run_uuid IN (?)"},
+		{table: "params", query: "run_uuid IN (?)"},
+		{table: "tags", query: "run_uuid IN (?)"},
+		{table: "logs", query: "run_uuid IN (?)"},
+		{table: "artifacts", query: "run_uuid IN (?)"},
+		{table: "run_shared_tags", query: "run_id IN (?)"},
+		{table: "run_idempotency_keys", query: "run_uuid IN (?)"},
+	} {
+		if err := tx.Exec(
+			fmt.Sprintf("DELETE FROM %s WHERE %s", step.table, step.query), runIDs,
+		).Error; err != nil {
+			return eris.Wrapf(err, "error deleting %s of runs", step.table)
+		}
+	}
+	return nil
+}
+
 // getMinRowNum will find the lowest row_num for the slice of runs
 // or 0 for an empty slice
 func getMinRowNum(runs []models.Run) models.RowNum {
@@ -16,10 +16,14 @@ type TagRepositoryProvider interface {
 	repositories.BaseRepositoryProvider
 	// CreateExperimentTag creates new models.ExperimentTag entity connected to models.Experiment.
 	CreateExperimentTag(ctx context.Context, experimentTag *models.ExperimentTag) error
+	// CreateExperimentTags creates or updates []models.ExperimentTag entities of models.Experiment in a transaction.
+	CreateExperimentTags(ctx context.Context, experimentID int32, experimentTags []models.ExperimentTag) error
 	// CreateRunTagWithTransaction creates new models.Tag entity connected to models.Run.
 	CreateRunTagWithTransaction(ctx context.Context, tx *gorm.DB, runID, key, value string) error
 	// GetByRunIDAndKey returns models.Tag by provided RunID and Tag Key.
 	GetByRunIDAndKey(ctx context.Context, runID, key string) (*models.Tag, error)
+	// GetByNamespaceIDAndKey returns models.Tag entities with provided Tag Key of all the runs in the namespace.
+	GetByNamespaceIDAndKey(ctx context.Context, namespaceID uint, key string) ([]models.Tag, error)
 	// Delete deletes existing models.Tag entity.
 	Delete(ctx context.Context, tag *models.Tag) error
 }
@@ -46,6 +50,20 @@ func (r TagRepository) CreateExperimentTag(ctx context.Context, experimentTag *m
 	return nil
 }
 
+// CreateExperimentTags creates or updates []models.ExperimentTag entities of models.Experiment in a transaction.
+func (r TagRepository) CreateExperimentTags(
+	ctx context.Context, experimentID int32, experimentTags []models.ExperimentTag,
+) error {
+	if err := r.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			UpdateAll: true,
+		}).Create(&experimentTags).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error creating tags for experiment with id: %d", experimentID)
+	}
+	return nil
+}
+
 // CreateRunTagWithTransaction creates new models.Tag entity connected to models.Run.
 func (r TagRepository) CreateRunTagWithTransaction(
 	ctx context.Context, tx *gorm.DB, runID, key, value string,
@@ -74,6 +92,24 @@ func (r TagRepository) GetByRunIDAndKey(ctx context.Context, runID, key string)
 	return &tag, nil
 }
 
+// GetByNamespaceIDAndKey returns models.Tag entities with provided Tag Key of all the runs in the namespace.
+func (r TagRepository) GetByNamespaceIDAndKey(
+	ctx context.Context, namespaceID uint, key string,
+) ([]models.Tag, error) {
+	var tags []models.Tag
+	if err := r.GetDB().WithContext(ctx).Joins(
+		"INNER JOIN runs ON runs.run_uuid = tags.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"tags.key = ?", key,
+	).Find(&tags).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting tags by namespace id: %d and tag key: %s", namespaceID, key)
+	}
+	return tags, nil
+}
+
 // Delete deletes existing models.Tag entity.
 func (r TagRepository) Delete(ctx context.Context, tag *models.Tag) error {
 	if err := r.GetDB().Delete(tag).Error; err != nil {
@@ -9,29 +9,37 @@ import (
 
 // List of route prefixes.
 const (
-	RunsRoutePrefix        = "/runs"
-	MetricsRoutePrefix     = "/metrics"
-	ArtifactsRoutePrefix   = "/artifacts"
-	ExperimentsRoutePrefix = "/experiments"
+	RunsRoutePrefix             = "/runs"
+	MetricsRoutePrefix          = "/metrics"
+	ArtifactsRoutePrefix        = "/artifacts"
+	ExperimentsRoutePrefix      = "/experiments"
+	ModelVersionsRoutePrefix    = "/model-versions"
+	RegisteredModelsRoutePrefix = "/registered-models"
 )
 
 // List of `/artifact/*` routes.
 const (
-	ArtifactsGetRoute  = "/get"
-	ArtifactsListRoute = "/list"
+	ArtifactsGetRoute    = "/get"
+	ArtifactsListRoute   = "/list"
+	ArtifactsUploadRoute = "/upload"
+	ArtifactsDeleteRoute = "/delete"
 )
 
 // List of `/experiments/*` routes.
 const (
-	ExperimentsGetRoute         = "/get"
-	ExperimentsListRoute        = "/list"
-	ExperimentsCreateRoute      = "/create"
-	ExperimentsDeleteRoute      = "/delete"
-	ExperimentsRestoreRoute     = "/restore"
-	ExperimentsSearchRoute      = "/search"
-	ExperimentsUpdateRoute      = "/update"
-	ExperimentsGetByNameRoute   = "/get-by-name"
-	ExperimentsSetExperimentTag = "/set-experiment-tag"
+	ExperimentsGetRoute          = "/get"
+	ExperimentsListRoute         = "/list"
+	ExperimentsCreateRoute       = "/create"
+	ExperimentsCloneRoute        = "/clone"
+	ExperimentsDeleteRoute       = "/delete"
+	ExperimentsRestoreRoute      = "/restore"
+	ExperimentsBatchRestoreRoute = "/batch-restore"
+	ExperimentsBatchGetRoute     = "/batch-get"
+	ExperimentsSearchRoute       = "/search"
+	ExperimentsUpdateRoute       = "/update"
+	ExperimentsGetByNameRoute    = "/get-by-name"
+	ExperimentsSetExperimentTag  = "/set-experiment-tag"
+	ExperimentsSetExperimentTags = "/set-experiment-tags"
 )
 
 // List of `/metrics/*` routes.
@@ -41,6 +49,20 @@ const (
 	MetricsGetHistoryBulkRoute = "/get-history-bulk"
 )
 
+// List of `/model-versions/*` routes.
+const (
+	ModelVersionsCreateRoute          = "/create"
+	ModelVersionsSearchRoute          = "/search"
+	ModelVersionsTransitionStageRoute = "/transition-stage"
+)
+
+// List of `/registered-models/*` routes.
+const (
+	RegisteredModelsCreateRoute = "/create"
+	RegisteredModelsGetRoute    = "/get"
+	RegisteredModelsSearchRoute = "/search"
+)
+
 // List of `/runs/*` routes.
 const (
 	RunsGetRoute          = "/get"
@@ -54,8 +76,12 @@ const (
 	RunsLogBatchRoute     = "/log-batch"
 	RunsLogMetricRoute    = "/log-metric"
 	RunsLogParameterRoute = "/log-parameter"
+	RunsLogParamsRoute    = "/log-params"
 	RunsLogOutputRoute    = "/log-output"
 	RunsLogArtifactRoute  = "/log-artifact"
+	RunsFinalizeRoute     = "/finalize"
+	RunsLineageRoute      = "/lineage"
+	RunsLogsStreamRoute   = "/logs/stream"
 )
 
 // Router represents `mlflow` router.
@@ -90,17 +116,23 @@ func (r *Router) Init(router fiber.Router) {
 		artifacts := mainGroup.Group(ArtifactsRoutePrefix)
 		artifacts.Get(ArtifactsGetRoute, r.controller.GetArtifact)
 		artifacts.Get(ArtifactsListRoute, r.controller.ListArtifacts)
+		artifacts.Post(ArtifactsUploadRoute, r.controller.UploadArtifact)
+		artifacts.Post(ArtifactsDeleteRoute, r.controller.DeleteArtifact)
 
 		experiments := mainGroup.Group(ExperimentsRoutePrefix)
+		experiments.Post(ExperimentsCloneRoute, r.controller.CloneExperiment)
 		experiments.Post(ExperimentsCreateRoute, r.controller.CreateExperiment)
 		experiments.Post(ExperimentsDeleteRoute, r.controller.DeleteExperiment)
 		experiments.Get(ExperimentsGetRoute, r.controller.GetExperiment)
 		experiments.Get(ExperimentsGetByNameRoute, r.controller.GetExperimentByName)
 		experiments.Get(ExperimentsListRoute, r.controller.SearchExperiments)
+		experiments.Post(ExperimentsBatchGetRoute, r.controller.GetExperimentsBatch)
 		experiments.Post(ExperimentsRestoreRoute, r.controller.RestoreExperiment)
+		experiments.Post(ExperimentsBatchRestoreRoute, r.controller.RestoreExperimentsBatch)
 		experiments.Get(ExperimentsSearchRoute, r.controller.SearchExperiments)
 		experiments.Post(ExperimentsSearchRoute, r.controller.SearchExperiments)
 		experiments.Post(ExperimentsSetExperimentTag, r.controller.SetExperimentTag)
+		experiments.Post(ExperimentsSetExperimentTags, r.controller.SetExperimentTags)
 		experiments.Post(ExperimentsUpdateRoute, r.controller.UpdateExperiment)
 
 		metrics := mainGroup.Group(MetricsRoutePrefix)
@@ -112,10 +144,14 @@ func (r *Router) Init(router fiber.Router) {
 		runs.Post(RunsCreateRoute, r.controller.CreateRun)
 		runs.Post(RunsDeleteRoute, r.controller.DeleteRun)
 		runs.Post(RunsDeleteTagRoute, r.controller.DeleteRunTag)
+		runs.Post(RunsFinalizeRoute, r.controller.FinalizeRun)
 		runs.Get(RunsGetRoute, r.controller.GetRun)
+		runs.Get(RunsLineageRoute, r.controller.GetRunLineage)
+		runs.Get(RunsLogsStreamRoute, r.controller.StreamRunLogs)
 		runs.Post(RunsLogBatchRoute, r.controller.LogBatch)
 		runs.Post(RunsLogMetricRoute, r.controller.LogMetric)
 		runs.Post(RunsLogParameterRoute, r.controller.LogParam)
+		runs.Post(RunsLogParamsRoute, r.controller.LogParams)
 		runs.Post(RunsRestoreRoute, r.controller.RestoreRun)
 		runs.Post(RunsSearchRoute, r.controller.SearchRuns)
 		runs.Post(RunsSetTagRoute, r.controller.SetRunTag)
@@ -123,8 +159,15 @@ func (r *Router) Init(router fiber.Router) {
 		runs.Post(RunsLogOutputRoute, r.controller.LogOutput)
 		runs.Post(RunsLogArtifactRoute, r.controller.LogArtifact)
 
-		mainGroup.Get("/model-versions/search", r.controller.SearchModelVersions)
-		mainGroup.Get("/registered-models/search", r.controller.SearchRegisteredModels)
+		modelVersions := mainGroup.Group(ModelVersionsRoutePrefix)
+		modelVersions.Post(ModelVersionsCreateRoute, r.controller.CreateModelVersion)
+		modelVersions.Get(ModelVersionsSearchRoute, r.controller.SearchModelVersions)
+		modelVersions.Post(ModelVersionsTransitionStageRoute, r.controller.TransitionModelVersionStage)
+
+		registeredModels := mainGroup.Group(RegisteredModelsRoutePrefix)
+		registeredModels.Post(RegisteredModelsCreateRoute, r.controller.CreateRegisteredModel)
+		registeredModels.Get(RegisteredModelsGetRoute, r.controller.GetRegisteredModel)
+		registeredModels.Get(RegisteredModelsSearchRoute, r.controller.SearchRegisteredModels)
 
 		mainGroup.Use(func(c *fiber.Ctx) error {
 			return api.NewEndpointNotFound("Not found")
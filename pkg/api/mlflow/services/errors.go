@@ -42,6 +42,9 @@ func ErrorHandler(c *fiber.Ctx, err error) error {
 	case api.ErrorCodeTemporarilyUnavailable:
 		code = fiber.StatusServiceUnavailable
 		fn = log.Warnf
+	case api.ErrorCodeRequestLimitExceeded:
+		code = fiber.StatusTooManyRequests
+		fn = log.Warnf
 	case api.ErrorCodeEndpointNotFound, api.ErrorCodeResourceDoesNotExist:
 		code = fiber.StatusNotFound
 		fn = log.Debugf
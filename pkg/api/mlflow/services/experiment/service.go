@@ -2,9 +2,9 @@ package experiment
 
 import (
 	"context"
-	"database/sql"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -15,11 +15,13 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
@@ -87,11 +89,16 @@ func (s Service) CreateExperiment(
 	experiment.NamespaceID = ns.ID
 
 	if err := s.experimentRepository.Create(ctx, experiment); err != nil {
+		if errors.As(err, &repositories.ExperimentConflictError{}) {
+			return nil, api.NewResourceAlreadyExistsError("%s", err)
+		}
 		return nil, api.NewInternalError("error inserting experiment '%s': %s", req.Name, err)
 	}
 
 	if experiment.ArtifactLocation == "" {
-		path, err := url.JoinPath(s.config.DefaultArtifactRoot, fmt.Sprintf("%d", *experiment.ID))
+		path, err := url.JoinPath(
+			ns.GetArtifactRoot(s.config.DefaultArtifactRoot), fmt.Sprintf("%d", *experiment.ID),
+		)
 		if err != nil {
 			return nil, api.NewInternalError(
 				"error creating artifact_location for experiment'%s': %s", experiment.Name, err,
@@ -126,6 +133,13 @@ func (s Service) UpdateExperiment(
 		return api.NewResourceDoesNotExistError("unable to find experiment '%d': %s", parsedID, err)
 	}
 
+	// changing of artifact location would orphan already logged artifacts, so it is immutable after creation.
+	if req.ArtifactLocation != "" && req.ArtifactLocation != experiment.ArtifactLocation {
+		return api.NewInvalidParameterValueError(
+			"artifact location of experiment '%d' cannot be changed", parsedID,
+		)
+	}
+
 	experiment = convertors.ConvertUpdateExperimentToDBModel(experiment, req)
 	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
 		return api.NewInternalError("unable to update experiment '%d': %s", *experiment.ID, err)
@@ -134,6 +148,39 @@ func (s Service) UpdateExperiment(
 	return nil
 }
 
+// CloneExperiment creates new Experiment entity with the tags of existing Experiment entity.
+func (s Service) CloneExperiment(
+	ctx context.Context, ns *models.Namespace, req *request.CloneExperimentRequest,
+) (*models.Experiment, error) {
+	if err := ValidateCloneExperimentRequest(req); err != nil {
+		return nil, err
+	}
+
+	parsedID, err := strconv.ParseInt(req.SourceID, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.SourceID, err)
+	}
+
+	source, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(parsedID))
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment '%d': %s", parsedID, err)
+	}
+
+	// runs are not cloned, so the new experiment gets its own default artifact location.
+	createRequest := request.CreateExperimentRequest{
+		Name: req.Name,
+		Tags: make([]request.ExperimentTagPartialRequest, len(source.Tags)),
+	}
+	for i, tag := range source.Tags {
+		createRequest.Tags[i] = request.ExperimentTagPartialRequest{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+
+	return s.CreateExperiment(ctx, ns, &createRequest)
+}
+
 // GetExperiment returns existing Experiment entity by ID.
 func (s Service) GetExperiment(
 	ctx context.Context, ns *models.Namespace, req *request.GetExperimentRequest,
@@ -156,6 +203,46 @@ func (s Service) GetExperiment(
 	return experiment, nil
 }
 
+// GetExperimentsBatch returns existing Experiment entities by IDs in the requested order.
+// Missing experiments are omitted.
+func (s Service) GetExperimentsBatch(
+	ctx context.Context, ns *models.Namespace, req *request.GetExperimentsBatchRequest,
+) ([]models.Experiment, error) {
+	if err := ValidateGetExperimentsBatchRequest(req); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(req.IDs))
+	requested := make(map[int32]struct{}, len(req.IDs))
+	for _, id := range req.IDs {
+		parsedID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, api.NewBadRequestError(`unable to parse experiment id '%s': %s`, id, err)
+		}
+		if _, ok := requested[int32(parsedID)]; !ok {
+			requested[int32(parsedID)] = struct{}{}
+			ids = append(ids, int32(parsedID))
+		}
+	}
+
+	experiments, err := s.experimentRepository.GetByNamespaceIDAndExperimentIDs(ctx, ns.ID, ids)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get experiments: %s", err)
+	}
+
+	found := make(map[int32]models.Experiment, len(experiments))
+	for _, experiment := range experiments {
+		found[*experiment.ID] = experiment
+	}
+	result := make([]models.Experiment, 0, len(experiments))
+	for _, id := range ids {
+		if experiment, ok := found[id]; ok {
+			result = append(result, experiment)
+		}
+	}
+	return result, nil
+}
+
 // GetExperimentByName returns existing Experiment entity by Name.
 func (s Service) GetExperimentByName(
 	ctx context.Context, ns *models.Namespace, req *request.GetExperimentRequest,
@@ -164,7 +251,13 @@ func (s Service) GetExperimentByName(
 		return nil, err
 	}
 
-	experiment, err := s.experimentRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.Name)
+	var experiment *models.Experiment
+	var err error
+	if req.CaseInsensitive {
+		experiment, err = s.experimentRepository.GetByNamespaceIDAndNameCaseInsensitive(ctx, ns.ID, req.Name)
+	} else {
+		experiment, err = s.experimentRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.Name)
+	}
 	if err != nil {
 		return nil, api.NewInternalError("unable to get experiment by name '%s': %v", req.Name, err)
 	}
@@ -197,19 +290,43 @@ func (s Service) DeleteExperiment(
 		return api.NewBadRequestError("unable to delete default experiment")
 	}
 
-	experiment.LifecycleStage = models.LifecycleStageDeleted
-	experiment.LastUpdateTime = sql.NullInt64{
-		Int64: time.Now().UTC().UnixMilli(),
-		Valid: true,
-	}
-
-	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
+	if err := s.experimentRepository.Archive(ctx, experiment, middleware.GetActorFromContext(ctx)); err != nil {
 		return api.NewInternalError("unable to delete experiment '%d': %s", *experiment.ID, err)
 	}
 
 	return nil
 }
 
+// DeleteExperimentDryRun returns what would be affected by deletion of the Experiment without deleting it.
+func (s Service) DeleteExperimentDryRun(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteExperimentRequest,
+) (*models.DeletionImpact, error) {
+	if err := ValidateDeleteExperimentRequest(req); err != nil {
+		return nil, err
+	}
+
+	parsedID, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.ID, err)
+	}
+
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(parsedID))
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment '%d': %s", parsedID, err)
+	}
+
+	if experiment.IsDefault(ns) {
+		return nil, api.NewBadRequestError("unable to delete default experiment")
+	}
+
+	impact, err := s.experimentRepository.GetDeletionImpact(ctx, experiment)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get deletion impact of experiment '%d': %s", *experiment.ID, err)
+	}
+
+	return impact, nil
+}
+
 // RestoreExperiment restores deleted Experiment entity.
 func (s Service) RestoreExperiment(
 	ctx context.Context, ns *models.Namespace, req *request.RestoreExperimentRequest,
@@ -228,25 +345,69 @@ func (s Service) RestoreExperiment(
 		return api.NewResourceDoesNotExistError(`unable to find experiment '%d': %s`, parsedID, err)
 	}
 
-	experiment.LifecycleStage = models.LifecycleStageActive
-	experiment.LastUpdateTime = sql.NullInt64{
-		Int64: time.Now().UTC().UnixMilli(),
-		Valid: true,
-	}
-
-	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
+	if err := s.experimentRepository.Restore(ctx, experiment, middleware.GetActorFromContext(ctx)); err != nil {
 		return api.NewInternalError("Unable to restore experiment '%d': %s", *experiment.ID, err)
 	}
 
 	return nil
 }
 
+// RestoreExperimentsBatch restores deleted Experiment entities in one transaction and returns the result per id.
+func (s Service) RestoreExperimentsBatch(
+	ctx context.Context, ns *models.Namespace, req *request.RestoreExperimentsBatchRequest,
+) ([]response.RestoreExperimentResultPartialResponse, error) {
+	if err := ValidateRestoreExperimentsBatchRequest(req); err != nil {
+		return nil, err
+	}
+
+	results := make([]response.RestoreExperimentResultPartialResponse, len(req.IDs))
+	experiments := make([]*models.Experiment, 0, len(req.IDs))
+	restored := make(map[int32]struct{}, len(req.IDs))
+	for i, id := range req.IDs {
+		results[i].ID = id
+		parsedID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			results[i].Error = fmt.Sprintf("unable to parse experiment id '%s'", id)
+			continue
+		}
+		experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(parsedID))
+		if err != nil {
+			results[i].Error = fmt.Sprintf("unable to find experiment '%d'", parsedID)
+			continue
+		}
+		if _, ok := restored[*experiment.ID]; ok {
+			results[i].Restored = true
+			continue
+		}
+		if experiment.LifecycleStage != models.LifecycleStageDeleted {
+			results[i].Error = fmt.Sprintf("experiment '%d' is not deleted", parsedID)
+			continue
+		}
+		restored[*experiment.ID] = struct{}{}
+		experiments = append(experiments, experiment)
+		results[i].Restored = true
+	}
+
+	if len(experiments) > 0 {
+		if err := s.experimentRepository.RestoreBatch(
+			ctx, experiments, time.Now().UTC().UnixMilli(), middleware.GetActorFromContext(ctx),
+		); err != nil {
+			return nil, api.NewInternalError("unable to restore experiments: %s", err)
+		}
+	}
+
+	return results, nil
+}
+
 func (s Service) SetExperimentTag(
 	ctx context.Context, ns *models.Namespace, req *request.SetExperimentTagRequest,
 ) error {
 	if err := ValidateSetExperimentTagRequest(req); err != nil {
 		return err
 	}
+	if err := ValidateSetExperimentTagLength(req, s.config.TagKeyMaxLength, s.config.TagValueMaxLength); err != nil {
+		return err
+	}
 
 	parsedID, err := strconv.ParseInt(req.ID, 10, 32)
 	if err != nil {
@@ -266,6 +427,35 @@ func (s Service) SetExperimentTag(
 	return nil
 }
 
+// SetExperimentTags creates or updates several tags of the experiment at once.
+func (s Service) SetExperimentTags(
+	ctx context.Context, ns *models.Namespace, req *request.SetExperimentTagsRequest,
+) error {
+	if err := ValidateSetExperimentTagsRequest(req); err != nil {
+		return err
+	}
+	if err := ValidateSetExperimentTagsLength(req, s.config.TagKeyMaxLength, s.config.TagValueMaxLength); err != nil {
+		return err
+	}
+
+	parsedID, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		return api.NewBadRequestError("Unable to parse experiment id '%s': %s", req.ID, err)
+	}
+
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(parsedID))
+	if err != nil {
+		return api.NewResourceDoesNotExistError(`unable to find experiment '%d': %s`, parsedID, err)
+	}
+
+	experimentTags := convertors.ConvertSetExperimentTagsRequestToDBModels(*experiment.ID, req)
+	if err := s.tagRepository.CreateExperimentTags(ctx, *experiment.ID, experimentTags); err != nil {
+		return api.NewInternalError("Unable to set tags for experiment '%d': %s", *experiment.ID, err)
+	}
+
+	return nil
+}
+
 // nolint: gocyclo
 // TODO:get back and fix `gocyclo` problem.
 func (s Service) SearchExperiments(
@@ -275,7 +465,11 @@ func (s Service) SearchExperiments(
 		return nil, 0, 0, err
 	}
 
-	query := database.DB.Where(
+	query := database.WithSearchReplicas(database.DB).Select(
+		"experiments.*, (SELECT MAX(runs.last_update_time) FROM runs"+
+			" WHERE runs.experiment_id = experiments.experiment_id AND runs.lifecycle_stage = ?) AS last_activity",
+		database.LifecycleStageActive,
+	).Where(
 		"experiments.namespace_id = ?", ns.ID,
 	)
 
@@ -299,10 +493,7 @@ func (s Service) SearchExperiments(
 	query.Where("lifecycle_stage IN ?", lifecyleStages)
 
 	// MaxResults
-	limit := int(req.MaxResults)
-	if limit == 0 {
-		limit = 1000
-	}
+	limit := s.config.GetSearchMaxResults(int(req.MaxResults))
 	query.Limit(limit + 1)
 
 	// PageToken
@@ -404,15 +595,26 @@ func (s Service) SearchExperiments(
 		}
 	}
 
-	// OrderBy
-	expOrder := false
+	// OrderBy, every clause may hold several comma separated columns, which are applied in sequence.
+	var orderBy []string
 	for _, o := range req.OrderBy {
+		for _, c := range strings.Split(o, ",") {
+			orderBy = append(orderBy, strings.TrimSpace(c))
+		}
+	}
+	expOrder := false
+	orderColumns := map[string]struct{}{}
+	for _, o := range orderBy {
 		components := experimentOrder.FindStringSubmatch(o)
 		if len(components) == 0 {
 			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
 		}
 
 		column := components[1]
+		if _, ok := orderColumns[column]; ok {
+			return nil, 0, 0, api.NewInvalidParameterValueError("duplicate order_by attribute '%s'", column)
+		}
+		orderColumns[column] = struct{}{}
 		switch column {
 		case "experiment_id":
 			expOrder = true
@@ -425,12 +627,12 @@ func (s Service) SearchExperiments(
 			)
 		}
 		query.Order(clause.OrderByColumn{
-			Column: clause.Column{Name: column},
+			Column: clause.Column{Table: "experiments", Name: column},
 			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
 		})
 
 	}
-	if len(req.OrderBy) == 0 {
+	if len(orderBy) == 0 {
 		query.Order("experiments.creation_time DESC")
 	}
 	if !expOrder {
@@ -170,6 +170,27 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:  "CreateExperimentConflictError",
+			error: api.NewResourceAlreadyExistsError(`experiment(name=name) already exists`),
+			request: &request.CreateExperimentRequest{
+				Name: "name",
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndName", context.TODO(), ns.ID, "name",
+				).Return(nil, nil)
+				experimentRepository.On(
+					"Create", context.TODO(), mock.Anything,
+				).Return(repositories.ExperimentConflictError{Name: "name"})
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
 		{
 			name:  "UpdateExperimentArtifactLocationDatabaseError",
 			error: api.NewInternalError(`error updating artifact_location for experiment 'name': database error`),
@@ -225,13 +246,12 @@ func TestService_DeleteExperiment_Ok(t *testing.T) {
 		ID: common.GetPointer(int32(1)),
 	}, nil)
 	experimentRepository.On(
-		"Update",
+		"Archive",
 		context.TODO(),
-		mock.MatchedBy(func(experiment *models.Experiment) bool {
-			assert.Equal(t, experiment.LifecycleStage, models.LifecycleStageDeleted)
-			assert.NotNil(t, experiment.LastUpdateTime)
-			return true
-		}),
+		&models.Experiment{
+			ID: common.GetPointer(int32(1)),
+		},
+		"",
 	).Return(nil)
 
 	// call service under testing.
@@ -343,7 +363,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					ID: common.GetPointer(int32(1)),
 				}, nil)
 				experimentRepository.On(
-					"Update", context.TODO(), mock.AnythingOfType("*models.Experiment"),
+					"Archive", context.TODO(), mock.AnythingOfType("*models.Experiment"), "",
 				).Return(errors.New("database error"))
 				return NewService(
 					&config.Config{},
@@ -362,6 +382,126 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 	}
 }
 
+func TestService_DeleteExperimentDryRun_Ok(t *testing.T) {
+	// initialise namespace to which experiment under the test belongs to.
+	ns := models.Namespace{
+		ID:   1,
+		Code: "code",
+	}
+
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+	).Return(&models.Experiment{
+		ID: common.GetPointer(int32(1)),
+	}, nil)
+	experimentRepository.On(
+		"GetDeletionImpact", context.TODO(), &models.Experiment{ID: common.GetPointer(int32(1))},
+	).Return(&models.DeletionImpact{
+		Experiments: 1,
+		Runs:        2,
+		Metrics:     3,
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{},
+		&repositories.MockTagRepositoryProvider{},
+		&experimentRepository,
+	)
+	impact, err := service.DeleteExperimentDryRun(context.TODO(), &ns, &request.DeleteExperimentRequest{
+		ID:     "1",
+		DryRun: true,
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, &models.DeletionImpact{Experiments: 1, Runs: 2, Metrics: 3}, impact)
+	experimentRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_DeleteExperimentDryRun_Error(t *testing.T) {
+	// initialise namespace to which experiment under the test belongs to.
+	ns := models.Namespace{
+		ID:                  1,
+		Code:                "code",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	}
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteExperimentRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError(`Missing value for required parameter 'experiment_id'`),
+			request: &request.DeleteExperimentRequest{},
+			service: func() *Service {
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "DefaultExperiment",
+			error: api.NewBadRequestError("unable to delete default experiment"),
+			request: &request.DeleteExperimentRequest{
+				ID: "0",
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(0),
+				).Return(&models.Experiment{
+					ID:   common.GetPointer(models.DefaultExperimentID),
+					Name: models.DefaultExperimentName,
+				}, nil)
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
+		{
+			name:  "GetDeletionImpactDatabaseError",
+			error: api.NewInternalError(`unable to get deletion impact of experiment '1': database error`),
+			request: &request.DeleteExperimentRequest{
+				ID: "1",
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+				).Return(&models.Experiment{
+					ID: common.GetPointer(int32(1)),
+				}, nil)
+				experimentRepository.On(
+					"GetDeletionImpact", context.TODO(), mock.AnythingOfType("*models.Experiment"),
+				).Return(nil, errors.New("database error"))
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().DeleteExperimentDryRun(context.TODO(), &ns, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestService_GetExperiment_Ok(t *testing.T) {
 	// initialise namespace to which experiment under the test belongs to.
 	ns := models.Namespace{
@@ -494,6 +634,110 @@ func TestService_GetExperiment_Error(t *testing.T) {
 	}
 }
 
+func TestService_GetExperimentsBatch_Ok(t *testing.T) {
+	// initialise namespace to which experiments under the test belong to.
+	ns := models.Namespace{
+		ID:   1,
+		Code: "code",
+	}
+
+	// init repository mocks. experiment 3 is missing, experiments are returned in a different order.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentIDs", context.TODO(), ns.ID, []int32{2, 3, 1},
+	).Return([]models.Experiment{
+		{ID: common.GetPointer(int32(1)), Name: "experiment1"},
+		{ID: common.GetPointer(int32(2)), Name: "experiment2"},
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{},
+		&repositories.MockTagRepositoryProvider{},
+		&experimentRepository,
+	)
+	experiments, err := service.GetExperimentsBatch(context.TODO(), &ns, &request.GetExperimentsBatchRequest{
+		IDs: []string{"2", "3", "1", "2"},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, []models.Experiment{
+		{ID: common.GetPointer(int32(2)), Name: "experiment2"},
+		{ID: common.GetPointer(int32(1)), Name: "experiment1"},
+	}, experiments)
+}
+
+func TestService_GetExperimentsBatch_Error(t *testing.T) {
+	// initialise namespace to which experiments under the test belong to.
+	ns := models.Namespace{
+		ID:   1,
+		Code: "code",
+	}
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetExperimentsBatchRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyExperimentIDs",
+			error:   api.NewInvalidParameterValueError(`Missing value for required parameter 'experiment_ids'`),
+			request: &request.GetExperimentsBatchRequest{},
+			service: func() *Service {
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect_id': strconv.ParseInt: parsing "incorrect_id": invalid syntax`,
+			),
+			request: &request.GetExperimentsBatchRequest{
+				IDs: []string{"1", "incorrect_id"},
+			},
+			service: func() *Service {
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "DatabaseError",
+			error: api.NewInternalError(`unable to get experiments: database error`),
+			request: &request.GetExperimentsBatchRequest{
+				IDs: []string{"1"},
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentIDs", context.TODO(), ns.ID, []int32{1},
+				).Return(nil, errors.New("database error"))
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().GetExperimentsBatch(context.TODO(), &ns, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestService_GetExperimentByName_Ok(t *testing.T) {
 	// initialise namespace to which experiment under the test belongs to.
 	ns := models.Namespace{
@@ -647,13 +891,12 @@ func TestService_RestoreExperiment_Ok(t *testing.T) {
 		ID: common.GetPointer(int32(1)),
 	}, nil)
 	experimentRepository.On(
-		"Update",
+		"Restore",
 		context.TODO(),
-		mock.MatchedBy(func(experiment *models.Experiment) bool {
-			assert.Equal(t, models.LifecycleStageActive, experiment.LifecycleStage)
-			assert.NotNil(t, experiment.LastUpdateTime)
-			return true
-		}),
+		&models.Experiment{
+			ID: common.GetPointer(int32(1)),
+		},
+		"",
 	).Return(nil)
 
 	// call service under testing.
@@ -743,7 +986,7 @@ func TestService_RestoreExperiment_Error(t *testing.T) {
 					ID: common.GetPointer(int32(1)),
 				}, nil)
 				experimentRepository.On(
-					"Update", context.TODO(), mock.AnythingOfType("*models.Experiment"),
+					"Restore", context.TODO(), mock.AnythingOfType("*models.Experiment"), "",
 				).Return(errors.New("database error"))
 				return NewService(
 					&config.Config{},
@@ -917,6 +1160,158 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 	}
 }
 
+func TestService_SetExperimentTags_Ok(t *testing.T) {
+	// initialise namespace to which experiment under the test belongs to.
+	ns := models.Namespace{
+		ID:   1,
+		Code: "code",
+	}
+
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+	).Return(&models.Experiment{
+		ID: common.GetPointer(int32(1)),
+	}, nil)
+
+	tagsRepository := repositories.MockTagRepositoryProvider{}
+	tagsRepository.On(
+		"CreateExperimentTags",
+		context.TODO(),
+		int32(1),
+		[]models.ExperimentTag{
+			{Key: "key1", Value: "value1", ExperimentID: 1},
+			{Key: "key2", Value: "value2", ExperimentID: 1},
+		},
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{},
+		&tagsRepository,
+		&experimentRepository,
+	)
+	err := service.SetExperimentTags(context.TODO(), &ns, &request.SetExperimentTagsRequest{
+		ID: "1",
+		Tags: []request.ExperimentTagPartialRequest{
+			{Key: "key1", Value: "value1"},
+			{Key: "key2", Value: "value2"},
+		},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	tagsRepository.AssertExpectations(t)
+}
+
+func TestService_SetExperimentTags_Error(t *testing.T) {
+	// initialise namespace to which experiment under the test belongs to.
+	ns := models.Namespace{
+		ID:   1,
+		Code: "code",
+	}
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetExperimentTagsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError(`Missing value for required parameter 'experiment_id'`),
+			request: &request.SetExperimentTagsRequest{},
+			service: func() *Service {
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "TagValueIsTooLong",
+			error: api.NewInvalidParameterValueError(`'value' parameter can't be longer than 5 characters`),
+			request: &request.SetExperimentTagsRequest{
+				ID: "1",
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key1", Value: "value"},
+					{Key: "key2", Value: "value2"},
+				},
+			},
+			service: func() *Service {
+				return NewService(
+					&config.Config{
+						TagValueMaxLength: 5,
+					},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "ExperimentNotFound",
+			error: api.NewResourceDoesNotExistError(`unable to find experiment '1': experiment not found`),
+			request: &request.SetExperimentTagsRequest{
+				ID: "1",
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key"},
+				},
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+				).Return(nil, errors.New("experiment not found"))
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
+		{
+			name:  "SetExperimentTagsDatabaseError",
+			error: api.NewInternalError(`Unable to set tags for experiment '1': database error`),
+			request: &request.SetExperimentTagsRequest{
+				ID: "1",
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key"},
+				},
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+				).Return(&models.Experiment{
+					ID: common.GetPointer(int32(1)),
+				}, nil)
+				tagRepository := repositories.MockTagRepositoryProvider{}
+				tagRepository.On(
+					"CreateExperimentTags",
+					context.TODO(),
+					int32(1),
+					mock.AnythingOfType("[]models.ExperimentTag"),
+				).Return(errors.New("database error"))
+
+				return NewService(
+					&config.Config{},
+					&tagRepository,
+					&experimentRepository,
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			assert.Equal(t, tt.error, tt.service().SetExperimentTags(context.TODO(), &ns, tt.request))
+		})
+	}
+}
+
 func TestService_UpdateExperiment_Ok(t *testing.T) {
 	// initialise namespace to which experiment under the test belongs to.
 	ns := models.Namespace{
@@ -1031,6 +1426,29 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:  "ChangeArtifactLocation",
+			error: api.NewInvalidParameterValueError(`artifact location of experiment '1' cannot be changed`),
+			request: &request.UpdateExperimentRequest{
+				ID:               "1",
+				Name:             "name",
+				ArtifactLocation: "s3://bucket/new",
+			},
+			service: func() *Service {
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), ns.ID, int32(1),
+				).Return(&models.Experiment{
+					ID:               common.GetPointer(int32(1)),
+					ArtifactLocation: "s3://bucket/1",
+				}, nil)
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&experimentRepository,
+				)
+			},
+		},
 		{
 			name:  "UpdateExperimentDatabaseError",
 			error: api.NewInternalError(`unable to update experiment '1': database error`),
@@ -1,12 +1,16 @@
 package experiment
 
 import (
+	"unicode/utf8"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
 const (
 	MaxResultsPerPage = 1000000
+	// MaxExperimentsBatchSize is the maximum number of experiments requested at once.
+	MaxExperimentsBatchSize = 1000
 )
 
 // AllowedViewTypeList supported list of ViewType.
@@ -39,6 +43,18 @@ func ValidateUpdateExperimentRequest(req *request.UpdateExperimentRequest) error
 	return nil
 }
 
+// ValidateCloneExperimentRequest validates `POST /mlflow/experiments/clone` request.
+func ValidateCloneExperimentRequest(req *request.CloneExperimentRequest) error {
+	if req.SourceID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'source_experiment_id'")
+	}
+
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
 // ValidateGetExperimentByIDRequest validates `GET /mlflow/experiments/get` request.
 func ValidateGetExperimentByIDRequest(req *request.GetExperimentRequest) error {
 	if req.ID == "" {
@@ -71,6 +87,27 @@ func ValidateRestoreExperimentRequest(req *request.RestoreExperimentRequest) err
 	return nil
 }
 
+// ValidateGetExperimentsBatchRequest validates `POST /mlflow/experiments/batch-get` request.
+func ValidateGetExperimentsBatchRequest(req *request.GetExperimentsBatchRequest) error {
+	if len(req.IDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	if len(req.IDs) > MaxExperimentsBatchSize {
+		return api.NewInvalidParameterValueError(
+			"Parameter 'experiment_ids' can contain at most %d ids", MaxExperimentsBatchSize,
+		)
+	}
+	return nil
+}
+
+// ValidateRestoreExperimentsBatchRequest validates `POST /mlflow/experiments/batch-restore` request.
+func ValidateRestoreExperimentsBatchRequest(req *request.RestoreExperimentsBatchRequest) error {
+	if len(req.IDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	return nil
+}
+
 // ValidateSearchExperimentsRequest validates `POST /mlflow/experiments/restore` request.
 func ValidateSearchExperimentsRequest(req *request.SearchExperimentsRequest) error {
 	if _, ok := AllowedViewTypeList[req.ViewType]; !ok {
@@ -93,3 +130,48 @@ func ValidateSetExperimentTagRequest(req *request.SetExperimentTagRequest) error
 	}
 	return nil
 }
+
+// ValidateSetExperimentTagsRequest validates `POST /mlflow/experiments/set-experiment-tags` request.
+func ValidateSetExperimentTagsRequest(req *request.SetExperimentTagsRequest) error {
+	if req.ID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+
+	if len(req.Tags) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'tags'")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+		}
+	}
+	return nil
+}
+
+// ValidateSetExperimentTagLength validates length of `POST /mlflow/experiments/set-experiment-tag` key and value.
+// Zero max length disables the corresponding check.
+func ValidateSetExperimentTagLength(req *request.SetExperimentTagRequest, keyMaxLength, valueMaxLength int) error {
+	if keyMaxLength > 0 && utf8.RuneCountInString(req.Key) > keyMaxLength {
+		return api.NewInvalidParameterValueError("'key' parameter can't be longer than %d characters", keyMaxLength)
+	}
+	if valueMaxLength > 0 && utf8.RuneCountInString(req.Value) > valueMaxLength {
+		return api.NewInvalidParameterValueError(
+			"'value' parameter can't be longer than %d characters", valueMaxLength,
+		)
+	}
+	return nil
+}
+
+// ValidateSetExperimentTagsLength validates length of `POST /mlflow/experiments/set-experiment-tags` keys and values.
+// Zero max length disables the corresponding check.
+func ValidateSetExperimentTagsLength(req *request.SetExperimentTagsRequest, keyMaxLength, valueMaxLength int) error {
+	for _, tag := range req.Tags {
+		if err := ValidateSetExperimentTagLength(&request.SetExperimentTagRequest{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}, keyMaxLength, valueMaxLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package experiment
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,42 @@ func TestValidateCreateExperimentRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateCloneExperimentRequest_Ok(t *testing.T) {
+	err := ValidateCloneExperimentRequest(&request.CloneExperimentRequest{
+		SourceID: "1",
+		Name:     "name",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCloneExperimentRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CloneExperimentRequest
+	}{
+		{
+			name:    "EmptySourceIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'source_experiment_id'"),
+			request: &request.CloneExperimentRequest{},
+		},
+		{
+			name:  "EmptyNameProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CloneExperimentRequest{
+				SourceID: "1",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCloneExperimentRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateUpdateExperimentRequest_Ok(t *testing.T) {
 	err := ValidateUpdateExperimentRequest(&request.UpdateExperimentRequest{
 		ID:   "id",
@@ -166,6 +203,41 @@ func TestValidateDeleteExperimentRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateGetExperimentsBatchRequest_Ok(t *testing.T) {
+	err := ValidateGetExperimentsBatchRequest(&request.GetExperimentsBatchRequest{
+		IDs: []string{"1", "2"},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateGetExperimentsBatchRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetExperimentsBatchRequest
+	}{
+		{
+			name:    "EmptyIDsProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.GetExperimentsBatchRequest{},
+		},
+		{
+			name:  "TooManyIDs",
+			error: api.NewInvalidParameterValueError("Parameter 'experiment_ids' can contain at most 1000 ids"),
+			request: &request.GetExperimentsBatchRequest{
+				IDs: strings.Split(strings.Repeat("1,", MaxExperimentsBatchSize), ","),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetExperimentsBatchRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateRestoreExperimentRequest_Ok(t *testing.T) {
 	err := ValidateRestoreExperimentRequest(&request.RestoreExperimentRequest{
 		ID: "id",
@@ -268,3 +340,126 @@ func TestValidateSetExperimentTagRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSetExperimentTagsRequest_Ok(t *testing.T) {
+	err := ValidateSetExperimentTagsRequest(&request.SetExperimentTagsRequest{
+		ID: "id",
+		Tags: []request.ExperimentTagPartialRequest{
+			{Key: "key1"},
+			{Key: "key2", Value: "value2"},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateSetExperimentTagsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetExperimentTagsRequest
+	}{
+		{
+			name:    "EmptyIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.SetExperimentTagsRequest{},
+		},
+		{
+			name:  "EmptyTagsProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags'"),
+			request: &request.SetExperimentTagsRequest{
+				ID: "id",
+			},
+		},
+		{
+			name:  "EmptyKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.SetExperimentTagsRequest{
+				ID: "id",
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key1"},
+					{Value: "value2"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetExperimentTagsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSetExperimentTagLength_Ok(t *testing.T) {
+	testData := []struct {
+		name           string
+		keyMaxLength   int
+		valueMaxLength int
+		request        *request.SetExperimentTagRequest
+	}{
+		{
+			name:           "KeyAndValueHaveMaxLength",
+			keyMaxLength:   5,
+			valueMaxLength: 10,
+			request: &request.SetExperimentTagRequest{
+				Key:   strings.Repeat("k", 5),
+				Value: strings.Repeat("v", 10),
+			},
+		},
+		{
+			name:           "LengthIsCountedInCharacters",
+			keyMaxLength:   5,
+			valueMaxLength: 10,
+			request: &request.SetExperimentTagRequest{
+				Key:   "ключk",
+				Value: "значениеvv",
+			},
+		},
+		{
+			name: "ChecksAreDisabled",
+			request: &request.SetExperimentTagRequest{
+				Key:   strings.Repeat("k", 500),
+				Value: strings.Repeat("v", 10000),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateSetExperimentTagLength(tt.request, tt.keyMaxLength, tt.valueMaxLength))
+		})
+	}
+}
+
+func TestValidateSetExperimentTagLength_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetExperimentTagRequest
+	}{
+		{
+			name:  "KeyIsTooLong",
+			error: api.NewInvalidParameterValueError("'key' parameter can't be longer than 5 characters"),
+			request: &request.SetExperimentTagRequest{
+				Key:   strings.Repeat("k", 6),
+				Value: strings.Repeat("v", 10),
+			},
+		},
+		{
+			name:  "ValueIsTooLong",
+			error: api.NewInvalidParameterValueError("'value' parameter can't be longer than 10 characters"),
+			request: &request.SetExperimentTagRequest{
+				Key:   strings.Repeat("k", 5),
+				Value: strings.Repeat("v", 11),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetExperimentTagLength(tt.request, 5, 10)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package metric
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// historyCacheKey identifies downsampled metric history. Series of all the metric contexts are cached together,
+// because `GET /metrics/get-history` endpoint always returns all of them.
+type historyCacheKey struct {
+	runID     string
+	metricKey string
+	maxPoints int
+	fill      request.MetricHistoryFill
+	xAxis     request.MetricHistoryXAxis
+	startStep int64
+	endStep   int64
+	startTime int64
+	endTime   int64
+}
+
+// newHistoryCacheKey creates historyCacheKey from the request. Missing range bounds are stored as -1.
+func newHistoryCacheKey(runID string, req *request.GetMetricHistoryRequest) historyCacheKey {
+	bound := func(value *int64) int64 {
+		if value == nil {
+			return -1
+		}
+		return *value
+	}
+	return historyCacheKey{
+		runID:     runID,
+		metricKey: req.MetricKey,
+		maxPoints: req.MaxPoints,
+		fill:      req.Fill,
+		xAxis:     req.XAxis,
+		startStep: bound(req.StartStep),
+		endStep:   bound(req.EndStep),
+		startTime: bound(req.StartTime),
+		endTime:   bound(req.EndTime),
+	}
+}
+
+// historyCacheEntry holds downsampled metric history along with the metric history version it was built from.
+type historyCacheEntry struct {
+	version int64
+	metrics []models.Metric
+}
+
+// HistoryCache is LRU cache of downsampled metric histories.
+// nil HistoryCache is valid and caches nothing.
+type HistoryCache struct {
+	cache *lru.Cache[historyCacheKey, historyCacheEntry]
+}
+
+// NewHistoryCache creates new HistoryCache instance. When `size` is 0, nil cache is returned.
+func NewHistoryCache(size int) (*HistoryCache, error) {
+	if size == 0 {
+		return nil, nil
+	}
+	cache, err := lru.New[historyCacheKey, historyCacheEntry](size)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating lru cache for metric histories")
+	}
+	return &HistoryCache{cache: cache}, nil
+}
+
+// Get returns cached metric history, if it was built from the same metric history version.
+func (c *HistoryCache) Get(key historyCacheKey, version int64) ([]models.Metric, bool) {
+	if c == nil {
+		return nil, false
+	}
+	entry, ok := c.cache.Get(key)
+	if !ok || entry.version != version {
+		return nil, false
+	}
+	return entry.metrics, true
+}
+
+// Add adds metric history into the cache.
+func (c *HistoryCache) Add(key historyCacheKey, version int64, metrics []models.Metric) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(key, historyCacheEntry{version: version, metrics: metrics})
+}
+
+// RemoveRun removes all the cached metric histories of the run. Metric history version doesn't change,
+// when metric keys of the run are renamed, so cached histories have to be dropped explicitly.
+func (c *HistoryCache) RemoveRun(runID string) {
+	if c == nil {
+		return
+	}
+	for _, key := range c.cache.Keys() {
+		if key.runID == runID {
+			c.cache.Remove(key)
+		}
+	}
+}
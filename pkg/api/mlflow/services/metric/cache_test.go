@@ -0,0 +1,40 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestHistoryCache_RemoveRun_Ok(t *testing.T) {
+	historyCache, err := NewHistoryCache(10)
+	require.Nil(t, err)
+
+	metrics := []models.Metric{{Key: "key", Step: 1, Value: 1.1, Iter: 1}}
+	key1 := newHistoryCacheKey("1", &request.GetMetricHistoryRequest{MetricKey: "key", MaxPoints: 2})
+	key2 := newHistoryCacheKey("1", &request.GetMetricHistoryRequest{MetricKey: "other", MaxPoints: 2})
+	key3 := newHistoryCacheKey("2", &request.GetMetricHistoryRequest{MetricKey: "key", MaxPoints: 2})
+	historyCache.Add(key1, 1, metrics)
+	historyCache.Add(key2, 1, metrics)
+	historyCache.Add(key3, 1, metrics)
+
+	// cached histories of the run are removed, even if their version is still the same.
+	historyCache.RemoveRun("1")
+	_, ok := historyCache.Get(key1, 1)
+	assert.False(t, ok)
+	_, ok = historyCache.Get(key2, 1)
+	assert.False(t, ok)
+
+	// cached histories of other runs are kept.
+	cached, ok := historyCache.Get(key3, 1)
+	assert.True(t, ok)
+	assert.Equal(t, metrics, cached)
+
+	// nil cache caches nothing.
+	var nilCache *HistoryCache
+	nilCache.RemoveRun("1")
+}
@@ -0,0 +1,120 @@
+package metric
+
+import (
+	"sort"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// DownsampleMetrics reduces every metric context series to no more than `maxPoints` evenly spaced values.
+// The first and the last values of each series are always kept. Series are returned ordered by step.
+func DownsampleMetrics(metrics []models.Metric, maxPoints int) []models.Metric {
+	series := splitMetricSeries(metrics)
+	result := make([]models.Metric, 0, min(len(metrics), maxPoints*len(series)))
+	for _, values := range series {
+		switch {
+		case len(values) <= maxPoints:
+			result = append(result, values...)
+		case maxPoints == 1:
+			result = append(result, values[len(values)-1])
+		default:
+			for n := 0; n < maxPoints; n++ {
+				result = append(result, values[n*(len(values)-1)/(maxPoints-1)])
+			}
+		}
+	}
+	return result
+}
+
+// ForwardFillMetrics adds a value for every missing step of each metric context series, which carries the last
+// known value of the series. Series are filled from their first value up to `endStep`, when it is provided,
+// or up to their last value otherwise. Series are returned ordered by step.
+// When `maxPoints` is positive and the filled metrics would have more values, nothing is filled and false is returned.
+func ForwardFillMetrics(metrics []models.Metric, endStep *int64, maxPoints int) ([]models.Metric, bool) {
+	series := splitMetricSeries(metrics)
+
+	// count the points upfront, so sparse series with huge gaps are rejected without allocating them.
+	size := int64(0)
+	for _, values := range series {
+		last := values[len(values)-1].Step
+		if endStep != nil && *endStep > last {
+			last = *endStep
+		}
+		size += int64(len(values)) + last - values[0].Step + 1 - countDistinctSteps(values)
+		if maxPoints > 0 && size > int64(maxPoints) {
+			return nil, false
+		}
+	}
+
+	result := make([]models.Metric, 0, size)
+	for _, values := range series {
+		for n, value := range values {
+			result = append(result, value)
+			next := value.Step + 1
+			switch {
+			case n+1 < len(values):
+				next = values[n+1].Step
+			case endStep != nil && *endStep > value.Step:
+				next = *endStep + 1
+			}
+			for step := value.Step + 1; step < next; step++ {
+				filled := value
+				filled.Step = step
+				result = append(result, filled)
+			}
+		}
+	}
+	return result, true
+}
+
+// SortMetricsByTime orders metrics by timestamp, so they can be plotted against time.
+// Metrics logged at the same time stay ordered by step.
+func SortMetricsByTime(metrics []models.Metric) []models.Metric {
+	sort.SliceStable(metrics, func(i, j int) bool {
+		if metrics[i].Timestamp != metrics[j].Timestamp {
+			return metrics[i].Timestamp < metrics[j].Timestamp
+		}
+		return metrics[i].Step < metrics[j].Step
+	})
+	return metrics
+}
+
+// splitMetricSeries splits metrics into metric context series ordered by step.
+// Series are returned in order of the first appearance of their context.
+func splitMetricSeries(metrics []models.Metric) [][]models.Metric {
+	var contextIDs []uint
+	series := map[uint][]models.Metric{}
+	for _, metric := range metrics {
+		if _, ok := series[metric.ContextID]; !ok {
+			contextIDs = append(contextIDs, metric.ContextID)
+		}
+		series[metric.ContextID] = append(series[metric.ContextID], metric)
+	}
+
+	result := make([][]models.Metric, len(contextIDs))
+	for n, contextID := range contextIDs {
+		values := series[contextID]
+		sort.SliceStable(values, func(i, j int) bool {
+			if values[i].Step != values[j].Step {
+				return values[i].Step < values[j].Step
+			}
+			if values[i].Timestamp != values[j].Timestamp {
+				return values[i].Timestamp < values[j].Timestamp
+			}
+			return values[i].Iter < values[j].Iter
+		})
+		result[n] = values
+	}
+	return result
+}
+
+// countDistinctSteps counts distinct steps of the series ordered by step.
+func countDistinctSteps(values []models.Metric) int64 {
+	count := int64(0)
+	for n := range values {
+		if n == 0 || values[n].Step != values[n-1].Step {
+			count++
+		}
+	}
+	return count
+}
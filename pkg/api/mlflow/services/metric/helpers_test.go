@@ -0,0 +1,139 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestDownsampleMetrics_Ok(t *testing.T) {
+	metrics := []models.Metric{
+		{Step: 4, ContextID: 1},
+		{Step: 0, ContextID: 1},
+		{Step: 2, ContextID: 1},
+		{Step: 1, ContextID: 1},
+		{Step: 3, ContextID: 1},
+		{Step: 0, ContextID: 2},
+		{Step: 1, ContextID: 2},
+	}
+	tests := []struct {
+		name      string
+		maxPoints int
+		expected  []models.Metric
+	}{
+		{
+			name:      "KeepFirstAndLast",
+			maxPoints: 3,
+			expected: []models.Metric{
+				{Step: 0, ContextID: 1},
+				{Step: 2, ContextID: 1},
+				{Step: 4, ContextID: 1},
+				{Step: 0, ContextID: 2},
+				{Step: 1, ContextID: 2},
+			},
+		},
+		{
+			name:      "KeepLastOnly",
+			maxPoints: 1,
+			expected: []models.Metric{
+				{Step: 4, ContextID: 1},
+				{Step: 1, ContextID: 2},
+			},
+		},
+		{
+			name:      "KeepAll",
+			maxPoints: 10,
+			expected: []models.Metric{
+				{Step: 0, ContextID: 1},
+				{Step: 1, ContextID: 1},
+				{Step: 2, ContextID: 1},
+				{Step: 3, ContextID: 1},
+				{Step: 4, ContextID: 1},
+				{Step: 0, ContextID: 2},
+				{Step: 1, ContextID: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DownsampleMetrics(append([]models.Metric{}, metrics...), tt.maxPoints))
+		})
+	}
+}
+
+func TestForwardFillMetrics_Ok(t *testing.T) {
+	metrics := []models.Metric{
+		{Step: 4, Value: 4, ContextID: 1},
+		{Step: 1, Value: 1, ContextID: 1},
+		{Step: 1, Value: 1.5, Timestamp: 1, ContextID: 1},
+		{Step: 2, Value: 20, ContextID: 2},
+	}
+	tests := []struct {
+		name     string
+		endStep  *int64
+		expected []models.Metric
+	}{
+		{
+			name: "UpToLastValue",
+			expected: []models.Metric{
+				{Step: 1, Value: 1, ContextID: 1},
+				{Step: 1, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 2, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 3, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 4, Value: 4, ContextID: 1},
+				{Step: 2, Value: 20, ContextID: 2},
+			},
+		},
+		{
+			name:    "UpToEndStep",
+			endStep: common.GetPointer[int64](5),
+			expected: []models.Metric{
+				{Step: 1, Value: 1, ContextID: 1},
+				{Step: 1, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 2, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 3, Value: 1.5, Timestamp: 1, ContextID: 1},
+				{Step: 4, Value: 4, ContextID: 1},
+				{Step: 5, Value: 4, ContextID: 1},
+				{Step: 2, Value: 20, ContextID: 2},
+				{Step: 3, Value: 20, ContextID: 2},
+				{Step: 4, Value: 20, ContextID: 2},
+				{Step: 5, Value: 20, ContextID: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filled, ok := ForwardFillMetrics(append([]models.Metric{}, metrics...), tt.endStep, len(tt.expected))
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, filled)
+		})
+	}
+}
+
+func TestForwardFillMetrics_Error(t *testing.T) {
+	metrics := []models.Metric{
+		{Step: 0, ContextID: 1},
+		{Step: 1000000000, ContextID: 1},
+	}
+	filled, ok := ForwardFillMetrics(metrics, nil, 1000)
+	assert.False(t, ok)
+	assert.Nil(t, filled)
+}
+
+func TestSortMetricsByTime_Ok(t *testing.T) {
+	metrics := []models.Metric{
+		{Step: 1, Timestamp: 30, ContextID: 1},
+		{Step: 2, Timestamp: 10, ContextID: 1},
+		{Step: 2, Timestamp: 20, ContextID: 2},
+		{Step: 1, Timestamp: 20, ContextID: 2},
+	}
+	assert.Equal(t, []models.Metric{
+		{Step: 2, Timestamp: 10, ContextID: 1},
+		{Step: 1, Timestamp: 20, ContextID: 2},
+		{Step: 2, Timestamp: 20, ContextID: 2},
+		{Step: 1, Timestamp: 30, ContextID: 1},
+	}, SortMetricsByTime(metrics))
+}
@@ -12,16 +12,24 @@ import (
 
 // Service provides service layer to work with `metric` business logic.
 type Service struct {
+	historyCache     *HistoryCache
+	historyMaxPoints int
 	runRepository    repositories.RunRepositoryProvider
 	metricRepository repositories.MetricRepositoryProvider
 }
 
 // NewService creates new Service instance.
+// Optional `historyCache` caches downsampled metric histories, nil disables caching.
+// Positive `historyMaxPoints` is the maximum number of points a single metric history request can return.
 func NewService(
 	runRepository repositories.RunRepositoryProvider,
 	metricRepository repositories.MetricRepositoryProvider,
+	historyCache *HistoryCache,
+	historyMaxPoints int,
 ) *Service {
 	return &Service{
+		historyCache:     historyCache,
+		historyMaxPoints: historyMaxPoints,
 		runRepository:    runRepository,
 		metricRepository: metricRepository,
 	}
@@ -33,6 +41,11 @@ func (s Service) GetMetricHistory(
 	if err := ValidateGetMetricHistoryRequest(req); err != nil {
 		return nil, err
 	}
+	if s.historyMaxPoints > 0 && req.MaxPoints > s.historyMaxPoints {
+		return nil, api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_points': has to be at most %d", s.historyMaxPoints,
+		)
+	}
 
 	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
 	if err != nil {
@@ -42,16 +55,85 @@ func (s Service) GetMetricHistory(
 		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
-	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req.MetricKey)
+	if req.MaxPoints == 0 {
+		// one point above the maximum is enough to find out that the history is too long.
+		limit := 0
+		if s.historyMaxPoints > 0 {
+			limit = s.historyMaxPoints + 1
+		}
+		metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req, limit)
+		if err != nil {
+			return nil, api.NewInternalError(
+				"unable to get metric history for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
+			)
+		}
+		if s.historyMaxPoints > 0 && len(metrics) > s.historyMaxPoints {
+			return nil, api.NewInvalidParameterValueError(
+				"metric history for metric '%s' of run '%s' has more than %d points, "+
+					"use 'max_points' parameter to downsample it",
+				req.MetricKey, req.GetRunID(), s.historyMaxPoints,
+			)
+		}
+		if metrics, err = s.fillMetricHistory(req, metrics); err != nil {
+			return nil, err
+		}
+		return orderMetricHistory(req, metrics), nil
+	}
+
+	// downsampled metric history is served from the cache, until the metric is logged again.
+	version, err := s.metricRepository.GetMetricHistoryVersion(ctx, run.ID, req.MetricKey)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"unable to get metric history version for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
+		)
+	}
+	cacheKey := newHistoryCacheKey(run.ID, req)
+	if metrics, ok := s.historyCache.Get(cacheKey, version); ok {
+		return metrics, nil
+	}
+
+	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req, 0)
 	if err != nil {
 		return nil, api.NewInternalError(
 			"unable to get metric history for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
 		)
 	}
+	if metrics, err = s.fillMetricHistory(req, metrics); err != nil {
+		return nil, err
+	}
+	metrics = orderMetricHistory(req, DownsampleMetrics(metrics, req.MaxPoints))
+	s.historyCache.Add(cacheKey, version, metrics)
 
 	return metrics, nil
 }
 
+// fillMetricHistory fills the gaps between steps of metric history the way the request asks for.
+func (s Service) fillMetricHistory(
+	req *request.GetMetricHistoryRequest, metrics []models.Metric,
+) ([]models.Metric, error) {
+	if req.Fill != request.MetricHistoryFillForward {
+		return metrics, nil
+	}
+	metrics, ok := ForwardFillMetrics(metrics, req.EndStep, s.historyMaxPoints)
+	if !ok {
+		return nil, api.NewInvalidParameterValueError(
+			"metric history for metric '%s' of run '%s' has more than %d points after forward-filling, "+
+				"use 'start_step' and 'end_step' parameters to narrow it down",
+			req.MetricKey, req.GetRunID(), s.historyMaxPoints,
+		)
+	}
+	return metrics, nil
+}
+
+// orderMetricHistory orders metric history by the x-axis the request asks for. Filled and downsampled
+// metric history is ordered by step, so it has to be ordered by timestamp again when plotted against time.
+func orderMetricHistory(req *request.GetMetricHistoryRequest, metrics []models.Metric) []models.Metric {
+	if req.XAxis == request.MetricHistoryXAxisTime {
+		return SortMetricsByTime(metrics)
+	}
+	return metrics
+}
+
 func (s Service) GetMetricHistoryBulk(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryBulkRequest,
 ) ([]models.Metric, error) {
@@ -34,7 +34,11 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 		"GetMetricHistoryByRunIDAndKey",
 		context.TODO(),
 		"1",
-		"key",
+		&request.GetMetricHistoryRequest{
+			RunID:     "1",
+			MetricKey: "key",
+		},
+		0,
 	).Return([]models.Metric{
 		{
 			Key:       "key",
@@ -45,7 +49,7 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &metricRepository)
+	service := NewService(&runRepository, &metricRepository, nil, 0)
 	metrics, err := service.GetMetricHistory(
 		context.TODO(),
 		&models.Namespace{
@@ -69,6 +73,77 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 	}, metrics)
 }
 
+func TestService_GetMetricHistory_Cache_Ok(t *testing.T) {
+	req := request.GetMetricHistoryRequest{
+		RunID:     "1",
+		MetricKey: "key",
+		MaxPoints: 2,
+	}
+
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"1",
+	).Return(&models.Run{
+		ID: "1",
+	}, nil)
+
+	metricRepository := repositories.MockMetricRepositoryProvider{}
+	metricRepository.On(
+		"GetMetricHistoryVersion",
+		context.TODO(),
+		"1",
+		"key",
+	).Return(int64(3), nil).Twice()
+	metricRepository.On(
+		"GetMetricHistoryVersion",
+		context.TODO(),
+		"1",
+		"key",
+	).Return(int64(4), nil).Once()
+	metricRepository.On(
+		"GetMetricHistoryByRunIDAndKey",
+		context.TODO(),
+		"1",
+		&req,
+		0,
+	).Return([]models.Metric{
+		{Key: "key", Step: 1, Value: 1.1, Iter: 1},
+		{Key: "key", Step: 2, Value: 2.2, Iter: 2},
+		{Key: "key", Step: 3, Value: 3.3, Iter: 3},
+	}, nil)
+
+	historyCache, err := NewHistoryCache(10)
+	require.Nil(t, err)
+	service := NewService(&runRepository, &metricRepository, historyCache, 0)
+
+	expectedMetrics := []models.Metric{
+		{Key: "key", Step: 1, Value: 1.1, Iter: 1},
+		{Key: "key", Step: 3, Value: 3.3, Iter: 3},
+	}
+
+	// the first request misses the cache.
+	metrics, err := service.GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, &req)
+	require.Nil(t, err)
+	assert.Equal(t, expectedMetrics, metrics)
+	metricRepository.AssertNumberOfCalls(t, "GetMetricHistoryByRunIDAndKey", 1)
+
+	// the second identical request hits the cache.
+	metrics, err = service.GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, &req)
+	require.Nil(t, err)
+	assert.Equal(t, expectedMetrics, metrics)
+	metricRepository.AssertNumberOfCalls(t, "GetMetricHistoryByRunIDAndKey", 1)
+
+	// the metric has been logged since then, so the cached history is stale.
+	metrics, err = service.GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, &req)
+	require.Nil(t, err)
+	assert.Equal(t, expectedMetrics, metrics)
+	metricRepository.AssertNumberOfCalls(t, "GetMetricHistoryByRunIDAndKey", 2)
+}
+
 func TestService_GetMetricHistory_Error(t *testing.T) {
 	testData := []struct {
 		name    string
@@ -93,7 +168,7 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 					LifecycleStage: models.LifecycleStageActive,
 				}, nil)
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -105,7 +180,7 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -128,9 +203,13 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 					"GetMetricHistoryByRunIDAndKey",
 					context.TODO(),
 					"1",
-					"key",
+					&request.GetMetricHistoryRequest{
+						RunID:     "1",
+						MetricKey: "key",
+					},
+					0,
 				).Return(nil, errors.New("database error"))
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 	}
@@ -166,7 +245,7 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 	}, nil)
 
 	// call service under testing.
-	service := NewService(&runRepository, &metricRepository)
+	service := NewService(&runRepository, &metricRepository, nil, 0)
 	metrics, err := service.GetMetricHistoryBulk(context.TODO(), &models.Namespace{
 		ID: 1,
 	}, &request.GetMetricHistoryBulkRequest{
@@ -203,7 +282,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -217,7 +296,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -231,7 +310,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -253,7 +332,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 					"key",
 					10,
 				).Return(nil, errors.New("database error"))
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 	}
@@ -343,7 +422,7 @@ func TestNewService_GetMetricHistories_Ok(t *testing.T) {
 			)
 
 			// call service under testing.
-			service := NewService(&runRepository, &metricRepository)
+			service := NewService(&runRepository, &metricRepository, nil, 0)
 			//nolint:rowserrcheck,sqlclosecheck
 			rows, iterator, err := service.GetMetricHistories(context.TODO(), tt.namespace, tt.request)
 			assert.Equal(t, tt.expectedErr, err)
@@ -373,7 +452,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -386,7 +465,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -400,7 +479,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 		{
@@ -430,7 +509,7 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 					nil,
 					errors.New("database error"),
 				)
-				return NewService(&runRepository, &metricRepository)
+				return NewService(&runRepository, &metricRepository, nil, 0)
 			},
 		},
 	}
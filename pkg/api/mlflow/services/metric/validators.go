@@ -28,6 +28,31 @@ func ValidateGetMetricHistoryRequest(req *request.GetMetricHistoryRequest) error
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'")
 	}
+	if req.StartStep != nil && req.EndStep != nil && *req.StartStep > *req.EndStep {
+		return api.NewInvalidParameterValueError("'start_step' parameter can't be greater than 'end_step' parameter")
+	}
+	if req.StartTime != nil && req.EndTime != nil && *req.StartTime > *req.EndTime {
+		return api.NewInvalidParameterValueError("'start_time' parameter can't be greater than 'end_time' parameter")
+	}
+	if req.Smoothing != nil && (*req.Smoothing < 0 || *req.Smoothing > 1) {
+		return api.NewInvalidParameterValueError("'smoothing' parameter has to be in range [0, 1]")
+	}
+	if req.MaxPoints < 0 {
+		return api.NewInvalidParameterValueError("'max_points' parameter has to be a non-negative number")
+	}
+	if req.Precision < 0 {
+		return api.NewInvalidParameterValueError("'precision' parameter has to be a non-negative number")
+	}
+	switch req.Fill {
+	case "", request.MetricHistoryFillNone, request.MetricHistoryFillForward:
+	default:
+		return api.NewInvalidParameterValueError("'fill' parameter has to be one of 'none' or 'forward'")
+	}
+	switch req.XAxis {
+	case "", request.MetricHistoryXAxisStep, request.MetricHistoryXAxisTime:
+	default:
+		return api.NewInvalidParameterValueError("'x_axis' parameter has to be one of 'step' or 'time'")
+	}
 	return nil
 }
 
@@ -46,6 +71,10 @@ func ValidateGetMetricHistoryBulkRequest(req *request.GetMetricHistoryBulkReques
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("GetMetricHistoryBulk request must specify a metric_key.")
 	}
+
+	if req.Precision < 0 {
+		return api.NewInvalidParameterValueError("'precision' parameter has to be a non-negative number")
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
@@ -15,6 +16,8 @@ func TestValidateGetMetricHistoryRequest_Ok(t *testing.T) {
 		RunID:     "id",
 		RunUUID:   "uuid",
 		MetricKey: "key",
+		StartStep: common.GetPointer[int64](200),
+		EndStep:   common.GetPointer[int64](200),
 	})
 	require.Nil(t, err)
 }
@@ -37,6 +40,71 @@ func TestValidateGetMetricHistoryRequest_Error(t *testing.T) {
 				RunID: "id",
 			},
 		},
+		{
+			name:  "StartStepGreaterThanEndStep",
+			error: api.NewInvalidParameterValueError("'start_step' parameter can't be greater than 'end_step' parameter"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				StartStep: common.GetPointer[int64](300),
+				EndStep:   common.GetPointer[int64](200),
+			},
+		},
+		{
+			name:  "StartTimeGreaterThanEndTime",
+			error: api.NewInvalidParameterValueError("'start_time' parameter can't be greater than 'end_time' parameter"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				StartTime: common.GetPointer[int64](2),
+				EndTime:   common.GetPointer[int64](1),
+			},
+		},
+		{
+			name:  "SmoothingOutOfRange",
+			error: api.NewInvalidParameterValueError("'smoothing' parameter has to be in range [0, 1]"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				Smoothing: common.GetPointer(1.5),
+			},
+		},
+		{
+			name:  "NegativeMaxPoints",
+			error: api.NewInvalidParameterValueError("'max_points' parameter has to be a non-negative number"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				MaxPoints: -1,
+			},
+		},
+		{
+			name:  "NegativePrecision",
+			error: api.NewInvalidParameterValueError("'precision' parameter has to be a non-negative number"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				Precision: -1,
+			},
+		},
+		{
+			name:  "UnsupportedFill",
+			error: api.NewInvalidParameterValueError("'fill' parameter has to be one of 'none' or 'forward'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				Fill:      "backward",
+			},
+		},
+		{
+			name:  "UnsupportedXAxis",
+			error: api.NewInvalidParameterValueError("'x_axis' parameter has to be one of 'step' or 'time'"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				XAxis:     "epoch",
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -2,26 +2,233 @@ package model
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
+// runsSourceScheme is the scheme of the model version source referencing run artifacts.
+const runsSourceScheme = "runs:/"
+
 // Service provides service layer to work with `model` business logic.
-type Service struct{}
+type Service struct {
+	runRepository   repositories.RunRepositoryProvider
+	modelRepository repositories.ModelRepositoryProvider
+}
 
 // NewService creates new Service instance.
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	modelRepository repositories.ModelRepositoryProvider,
+) *Service {
+	return &Service{
+		runRepository:   runRepository,
+		modelRepository: modelRepository,
+	}
+}
+
+// CreateRegisteredModel creates new RegisteredModel entity.
+func (s Service) CreateRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateCreateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	registeredModel := models.RegisteredModel{
+		Name:            req.Name,
+		Description:     req.Description,
+		CreationTime:    now,
+		LastUpdatedTime: now,
+		NamespaceID:     namespace.ID,
+	}
+	if err := s.modelRepository.CreateRegisteredModel(ctx, &registeredModel); err != nil {
+		if errors.As(err, &repositories.RegisteredModelConflictError{}) {
+			return nil, api.NewResourceAlreadyExistsError("%s", err)
+		}
+		return nil, api.NewInternalError("error inserting registered model '%s': %s", req.Name, err)
+	}
+	return &registeredModel, nil
+}
+
+// GetRegisteredModel returns RegisteredModel entity with its versions.
+func (s Service) GetRegisteredModel(
+	ctx context.Context, namespace *models.Namespace, req *request.GetRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateGetRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.modelRepository.GetRegisteredModelByNamespaceIDAndName(ctx, namespace.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find registered model '%s': %s", req.Name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("registered model(name=%s) not found", req.Name)
+	}
+	return registeredModel, nil
+}
+
+// CreateModelVersion creates new ModelVersion entity of the registered model, pointing to the run artifact.
+func (s Service) CreateModelVersion(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateModelVersionRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateCreateModelVersionRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.modelRepository.GetRegisteredModelByNamespaceIDAndName(ctx, namespace.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find registered model '%s': %s", req.Name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("registered model(name=%s) not found", req.Name)
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunIDWithRelations(ctx, namespace.ID, req.RunID, nil)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	source, err := getModelVersionSource(run, req.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	modelVersion := models.ModelVersion{
+		RegisteredModelID: registeredModel.ID,
+		Description:       req.Description,
+		Source:            source,
+		Status:            models.ModelVersionStatusReady,
+		CreationTime:      now,
+		LastUpdatedTime:   now,
+		RunID:             run.ID,
+	}
+	if err := s.modelRepository.CreateModelVersion(ctx, &modelVersion); err != nil {
+		return nil, api.NewInternalError("error inserting version of registered model '%s': %s", req.Name, err)
+	}
+	return &modelVersion, nil
 }
 
+// TransitionModelVersionStage moves existing ModelVersion entity to the requested stage.
+func (s Service) TransitionModelVersionStage(
+	ctx context.Context, namespace *models.Namespace, req *request.TransitionModelVersionStageRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateTransitionModelVersionStageRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.modelRepository.GetRegisteredModelByNamespaceIDAndName(ctx, namespace.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find registered model '%s': %s", req.Name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("registered model(name=%s) not found", req.Name)
+	}
+
+	version, _ := strconv.ParseInt(req.Version, 10, 64)
+	modelVersion, err := s.modelRepository.GetModelVersionByRegisteredModelIDAndVersion(
+		ctx, registeredModel.ID, version,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find model version '%s' of '%s': %s", req.Version, req.Name, err)
+	}
+	if modelVersion == nil {
+		return nil, api.NewResourceDoesNotExistError(
+			"model version(name=%s, version=%s) not found", req.Name, req.Version,
+		)
+	}
+
+	stage, _ := getModelVersionStage(req.Stage)
+	if !slices.Contains(AllowedModelVersionStageTransitions[modelVersion.CurrentStage], stage) {
+		return nil, api.NewInvalidParameterValueError(
+			"model version(name=%s, version=%s) can't be moved from stage '%s' to stage '%s'",
+			req.Name, req.Version, modelVersion.CurrentStage, stage,
+		)
+	}
+
+	// only one version of the model is expected to be in `Staging` or `Production` at a time,
+	// so the others are archived on request.
+	archiveExistingVersions := req.ArchiveExistingVersions &&
+		(stage == models.ModelVersionStageStaging || stage == models.ModelVersionStageProduction)
+
+	modelVersion.CurrentStage = stage
+	modelVersion.LastUpdatedTime = time.Now().UTC().UnixMilli()
+	if err := s.modelRepository.TransitionModelVersionStage(ctx, modelVersion, archiveExistingVersions); err != nil {
+		return nil, api.NewInternalError(
+			"error moving model version '%s' of '%s' to stage '%s': %s", req.Version, req.Name, stage, err,
+		)
+	}
+	return modelVersion, nil
+}
+
+// SearchModelVersions handles `GET /model-versions/search` endpoint.
 func (s Service) SearchModelVersions(ctx context.Context) (any, error) {
 	return fiber.Map{
 		"model_versions": []any{},
 	}, nil
 }
 
+// SearchRegisteredModels handles `GET /registered-models/search` endpoint.
 func (s Service) SearchRegisteredModels(ctx context.Context) (any, error) {
 	return fiber.Map{
 		"registered_models": []any{},
 	}, nil
 }
+
+// getModelVersionSource resolves requested source into the absolute location inside the run artifact location.
+func getModelVersionSource(run *models.Run, source string) (string, error) {
+	artifactURI := strings.TrimSuffix(run.ArtifactURI, "/")
+	path := source
+	switch {
+	case strings.HasPrefix(source, runsSourceScheme):
+		runID, runPath, _ := strings.Cut(strings.TrimPrefix(source, runsSourceScheme), "/")
+		if runID != run.ID {
+			return "", api.NewInvalidParameterValueError(
+				"source '%s' doesn't belong to run '%s'", source, run.ID,
+			)
+		}
+		path = runPath
+	case source == artifactURI:
+		path = ""
+	case strings.HasPrefix(source, artifactURI+"/"):
+		path = strings.TrimPrefix(source, artifactURI+"/")
+	}
+
+	parsedURL, err := url.Parse(path)
+	if err != nil ||
+		parsedURL.Scheme != "" ||
+		parsedURL.Host != "" ||
+		parsedURL.RawQuery != "" ||
+		parsedURL.RawFragment != "" ||
+		parsedURL.User != nil ||
+		filepath.IsAbs(parsedURL.Path) ||
+		slices.Contains(strings.Split(parsedURL.Path, "/"), "..") {
+		return "", api.NewInvalidParameterValueError(
+			"source '%s' is not located inside artifact location of run '%s'", source, run.ID,
+		)
+	}
+
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return artifactURI, nil
+	}
+	return fmt.Sprintf("%s/%s", artifactURI, path), nil
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+)
+
+// AllowedModelVersionStageTransitions lists stages a model version can be moved to from its current stage.
+var AllowedModelVersionStageTransitions = map[models.ModelVersionStage][]models.ModelVersionStage{
+	models.ModelVersionStageNone: {
+		models.ModelVersionStageStaging,
+		models.ModelVersionStageProduction,
+		models.ModelVersionStageArchived,
+	},
+	models.ModelVersionStageStaging: {
+		models.ModelVersionStageNone,
+		models.ModelVersionStageProduction,
+		models.ModelVersionStageArchived,
+	},
+	models.ModelVersionStageProduction: {
+		models.ModelVersionStageStaging,
+		models.ModelVersionStageArchived,
+	},
+	models.ModelVersionStageArchived: {
+		models.ModelVersionStageNone,
+	},
+}
+
+// ValidateCreateRegisteredModelRequest validates `POST /mlflow/registered-models/create` request.
+func ValidateCreateRegisteredModelRequest(req *request.CreateRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateGetRegisteredModelRequest validates `GET /mlflow/registered-models/get` request.
+func ValidateGetRegisteredModelRequest(req *request.GetRegisteredModelRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	return nil
+}
+
+// ValidateCreateModelVersionRequest validates `POST /mlflow/model-versions/create` request.
+func ValidateCreateModelVersionRequest(req *request.CreateModelVersionRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Source == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'source'")
+	}
+	return nil
+}
+
+// ValidateTransitionModelVersionStageRequest validates `POST /mlflow/model-versions/transition-stage` request.
+func ValidateTransitionModelVersionStageRequest(req *request.TransitionModelVersionStageRequest) error {
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Version == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'version'")
+	}
+	if version, err := strconv.ParseInt(req.Version, 10, 64); err != nil || version < 1 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'version': '%s' is not a positive integer", req.Version,
+		)
+	}
+	if req.Stage == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'stage'")
+	}
+	if _, ok := getModelVersionStage(req.Stage); !ok {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'stage': unsupported stage '%s', supported stages are: %s, %s, %s, %s",
+			req.Stage,
+			models.ModelVersionStageNone,
+			models.ModelVersionStageStaging,
+			models.ModelVersionStageProduction,
+			models.ModelVersionStageArchived,
+		)
+	}
+	return nil
+}
+
+// getModelVersionStage returns supported stage matching provided stage name ignoring case.
+func getModelVersionStage(stage string) (models.ModelVersionStage, bool) {
+	for supportedStage := range AllowedModelVersionStageTransitions {
+		if strings.EqualFold(string(supportedStage), stage) {
+			return supportedStage, true
+		}
+	}
+	return "", false
+}
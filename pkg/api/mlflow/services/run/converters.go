@@ -19,9 +19,13 @@ func adjustSearchRunsRequestForNamespace(ns *models.Namespace, req *request.Sear
 }
 
 // adjustCreateRunRequestForNamespace preprocesses the CreateRunRequest for the given namespace.
+// The default experiment id is cleared, when the namespace has no default experiment, so it is created later.
 func adjustCreateRunRequestForNamespace(ns *models.Namespace, req *request.CreateRunRequest) {
 	if req.ExperimentID == "0" {
-		req.ExperimentID = fmt.Sprintf("%d", *ns.DefaultExperimentID)
+		req.ExperimentID = ""
+		if ns.DefaultExperimentID != nil {
+			req.ExperimentID = fmt.Sprintf("%d", *ns.DefaultExperimentID)
+		}
 	}
 }
 
@@ -70,6 +70,16 @@ func Test_adjustCreateRunRequestForNamespace_Ok(t *testing.T) {
 				ExperimentID: "123",
 			},
 		},
+		{
+			name: "DefaultExperimentIDProvidedAndNamespaceHasNoDefaultExperiment",
+			ns:   &models.Namespace{},
+			inputRequest: &request.CreateRunRequest{
+				ExperimentID: "0",
+			},
+			resultRequest: &request.CreateRunRequest{
+				ExperimentID: "",
+			},
+		},
 		{
 			name: "DefaultExperimentIDNotProvided",
 			ns: &models.Namespace{
@@ -0,0 +1,77 @@
+package run
+
+import (
+	"context"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// purgeDeletedInterval is how often DeletedPurger looks for expired deleted entities.
+const purgeDeletedInterval = time.Hour
+
+// DeletedPurger represents a purger of deleted experiments and runs.
+type DeletedPurger struct {
+	ctx                  context.Context
+	config               *config.Config
+	runRepository        repositories.RunRepositoryProvider
+	experimentRepository repositories.ExperimentRepositoryProvider
+}
+
+// NewDeletedPurger creates a new instance of DeletedPurger.
+func NewDeletedPurger(
+	ctx context.Context,
+	config *config.Config,
+	runRepository repositories.RunRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+) *DeletedPurger {
+	return &DeletedPurger{
+		ctx:                  ctx,
+		config:               config,
+		runRepository:        runRepository,
+		experimentRepository: experimentRepository,
+	}
+}
+
+// Run runs deleted purger background jobs.
+func (p DeletedPurger) Run() {
+	go func() {
+		ticker := time.NewTicker(purgeDeletedInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				log.Debug("deleted purger finished. exiting.")
+				return
+			case <-ticker.C:
+				numberOfExperiments, numberOfRuns, err := p.Purge(p.ctx)
+				if err != nil {
+					log.Errorf("error purging deleted experiments and runs: %+v", err)
+				} else {
+					log.Debugf(
+						"%d deleted experiments and %d deleted runs were successfully purged",
+						numberOfExperiments, numberOfRuns,
+					)
+				}
+			}
+		}
+	}()
+}
+
+// Purge permanently removes experiments and runs which were deleted longer ago than the configured TTL.
+// Metrics, params and tags of the removed runs are removed as well.
+func (p DeletedPurger) Purge(ctx context.Context) (int64, int64, error) {
+	numberOfExperiments, err := p.experimentRepository.PurgeDeleted(ctx, p.config.PurgeDeletedTTL)
+	if err != nil {
+		return 0, 0, eris.Wrap(err, "error purging deleted experiments")
+	}
+	numberOfRuns, err := p.runRepository.PurgeDeleted(ctx, p.config.PurgeDeletedTTL)
+	if err != nil {
+		return numberOfExperiments, 0, eris.Wrap(err, "error purging deleted runs")
+	}
+	return numberOfExperiments, numberOfRuns, nil
+}
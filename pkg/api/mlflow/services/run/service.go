@@ -2,12 +2,13 @@ package run
 
 import (
 	"context"
-	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,9 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	commonRepositories "github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
@@ -47,6 +51,7 @@ const (
 
 // Service provides service layer to work with `run` business logic.
 type Service struct {
+	config               *config.Config
 	logRepository        repositories.LogRepositoryProvider
 	tagRepository        repositories.TagRepositoryProvider
 	runRepository        repositories.RunRepositoryProvider
@@ -54,10 +59,13 @@ type Service struct {
 	metricRepository     repositories.MetricRepositoryProvider
 	experimentRepository repositories.ExperimentRepositoryProvider
 	artifactRepository   repositories.ArtifactRepositoryProvider
+	namespaceRepository  repositories.NamespaceRepositoryProvider
+	searchRunsFilter     commonRepositories.SearchRunsFilter
 }
 
 // NewService creates new Service instance.
 func NewService(
+	config *config.Config,
 	tagRepository repositories.TagRepositoryProvider,
 	runRepository repositories.RunRepositoryProvider,
 	paramRepository repositories.ParamRepositoryProvider,
@@ -65,8 +73,11 @@ func NewService(
 	experimentRepository repositories.ExperimentRepositoryProvider,
 	logRepository repositories.LogRepositoryProvider,
 	artifactRepository repositories.ArtifactRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+	searchRunsFilter commonRepositories.SearchRunsFilter,
 ) *Service {
 	return &Service{
+		config:               config,
 		logRepository:        logRepository,
 		tagRepository:        tagRepository,
 		runRepository:        runRepository,
@@ -74,34 +85,176 @@ func NewService(
 		metricRepository:     metricRepository,
 		experimentRepository: experimentRepository,
 		artifactRepository:   artifactRepository,
+		namespaceRepository:  namespaceRepository,
+		searchRunsFilter:     searchRunsFilter,
 	}
 }
 
 func (s Service) CreateRun(
 	ctx context.Context, ns *models.Namespace, req *request.CreateRunRequest,
 ) (*models.Run, error) {
-	adjustCreateRunRequestForNamespace(ns, req)
-	experimentID, err := strconv.ParseInt(req.ExperimentID, 10, 32)
-	if err != nil {
-		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.ExperimentID, err)
+	if err := ValidateCreateRunRequest(req); err != nil {
+		return nil, err
 	}
 
-	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(experimentID))
+	adjustCreateRunRequestForNamespace(ns, req)
+	experiment, err := s.getCreateRunExperiment(ctx, ns, req)
 	if err != nil {
-		return nil, api.NewResourceDoesNotExistError("unable to find experiment with id '%s': %s", req.ExperimentID, err)
+		return nil, err
 	}
 
-	run, err := convertors.ConvertCreateRunRequestToDBModel(experiment, req)
+	run, err := convertors.ConvertCreateRunRequestToDBModel(s.newRunID(), experiment, req)
 	if err != nil {
 		return nil, api.NewInternalError("error converting request to actual run model: %s", err)
 	}
-	if err := s.runRepository.Create(ctx, run); err != nil {
+	// retried requests with the same idempotency key get the run created by the first request.
+	switch {
+	case req.IdempotencyKey != "":
+		run, err = s.runRepository.CreateWithIdempotencyKey(ctx, ns.ID, req.IdempotencyKey, run, s.config.RunNameUnique)
+	case s.config.RunNameUnique:
+		err = s.runRepository.CreateWithUniqueName(ctx, run)
+	default:
+		err = s.runRepository.Create(ctx, run)
+	}
+	if err != nil {
+		if errors.As(err, &repositories.RunNameConflictError{}) {
+			return nil, api.NewResourceAlreadyExistsError("%s", err)
+		}
 		return nil, api.NewInternalError("error inserting run: %s", err)
 	}
 
 	return run, nil
 }
 
+// newRunID generates id of the new run in the configured format.
+func (s Service) newRunID() string {
+	if s.config.RunIDFormat == config.RunIDFormatULID {
+		return database.NewULID()
+	}
+	return database.NewUUID()
+}
+
+// getCreateRunExperiment returns experiment the run has to be created in.
+// When the experiment is provided by name and doesn't exist, it is created if auto-creation is enabled.
+// The runs created without experiment land in the default experiment of the namespace.
+func (s Service) getCreateRunExperiment(
+	ctx context.Context, ns *models.Namespace, req *request.CreateRunRequest,
+) (*models.Experiment, error) {
+	if req.ExperimentName == "" {
+		if req.ExperimentID == "" ||
+			(ns.DefaultExperimentID != nil && req.ExperimentID == fmt.Sprintf("%d", *ns.DefaultExperimentID)) {
+			return s.getNamespaceDefaultExperiment(ctx, ns)
+		}
+		experimentID, err := strconv.ParseInt(req.ExperimentID, 10, 32)
+		if err != nil {
+			return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", req.ExperimentID, err)
+		}
+		experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, int32(experimentID))
+		if err != nil {
+			return nil, api.NewResourceDoesNotExistError(
+				"unable to find experiment with id '%s': %s", req.ExperimentID, err,
+			)
+		}
+		return experiment, nil
+	}
+
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.ExperimentName)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"error getting experiment with name: '%s', error: %s", req.ExperimentName, err,
+		)
+	}
+	if experiment != nil {
+		return experiment, nil
+	}
+	if !s.config.ExperimentAutoCreate {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment with name '%s'", req.ExperimentName)
+	}
+
+	return s.createExperiment(ctx, ns, req.ExperimentName)
+}
+
+// getNamespaceDefaultExperiment returns the default experiment of the namespace.
+// When the namespace has no default experiment, it is created and set as the default one.
+func (s Service) getNamespaceDefaultExperiment(
+	ctx context.Context, ns *models.Namespace,
+) (*models.Experiment, error) {
+	if ns.DefaultExperimentID != nil {
+		experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, ns.ID, *ns.DefaultExperimentID)
+		if err == nil {
+			return experiment, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, api.NewInternalError(
+				"unable to find default experiment with id '%d': %s", *ns.DefaultExperimentID, err,
+			)
+		}
+	}
+
+	experiment, err := s.createExperiment(ctx, ns, models.DefaultExperimentName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the namespace could be shared with the concurrent requests, so its copy is updated.
+	namespace := *ns
+	namespace.DefaultExperimentID = experiment.ID
+	if err := s.namespaceRepository.Update(ctx, &namespace); err != nil {
+		return nil, api.NewInternalError(
+			"error setting default experiment of namespace '%s': %s", namespace.Code, err,
+		)
+	}
+	log.Infof("set experiment with id %d as default one of namespace '%s'", *experiment.ID, namespace.Code)
+	return experiment, nil
+}
+
+// createExperiment creates the experiment with the given name on run creation.
+// When the experiment has been created by the concurrent request in the meantime, that experiment is returned.
+func (s Service) createExperiment(
+	ctx context.Context, ns *models.Namespace, name string,
+) (*models.Experiment, error) {
+	experiment, err := convertors.ConvertCreateExperimentToDBModel(&request.CreateExperimentRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, api.NewInternalError("error converting experiment '%s': %s", name, err)
+	}
+	experiment.NamespaceID = ns.ID
+	if err := s.experimentRepository.Create(ctx, experiment); err != nil {
+		// experiment could be created by the concurrent request in the meantime.
+		if errors.As(err, &repositories.ExperimentConflictError{}) {
+			experiment, err = s.experimentRepository.GetByNamespaceIDAndName(ctx, ns.ID, name)
+			if err != nil {
+				return nil, api.NewInternalError(
+					"error getting experiment with name: '%s', error: %s", name, err,
+				)
+			}
+			if experiment == nil {
+				return nil, api.NewInternalError("unable to find experiment with name '%s'", name)
+			}
+			return experiment, nil
+		}
+		return nil, api.NewInternalError("error inserting experiment '%s': %s", name, err)
+	}
+
+	path, err := url.JoinPath(
+		ns.GetArtifactRoot(s.config.DefaultArtifactRoot), fmt.Sprintf("%d", *experiment.ID),
+	)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"error creating artifact_location for experiment'%s': %s", experiment.Name, err,
+		)
+	}
+	experiment.ArtifactLocation = path
+	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
+		return nil, api.NewInternalError(
+			"error updating artifact_location for experiment '%s': %s", experiment.Name, err,
+		)
+	}
+	log.Infof("created experiment '%s' with id %d on run creation", experiment.Name, *experiment.ID)
+	return experiment, nil
+}
+
 func (s Service) UpdateRun(
 	ctx context.Context, namespace *models.Namespace, req *request.UpdateRunRequest,
 ) (*models.Run, error) {
@@ -139,6 +292,44 @@ func (s Service) UpdateRun(
 	return run, nil
 }
 
+// FinalizeRun sets the final status, end time and tags of the run in scope of one transaction.
+func (s Service) FinalizeRun(
+	ctx context.Context, namespace *models.Namespace, req *request.FinalizeRunRequest,
+) (*models.Run, error) {
+	if err := ValidateFinalizeRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.GetRunID(), models.LifecycleStageActive,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+
+	run, tags := convertors.ConvertFinalizeRunRequestToDBModel(run, req)
+	if err := s.runRepository.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.runRepository.UpdateWithTransaction(ctx, tx, run); err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			if err := s.tagRepository.CreateRunTagWithTransaction(
+				ctx, tx, run.ID, tag.Key, tag.Value,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, api.NewInternalError("unable to finalize run '%s': %s", run.ID, err)
+	}
+
+	return run, nil
+}
+
 func (s Service) GetRun(
 	ctx context.Context,
 	namespace *models.Namespace,
@@ -148,7 +339,15 @@ func (s Service) GetRun(
 		return nil, err
 	}
 
-	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	var run *models.Run
+	var err error
+	if req.Fields == "" {
+		run, err = s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	} else {
+		run, err = s.runRepository.GetByNamespaceIDAndRunIDWithRelations(
+			ctx, namespace.ID, req.GetRunID(), getRunRelations(req),
+		)
+	}
 	if err != nil {
 		return nil, api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.GetRunID(), err)
 	}
@@ -159,6 +358,69 @@ func (s Service) GetRun(
 	return run, nil
 }
 
+// GetRunLineage returns the parent chain, starting from the immediate parent, and the immediate children
+// of the requested run. Nested runs reference their parent by the `mlflow.parentRunId` tag.
+func (s Service) GetRunLineage(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.GetRunLineageRequest,
+) ([]string, []string, error) {
+	if err := ValidateGetRunLineageRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunIDWithRelations(ctx, namespace.ID, req.GetRunID(), nil)
+	if err != nil {
+		return nil, nil, api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+
+	tags, err := s.tagRepository.GetByNamespaceIDAndKey(ctx, namespace.ID, convertors.TagKeyParentRunID)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to get parent run tags for run '%s': %s", run.ID, err)
+	}
+	parentRunIDs := make(map[string]string, len(tags))
+	children := []string{}
+	for _, tag := range tags {
+		parentRunIDs[tag.RunID] = tag.Value
+		if tag.Value == run.ID {
+			children = append(children, tag.RunID)
+		}
+	}
+	slices.Sort(children)
+
+	// the visited runs are tracked, so the broken tags referencing each other don't make the loop endless.
+	parents := []string{}
+	visited := map[string]bool{run.ID: true}
+	for runID := run.ID; ; {
+		parentRunID, ok := parentRunIDs[runID]
+		if !ok || visited[parentRunID] {
+			break
+		}
+		parents = append(parents, parentRunID)
+		visited[parentRunID] = true
+		runID = parentRunID
+	}
+	return parents, children, nil
+}
+
+// getRunRelations returns the run relations which have to be loaded for the requested fields.
+func getRunRelations(req *request.GetRunRequest) []string {
+	var relations []string
+	if req.HasField(request.RunFieldMetrics) {
+		relations = append(relations, repositories.RunRelationLatestMetrics)
+	}
+	if req.HasField(request.RunFieldParams) {
+		relations = append(relations, repositories.RunRelationParams)
+	}
+	if req.HasField(request.RunFieldTags) {
+		relations = append(relations, repositories.RunRelationTags)
+	}
+	return relations
+}
+
 // nolint:gocyclo
 // TODO:get back and fix `gocyclo` problem.
 func (s Service) SearchRuns(
@@ -186,7 +448,7 @@ func (s Service) SearchRuns(
 			database.LifecycleStageDeleted,
 		}
 	}
-	tx := database.DB.Joins(
+	tx := database.WithSearchReplicas(database.DB).Joins(
 		"LEFT JOIN experiments ON experiments.experiment_id = runs.experiment_id",
 	).Where(
 		"experiments.namespace_id = ?", namespace.ID,
@@ -195,13 +457,11 @@ func (s Service) SearchRuns(
 	).Where(
 		"runs.lifecycle_stage IN ?", lifecyleStages,
 	)
+	tx = s.searchRunsFilter.Filter(ctx, tx)
 
 	// MaxResults
 	// TODO if compatible with mlflow client, consider using same logic as in ExperimentSearch
-	limit := int(req.MaxResults)
-	if limit == 0 {
-		limit = 1000
-	}
+	limit := s.config.GetSearchMaxResults(int(req.MaxResults))
 	tx.Limit(limit)
 
 	// PageToken
@@ -416,6 +676,7 @@ func (s Service) SearchRuns(
 		}
 
 		column := strings.Trim(components[2], "`\"")
+		desc := len(components) == 4 && strings.ToUpper(components[3]) == "DESC"
 
 		var kind any
 		switch components[1] {
@@ -437,22 +698,36 @@ func (s Service) SearchRuns(
 		}
 		if kind != nil {
 			table := fmt.Sprintf("order_%d", n)
+			query := database.DB.Select("run_uuid", "value")
+			// the same metric could be logged with different contexts, so the best value
+			// in the requested direction is used to keep only one row per run.
+			if _, ok := kind.(*database.LatestMetric); ok {
+				aggregate := "MIN"
+				if desc {
+					aggregate = "MAX"
+				}
+				query = database.DB.Select(fmt.Sprintf("run_uuid, %s(value) AS value", aggregate)).Group("run_uuid")
+			}
 			tx.Joins(
 				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.DB.Select("run_uuid", "value").Where("key = ?", column).Model(kind),
+				query.Where("key = ?", column).Model(kind),
 			)
 			column = fmt.Sprintf("%s.value", table)
+			// runs without the value always go last, regardless of the direction and the database defaults.
+			tx.Order(fmt.Sprintf("%s IS NULL", column))
 		}
 		tx.Order(clause.OrderByColumn{
 			Column: clause.Column{
 				Name: column,
 			},
-			Desc: len(components) == 4 && strings.ToUpper(components[3]) == "DESC",
+			Desc: desc,
 		})
 	}
 	if !startTimeOrder {
 		tx.Order("runs.start_time DESC")
 	}
+	// `run_uuid` is unique, so it always has to be the last sort key to break ties
+	// between runs with equal sort keys and to keep pagination stable.
 	tx.Order("runs.run_uuid")
 
 	// Actual query
@@ -484,13 +759,37 @@ func (s Service) DeleteRun(
 		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
 	}
 
-	if err := s.runRepository.Archive(ctx, run); err != nil {
+	if err := s.runRepository.Archive(ctx, namespace.ID, run, middleware.GetActorFromContext(ctx)); err != nil {
 		return api.NewInternalError("unable to delete run '%s': %s", run.ID, err)
 	}
 
 	return nil
 }
 
+// DeleteRunDryRun returns what would be affected by deletion of the Run without deleting it.
+func (s Service) DeleteRunDryRun(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteRunRequest,
+) (*models.DeletionImpact, error) {
+	if err := ValidateDeleteRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	impact, err := s.runRepository.GetDeletionImpact(ctx, run)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get deletion impact of run '%s': %s", run.ID, err)
+	}
+
+	return impact, nil
+}
+
 func (s Service) RestoreRun(
 	ctx context.Context,
 	namespace *models.Namespace,
@@ -508,9 +807,7 @@ func (s Service) RestoreRun(
 		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
 	}
 
-	run.DeletedTime = sql.NullInt64{Valid: false}
-	run.LifecycleStage = models.LifecycleStageActive
-	if err := s.runRepository.Update(ctx, run); err != nil {
+	if err := s.runRepository.Restore(ctx, namespace.ID, run, middleware.GetActorFromContext(ctx)); err != nil {
 		return api.NewInternalError("unable to restore run '%s': %s", run.ID, err)
 	}
 
@@ -538,7 +835,16 @@ func (s Service) LogMetric(
 	if err != nil {
 		return api.NewInvalidParameterValueError(err.Error())
 	}
-	if err := s.metricRepository.CreateBatch(ctx, run, 1, []models.Metric{*metric}); err != nil {
+	strictSteps, err := s.isStrictStepsRun(ctx, run, nil)
+	if err != nil {
+		return err
+	}
+	if err := s.metricRepository.CreateBatch(ctx, run, 1, []models.Metric{*metric}, strictSteps); err != nil {
+		if errors.As(err, &repositories.MetricStepOrderError{}) {
+			return api.NewInvalidParameterValueError(
+				"unable to log metric '%s' for run '%s': %s", req.Key, req.GetRunID(), err,
+			)
+		}
 		return api.NewInternalError("unable to log metric '%s' for run '%s': %s", req.Key, req.GetRunID(), err)
 	}
 
@@ -575,6 +881,36 @@ func (s Service) LogParam(
 	return nil
 }
 
+func (s Service) LogParams(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.LogParamsRequest,
+) error {
+	if err := ValidateLogParamsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.RunID, models.LifecycleStageActive,
+	)
+	if err != nil {
+		return api.NewInternalError("Unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("Run '%s' not found", req.RunID)
+	}
+
+	params := convertors.ConvertLogParamsRequestToDBModel(run.ID, req)
+	if err := s.paramRepository.CreateBatch(ctx, 100, params); err != nil {
+		if errors.As(err, &repositories.ParamConflictError{}) {
+			return api.NewInvalidParameterValueError("unable to insert params for run '%s': %s", run.ID, err)
+		}
+		return api.NewInternalError("unable to insert params for run '%s': %s", run.ID, err)
+	}
+
+	return nil
+}
+
 func (s Service) SetRunTag(
 	ctx context.Context,
 	namespace *models.Namespace,
@@ -583,6 +919,9 @@ func (s Service) SetRunTag(
 	if err := ValidateSetRunTagRequest(req); err != nil {
 		return err
 	}
+	if err := ValidateSetRunTagLength(req, s.config.TagKeyMaxLength, s.config.TagValueMaxLength); err != nil {
+		return err
+	}
 
 	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
 		ctx, namespace.ID, req.RunID, models.LifecycleStageActive,
@@ -624,8 +963,9 @@ func (s Service) DeleteRunTag(
 	if err != nil {
 		return api.NewInternalError("Unable to find tag '%s' for run '%s': %s", req.Key, req.RunID, err)
 	}
+	// deleting an absent tag is a no-op, so the request can be safely retried.
 	if tag == nil {
-		return api.NewResourceDoesNotExistError("No tag with name: %s", req.Key)
+		return nil
 	}
 
 	if err := s.tagRepository.Delete(ctx, tag); err != nil {
@@ -664,7 +1004,16 @@ func (s Service) LogBatch(
 		}
 		return api.NewInternalError("unable to insert params for run '%s': %s", run.ID, err)
 	}
-	if err := s.metricRepository.CreateBatch(ctx, run, 100, metrics); err != nil {
+	strictSteps := false
+	if len(metrics) > 0 {
+		if strictSteps, err = s.isStrictStepsRun(ctx, run, tags); err != nil {
+			return err
+		}
+	}
+	if err := s.metricRepository.CreateBatch(ctx, run, 100, metrics, strictSteps); err != nil {
+		if errors.As(err, &repositories.MetricStepOrderError{}) {
+			return api.NewInvalidParameterValueError("unable to insert metrics for run '%s': %s", run.ID, err)
+		}
 		return api.NewInternalError("unable to insert metrics for run '%s': %s", run.ID, err)
 	}
 	if err := s.runRepository.SetRunTagsBatch(ctx, run, 100, tags); err != nil {
@@ -674,6 +1023,27 @@ func (s Service) LogBatch(
 	return nil
 }
 
+// isStrictStepsRun checks whether strict step mode is enabled for the run, either by the
+// tags logged together with the metrics or by the tag already stored for the run.
+// The tag isn't looked up at all, unless the strict step mode is enabled for the server.
+func (s Service) isStrictStepsRun(ctx context.Context, run *models.Run, tags []models.Tag) (bool, error) {
+	if !s.config.RunStrictSteps {
+		return false, nil
+	}
+	for _, tag := range tags {
+		if tag.Key == convertors.TagKeyStrictSteps {
+			return strings.EqualFold(tag.Value, "true"), nil
+		}
+	}
+	tag, err := s.tagRepository.GetByRunIDAndKey(ctx, run.ID, convertors.TagKeyStrictSteps)
+	if err != nil {
+		return false, api.NewInternalError(
+			"unable to find tag '%s' for run '%s': %s", convertors.TagKeyStrictSteps, run.ID, err,
+		)
+	}
+	return tag != nil && strings.EqualFold(tag.Value, "true"), nil
+}
+
 func (s Service) LogOutput(
 	ctx context.Context,
 	namespace *models.Namespace,
@@ -6,15 +6,20 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/rotisserie/eris"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	commonRepositories "github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
 )
 
 func TestService_CreateRun_Ok(t *testing.T) {
@@ -62,6 +67,7 @@ func TestService_CreateRun_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
@@ -69,6 +75,8 @@ func TestService_CreateRun_Ok(t *testing.T) {
 		&experimentRepository,
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	run, err := service.CreateRun(context.TODO(), &ns, &request.CreateRunRequest{
 		ExperimentID: "0", // default experiment id provided by the client is "0"
@@ -100,6 +108,100 @@ func TestService_CreateRun_Ok(t *testing.T) {
 	}, run.Tags)
 }
 
+func TestService_CreateRun_DefaultExperimentCreated(t *testing.T) {
+	// initialise namespace, which default experiment doesn't exist.
+	ns := models.Namespace{
+		ID:                  1,
+		Code:                "code",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	}
+
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"Create",
+		context.TODO(),
+		mock.MatchedBy(func(run *models.Run) bool {
+			return run.ExperimentID == int32(2)
+		}),
+	).Return(nil)
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID",
+		context.TODO(),
+		ns.ID,
+		int32(0),
+	).Return(nil, eris.Wrap(gorm.ErrRecordNotFound, "error getting experiment by id: 0"))
+	experimentRepository.On(
+		"Create",
+		context.TODO(),
+		mock.MatchedBy(func(experiment *models.Experiment) bool {
+			return experiment.Name == models.DefaultExperimentName && experiment.NamespaceID == ns.ID
+		}),
+	).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Experiment).ID = common.GetPointer(int32(2))
+	}).Return(nil)
+	experimentRepository.On(
+		"Update",
+		context.TODO(),
+		mock.MatchedBy(func(experiment *models.Experiment) bool {
+			return experiment.ArtifactLocation == "/artifact/root/2"
+		}),
+	).Return(nil)
+
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"Update",
+		context.TODO(),
+		mock.MatchedBy(func(namespace *models.Namespace) bool {
+			return namespace.ID == ns.ID && *namespace.DefaultExperimentID == int32(2)
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{DefaultArtifactRoot: "/artifact/root"},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&experimentRepository,
+		&repositories.MockLogRepositoryProvider{},
+		&repositories.MockArtifactRepositoryProvider{},
+		&namespaceRepository,
+		commonRepositories.NoopSearchRunsFilter{},
+	)
+	run, err := service.CreateRun(context.TODO(), &ns, &request.CreateRunRequest{
+		Name: "name",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), run.ExperimentID)
+	// the namespace from the request context is kept as is, only its copy is updated.
+	assert.Equal(t, int32(0), *ns.DefaultExperimentID)
+	namespaceRepository.AssertExpectations(t)
+}
+
+func TestService_NewRunID_Ok(t *testing.T) {
+	// ULIDs are time-sortable, so every next id has to be greater than the previous one.
+	service := Service{config: &config.Config{RunIDFormat: config.RunIDFormatULID}}
+	previous := ""
+	for i := 0; i < 1000; i++ {
+		id := service.newRunID()
+		require.Regexp(t, `^[0-9a-hjkmnp-tv-z]{26}$`, id)
+		require.Greater(t, id, previous)
+		previous = id
+	}
+
+	// hex encoded UUIDs are used by default.
+	for _, format := range []string{"", config.RunIDFormatUUID} {
+		service = Service{config: &config.Config{RunIDFormat: format}}
+		require.Regexp(t, `^[0-9a-f]{32}$`, service.newRunID())
+	}
+}
+
 func TestService_CreateRun_Error(t *testing.T) {
 	// initialise namespace to which experiment under the test belongs to.
 	ns := models.Namespace{
@@ -114,11 +216,16 @@ func TestService_CreateRun_Error(t *testing.T) {
 		service func() *Service
 	}{
 		{
-			name:    "EmptyOrIncorrectExperimentID",
-			error:   api.NewBadRequestError(`unable to parse experiment id '': strconv.ParseInt: parsing "": invalid syntax`),
-			request: &request.CreateRunRequest{},
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'abc': strconv.ParseInt: parsing "abc": invalid syntax`,
+			),
+			request: &request.CreateRunRequest{
+				ExperimentID: "abc",
+			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -126,6 +233,8 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -144,6 +253,7 @@ func TestService_CreateRun_Error(t *testing.T) {
 					int32(1),
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -151,6 +261,8 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&experimentRepository,
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -198,6 +310,7 @@ func TestService_CreateRun_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -205,6 +318,8 @@ func TestService_CreateRun_Error(t *testing.T) {
 					&experimentRepository,
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -236,6 +351,7 @@ func TestService_UpdateRun_Error(t *testing.T) {
 			request: &request.UpdateRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -243,6 +359,8 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -261,6 +379,7 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -268,6 +387,8 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -292,17 +413,16 @@ func TestService_RestoreRun_Ok(t *testing.T) {
 		"1",
 	).Return(&models.Run{ID: "1"}, nil)
 	runRepository.On(
-		"Update",
+		"Restore",
 		context.TODO(),
-		&models.Run{
-			ID:             "1",
-			DeletedTime:    sql.NullInt64{Valid: false},
-			LifecycleStage: models.LifecycleStageActive,
-		},
+		uint(1),
+		&models.Run{ID: "1"},
+		"",
 	).Return(nil)
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
@@ -310,6 +430,8 @@ func TestService_RestoreRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.RestoreRun(context.TODO(), &models.Namespace{ID: 1}, &request.RestoreRunRequest{RunID: "1"})
 
@@ -330,6 +452,7 @@ func TestService_RestoreRun_Error(t *testing.T) {
 			request: &request.RestoreRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -337,6 +460,8 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -355,6 +480,7 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -362,6 +488,8 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -382,16 +510,14 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					ID: "1",
 				}, nil)
 				runRepository.On(
-					"Update",
+					"Restore",
 					context.TODO(),
-					mock.MatchedBy(func(run *models.Run) bool {
-						assert.Equal(t, "1", run.ID)
-						assert.Equal(t, sql.NullInt64{}, run.DeletedTime)
-						assert.Equal(t, models.LifecycleStageActive, run.LifecycleStage)
-						return true
-					}),
+					uint(1),
+					&models.Run{ID: "1"},
+					"",
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -399,6 +525,8 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -435,6 +563,7 @@ func TestService_SetRunTag_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
@@ -442,6 +571,8 @@ func TestService_SetRunTag_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.SetRunTag(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -468,11 +599,14 @@ func TestService_DeleteRun_Ok(t *testing.T) {
 	runRepository.On(
 		"Archive",
 		context.TODO(),
+		uint(1),
 		&models.Run{ID: "1"},
+		"",
 	).Return(nil)
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
@@ -480,6 +614,8 @@ func TestService_DeleteRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.DeleteRun(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteRunRequest{RunID: "1"})
 
@@ -500,6 +636,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 			request: &request.DeleteRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -507,6 +644,8 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -525,6 +664,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -532,6 +672,8 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -550,6 +692,7 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -557,6 +700,8 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -579,12 +724,15 @@ func TestService_DeleteRun_Error(t *testing.T) {
 				runRepository.On(
 					"Archive",
 					context.TODO(),
+					uint(1),
 					mock.MatchedBy(func(run *models.Run) bool {
 						assert.Equal(t, "1", run.ID)
 						return true
 					}),
+					"",
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -592,6 +740,8 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -608,46 +758,102 @@ func TestService_DeleteRun_Error(t *testing.T) {
 	}
 }
 
-func TestService_DeleteRunTag_Ok(t *testing.T) {}
-func TestService_DeleteRunTag_Error(t *testing.T) {
+func TestService_DeleteRunDryRun_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"1",
+	).Return(&models.Run{ID: "1"}, nil)
+	runRepository.On(
+		"GetDeletionImpact",
+		context.TODO(),
+		&models.Run{ID: "1"},
+	).Return(&models.DeletionImpact{Runs: 1, Metrics: 2}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockLogRepositoryProvider{},
+		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
+	)
+	impact, err := service.DeleteRunDryRun(
+		context.TODO(), &models.Namespace{ID: 1}, &request.DeleteRunRequest{RunID: "1", DryRun: true},
+	)
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, &models.DeletionImpact{Runs: 1, Metrics: 2}, impact)
+	runRepository.AssertNotCalled(t, "Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_DeleteRunDryRun_Error(t *testing.T) {
 	testData := []struct {
 		name    string
 		error   *api.ErrorResponse
-		request *request.DeleteRunTagRequest
+		request *request.DeleteRunRequest
 		service func() *Service
 	}{
 		{
-			name:    "EmptyOrIncorrectRunID",
-			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
-			request: &request.DeleteRunTagRequest{},
+			name:  "RunNotFoundOrDatabaseError",
+			error: api.NewResourceDoesNotExistError("unable to find run '1': database error"),
+			request: &request.DeleteRunRequest{
+				RunID: "1",
+			},
 			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
-					&repositories.MockRunRepositoryProvider{},
+					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
 		{
-			name:  "RunNotFoundOrDatabaseError",
-			error: api.NewInternalError("Unable to find run '1': database error"),
-			request: &request.DeleteRunTagRequest{
+			name:  "GetDeletionImpactDatabaseError",
+			error: api.NewInternalError("unable to get deletion impact of run '1': database error"),
+			request: &request.DeleteRunRequest{
 				RunID: "1",
 			},
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
 				runRepository.On(
-					"GetByNamespaceIDRunIDAndLifecycleStage",
+					"GetByNamespaceIDAndRunID",
 					context.TODO(),
 					uint(1),
 					"1",
-					models.LifecycleStageActive,
+				).Return(&models.Run{
+					ID: "1",
+				}, nil)
+				runRepository.On(
+					"GetDeletionImpact",
+					context.TODO(),
+					mock.AnythingOfType("*models.Run"),
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -655,12 +861,119 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().DeleteRunDryRun(context.TODO(), &models.Namespace{
+				ID: 1,
+			}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_DeleteRunTag_Ok(t *testing.T) {
+	testData := []struct {
+		name string
+		tag  *models.Tag
+	}{
 		{
-			name:  "ActiveRunNotFound",
-			error: api.NewResourceDoesNotExistError("Run '1' not found"),
+			name: "ExistingTag",
+			tag: &models.Tag{
+				RunID: "1",
+				Key:   "key",
+				Value: "value",
+			},
+		},
+		{
+			name: "AbsentTag",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			runRepository := repositories.MockRunRepositoryProvider{}
+			runRepository.On(
+				"GetByNamespaceIDRunIDAndLifecycleStage",
+				context.TODO(),
+				uint(1),
+				"1",
+				models.LifecycleStageActive,
+			).Return(&models.Run{
+				ID:             "1",
+				LifecycleStage: models.LifecycleStageActive,
+			}, nil)
+			tagRepository := repositories.MockTagRepositoryProvider{}
+			tagRepository.On(
+				"GetByRunIDAndKey",
+				context.TODO(),
+				"1",
+				"key",
+			).Return(tt.tag, nil)
+			if tt.tag != nil {
+				tagRepository.On("Delete", context.TODO(), tt.tag).Return(nil)
+			}
+
+			service := NewService(
+				&config.Config{},
+				&tagRepository,
+				&runRepository,
+				&repositories.MockParamRepositoryProvider{},
+				&repositories.MockMetricRepositoryProvider{},
+				&repositories.MockExperimentRepositoryProvider{},
+				&repositories.MockLogRepositoryProvider{},
+				&repositories.MockArtifactRepositoryProvider{},
+				&repositories.MockNamespaceRepositoryProvider{},
+				commonRepositories.NoopSearchRunsFilter{},
+			)
+			err := service.DeleteRunTag(context.TODO(), &models.Namespace{
+				ID: 1,
+			}, &request.DeleteRunTagRequest{
+				RunID: "1",
+				Key:   "key",
+			})
+			require.Nil(t, err)
+			tagRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteRunTag_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteRunTagRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DeleteRunTagRequest{},
+			service: func() *Service {
+				return NewService(
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockLogRepositoryProvider{},
+					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
+				)
+			},
+		},
+		{
+			name:  "RunNotFoundOrDatabaseError",
+			error: api.NewInternalError("Unable to find run '1': database error"),
 			request: &request.DeleteRunTagRequest{
 				RunID: "1",
 			},
@@ -672,8 +985,9 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					uint(1),
 					"1",
 					models.LifecycleStageActive,
-				).Return(nil, nil)
+				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -681,15 +995,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
 		{
-			name:  "NotFoundTag",
-			error: api.NewResourceDoesNotExistError("No tag with name: key"),
+			name:  "ActiveRunNotFound",
+			error: api.NewResourceDoesNotExistError("Run '1' not found"),
 			request: &request.DeleteRunTagRequest{
 				RunID: "1",
-				Key:   "key",
 			},
 			service: func() *Service {
 				runRepository := repositories.MockRunRepositoryProvider{}
@@ -699,25 +1014,18 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					uint(1),
 					"1",
 					models.LifecycleStageActive,
-				).Return(&models.Run{
-					ID:             "1",
-					LifecycleStage: models.LifecycleStageActive,
-				}, nil)
-				tagRepository := repositories.MockTagRepositoryProvider{}
-				tagRepository.On(
-					"GetByRunIDAndKey",
-					context.TODO(),
-					"1",
-					"key",
 				).Return(nil, nil)
 				return NewService(
-					&tagRepository,
+					&config.Config{},
+					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -748,6 +1056,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					"key",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -755,6 +1064,8 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -799,6 +1110,7 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -806,6 +1118,8 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -867,6 +1181,7 @@ func TestService_GetRun_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
@@ -874,6 +1189,8 @@ func TestService_GetRun_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	run, err := service.GetRun(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -917,6 +1234,54 @@ func TestService_GetRun_Ok(t *testing.T) {
 	}, run.Metrics)
 }
 
+func TestService_GetRun_WithFields_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunIDWithRelations",
+		context.TODO(),
+		uint(1),
+		"1",
+		[]string{repositories.RunRelationParams},
+	).Return(&models.Run{
+		ID: "1",
+		Params: []models.Param{
+			{
+				Key:      "key",
+				ValueStr: common.GetPointer("value"),
+			},
+		},
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.Config{},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockLogRepositoryProvider{},
+		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
+	)
+	run, err := service.GetRun(context.TODO(), &models.Namespace{
+		ID: 1,
+	}, &request.GetRunRequest{RunID: "1", Fields: "info,params"})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, "1", run.ID)
+	assert.Equal(t, []models.Param{
+		{
+			Key:      "key",
+			ValueStr: common.GetPointer("value"),
+		},
+	}, run.Params)
+	runRepository.AssertExpectations(t)
+}
+
 func TestService_GetRun_Error(t *testing.T) {
 	testData := []struct {
 		name    string
@@ -930,6 +1295,7 @@ func TestService_GetRun_Error(t *testing.T) {
 			request: &request.GetRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -937,6 +1303,8 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -955,6 +1323,7 @@ func TestService_GetRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -962,6 +1331,8 @@ func TestService_GetRun_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1015,6 +1386,7 @@ func TestService_LogBatch_Ok(t *testing.T) {
 			return true
 		}),
 	).Return(nil)
+	tagRepository := repositories.MockTagRepositoryProvider{}
 	metricRepository := repositories.MockMetricRepositoryProvider{}
 	metricRepository.On(
 		"CreateBatch",
@@ -1029,17 +1401,21 @@ func TestService_LogBatch_Ok(t *testing.T) {
 			assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 			return true
 		}),
+		false,
 	).Return(nil)
 
 	// call service under testing.
 	service := NewService(
-		&repositories.MockTagRepositoryProvider{},
+		&config.Config{},
+		&tagRepository,
 		&runRepository,
 		&paramRepository,
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.LogBatch(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1084,6 +1460,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 			request: &request.LogBatchRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1091,6 +1468,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1110,6 +1489,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1117,6 +1497,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1136,6 +1518,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1143,6 +1526,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1162,6 +1547,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1169,6 +1555,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1197,6 +1585,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					ID: "1",
 				}, nil)
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1204,6 +1593,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1244,6 +1635,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					},
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
@@ -1251,6 +1643,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1291,6 +1685,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 					},
 				).Return(repositories.ParamConflictError{Message: "param conflict!"})
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
@@ -1298,6 +1693,8 @@ func TestService_LogBatch_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1346,6 +1743,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 						},
 					},
 				).Return(nil)
+				tagRepository := repositories.MockTagRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
 				metricRepository.On(
 					"CreateBatch",
@@ -1364,17 +1762,22 @@ func TestService_LogBatch_Error(t *testing.T) {
 							Timestamp: 123456789,
 							ContextID: models.DefaultContext.ID,
 							Context:   models.DefaultContext,
+							Kind:      models.MetricKindUser,
 						},
 					},
+					false,
 				).Return(errors.New("database error"))
 				return NewService(
-					&repositories.MockTagRepositoryProvider{},
+					&config.Config{},
+					&tagRepository,
 					&runRepository,
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1445,6 +1848,7 @@ func TestService_LogBatch_Error(t *testing.T) {
 						},
 					},
 				).Return(nil)
+				tagRepository := repositories.MockTagRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
 				metricRepository.On(
 					"CreateBatch",
@@ -1463,17 +1867,22 @@ func TestService_LogBatch_Error(t *testing.T) {
 							Timestamp: 123456789,
 							ContextID: models.DefaultContext.ID,
 							Context:   models.DefaultContext,
+							Kind:      models.MetricKindUser,
 						},
 					},
+					false,
 				).Return(nil)
 				return NewService(
-					&repositories.MockTagRepositoryProvider{},
+					&config.Config{},
+					&tagRepository,
 					&runRepository,
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1500,6 +1909,7 @@ func TestService_LogMetric_Ok(t *testing.T) {
 		ID:             "1",
 		LifecycleStage: models.LifecycleStageActive,
 	}, nil)
+	tagRepository := repositories.MockTagRepositoryProvider{}
 	metricRepository := repositories.MockMetricRepositoryProvider{}
 	metricRepository.On(
 		"CreateBatch",
@@ -1514,17 +1924,21 @@ func TestService_LogMetric_Ok(t *testing.T) {
 			assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 			return true
 		}),
+		false,
 	).Return(nil)
 
 	// call service under testing.
 	service := NewService(
-		&repositories.MockTagRepositoryProvider{},
+		&config.Config{},
+		&tagRepository,
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.LogMetric(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1553,6 +1967,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 			request: &request.LogMetricRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1560,6 +1975,8 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1571,6 +1988,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1578,6 +1996,8 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1590,6 +2010,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1597,6 +2018,8 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1618,6 +2041,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1625,6 +2049,8 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1648,6 +2074,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 					ID: "1",
 				}, nil)
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1655,6 +2082,8 @@ func TestService_LogMetric_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1678,6 +2107,7 @@ func TestService_LogMetric_Error(t *testing.T) {
 				).Return(&models.Run{
 					ID: "1",
 				}, nil)
+				tagRepository := repositories.MockTagRepositoryProvider{}
 				metricRepository := repositories.MockMetricRepositoryProvider{}
 				metricRepository.On(
 					"CreateBatch",
@@ -1696,15 +2126,72 @@ func TestService_LogMetric_Error(t *testing.T) {
 						assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 						return true
 					}),
+					false,
 				).Return(errors.New("database error"))
 				return NewService(
-					&repositories.MockTagRepositoryProvider{},
+					&config.Config{},
+					&tagRepository,
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&metricRepository,
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockLogRepositoryProvider{},
+					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
+				)
+			},
+		},
+		{
+			name: "LogMetricStepOrderError",
+			error: api.NewInvalidParameterValueError(
+				"unable to log metric 'key' for run '1': step 1 of metric(key=key) is less than its last " +
+					"logged step 2, which strict step mode rejects",
+			),
+			request: &request.LogMetricRequest{
+				RunID:     "1",
+				Key:       "key",
+				Step:      1,
+				Value:     1.1,
+				Timestamp: 1234567890,
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(&models.Run{
+					ID: "1",
+				}, nil)
+				tagRepository := repositories.MockTagRepositoryProvider{}
+				tagRepository.On(
+					"GetByRunIDAndKey",
+					context.TODO(),
+					"1",
+					convertors.TagKeyStrictSteps,
+				).Return(&models.Tag{Key: convertors.TagKeyStrictSteps, Value: "true", RunID: "1"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"CreateBatch",
+					context.TODO(),
+					mock.Anything,
+					1,
+					mock.Anything,
+					true,
+				).Return(repositories.MetricStepOrderError{Key: "key", Step: 1, LastStep: 2})
+				return NewService(
+					&config.Config{RunStrictSteps: true},
+					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1747,6 +2234,7 @@ func TestService_LogParam_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.Config{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&paramRepository,
@@ -1754,6 +2242,8 @@ func TestService_LogParam_Ok(t *testing.T) {
 		&repositories.MockExperimentRepositoryProvider{},
 		&repositories.MockLogRepositoryProvider{},
 		&repositories.MockArtifactRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		commonRepositories.NoopSearchRunsFilter{},
 	)
 	err := service.LogParam(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1780,6 +2270,7 @@ func TestService_LogParam_Error(t *testing.T) {
 			request: &request.LogParamRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1787,6 +2278,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1798,6 +2291,7 @@ func TestService_LogParam_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
@@ -1805,6 +2299,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1826,6 +2322,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1833,6 +2330,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1854,6 +2353,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
@@ -1861,6 +2361,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1898,6 +2400,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
@@ -1905,6 +2408,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
@@ -1942,6 +2447,7 @@ func TestService_LogParam_Error(t *testing.T) {
 					}),
 				).Return(repositories.ParamConflictError{Message: "conflict!"})
 				return NewService(
+					&config.Config{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
@@ -1949,6 +2455,8 @@ func TestService_LogParam_Error(t *testing.T) {
 					&repositories.MockExperimentRepositoryProvider{},
 					&repositories.MockLogRepositoryProvider{},
 					&repositories.MockArtifactRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					commonRepositories.NoopSearchRunsFilter{},
 				)
 			},
 		},
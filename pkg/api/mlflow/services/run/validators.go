@@ -1,12 +1,20 @@
 package run
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
 const (
-	MaxResultsPerPage = 1000000
+	MaxResultsPerPage       = 1000000
+	MaxIdempotencyKeyLength = 256
+	MaxMetricsPerBatch      = 1000
+	MaxParamsPerBatch       = 100
+	MaxTagsPerBatch         = 100
 )
 
 // AllowedViewTypeList supported list of ViewType.
@@ -19,6 +27,21 @@ var (
 	}
 )
 
+// ValidateCreateRunRequest validates `POST /mlflow/runs/create` request.
+func ValidateCreateRunRequest(req *request.CreateRunRequest) error {
+	if req.ExperimentID != "" && req.ExperimentName != "" {
+		return api.NewInvalidParameterValueError(
+			"experiment_id and experiment_name cannot both be specified at the same time",
+		)
+	}
+	if len(req.IdempotencyKey) > MaxIdempotencyKeyLength {
+		return api.NewInvalidParameterValueError(
+			"'idempotency_key' parameter can't be longer than %d characters", MaxIdempotencyKeyLength,
+		)
+	}
+	return nil
+}
+
 // ValidateUpdateRunRequest validates `POST /mlflow/runs/update` request.
 func ValidateUpdateRunRequest(req *request.UpdateRunRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
@@ -27,11 +50,59 @@ func ValidateUpdateRunRequest(req *request.UpdateRunRequest) error {
 	return nil
 }
 
+// ValidateFinalizeRunRequest validates `POST /mlflow/runs/finalize` request.
+func ValidateFinalizeRunRequest(req *request.FinalizeRunRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	switch models.Status(req.Status) {
+	case "", models.StatusFinished, models.StatusFailed, models.StatusKilled:
+	default:
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'status': '%s' is not a terminal run status, supported values are: %s",
+			req.Status,
+			strings.Join([]string{
+				string(models.StatusFinished), string(models.StatusFailed), string(models.StatusKilled),
+			}, ", "),
+		)
+	}
+	if req.EndTime < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'end_time': has to be positive")
+	}
+	for _, tag := range req.Tags {
+		if tag.Key == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'")
+		}
+	}
+	return nil
+}
+
+// ValidateGetRunLineageRequest validates `GET /mlflow/runs/lineage` request.
+func ValidateGetRunLineageRequest(req *request.GetRunLineageRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	return nil
+}
+
 // ValidateGetRunRequest validates `GET /mlflow/runs/get` request.
 func ValidateGetRunRequest(req *request.GetRunRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
+	for _, field := range req.GetFields() {
+		switch field {
+		case request.RunFieldInfo, request.RunFieldParams, request.RunFieldTags, request.RunFieldMetrics:
+		default:
+			return api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'fields': unsupported field '%s', supported fields are: %s",
+				field,
+				strings.Join([]string{
+					request.RunFieldInfo, request.RunFieldParams, request.RunFieldTags, request.RunFieldMetrics,
+				}, ", "),
+			)
+		}
+	}
 	return nil
 }
 
@@ -79,6 +150,29 @@ func ValidateLogParamRequest(req *request.LogParamRequest) error {
 	return nil
 }
 
+// ValidateLogParamsRequest validates `POST /mlflow/runs/log-params` request.
+func ValidateLogParamsRequest(req *request.LogParamsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if len(req.Params) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'params'")
+	}
+	if len(req.Params) > MaxParamsPerBatch {
+		return api.NewInvalidParameterValueError(
+			"A params logging request can contain at most %d params. Got %d params. "+
+				"Please split up params across multiple requests and try again.",
+			MaxParamsPerBatch, len(req.Params),
+		)
+	}
+	for _, param := range req.Params {
+		if param.Key == "" {
+			return api.NewInvalidParameterValueError("Invalid value for parameter 'params' supplied")
+		}
+	}
+	return nil
+}
+
 // ValidateSetRunTagRequest validates `POST /mlflow/runs/set-tag` request.
 func ValidateSetRunTagRequest(req *request.SetRunTagRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
@@ -91,6 +185,20 @@ func ValidateSetRunTagRequest(req *request.SetRunTagRequest) error {
 	return nil
 }
 
+// ValidateSetRunTagLength validates length of `POST /mlflow/runs/set-tag` key and value.
+// Zero max length disables the corresponding check.
+func ValidateSetRunTagLength(req *request.SetRunTagRequest, keyMaxLength, valueMaxLength int) error {
+	if keyMaxLength > 0 && utf8.RuneCountInString(req.Key) > keyMaxLength {
+		return api.NewInvalidParameterValueError("'key' parameter can't be longer than %d characters", keyMaxLength)
+	}
+	if valueMaxLength > 0 && utf8.RuneCountInString(req.Value) > valueMaxLength {
+		return api.NewInvalidParameterValueError(
+			"'value' parameter can't be longer than %d characters", valueMaxLength,
+		)
+	}
+	return nil
+}
+
 // ValidateDeleteRunTagRequest validates `POST /mlflow/runs/delete-tag` request.
 func ValidateDeleteRunTagRequest(req *request.DeleteRunTagRequest) error {
 	if req.RunID == "" {
@@ -104,6 +212,27 @@ func ValidateLogBatchRequest(req *request.LogBatchRequest) error {
 	if req.RunID == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
+	if len(req.Metrics) > MaxMetricsPerBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d metrics. Got %d metrics. "+
+				"Please split up metrics across multiple requests and try again.",
+			MaxMetricsPerBatch, len(req.Metrics),
+		)
+	}
+	if len(req.Params) > MaxParamsPerBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d params. Got %d params. "+
+				"Please split up params across multiple requests and try again.",
+			MaxParamsPerBatch, len(req.Params),
+		)
+	}
+	if len(req.Tags) > MaxTagsPerBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d tags. Got %d tags. "+
+				"Please split up tags across multiple requests and try again.",
+			MaxTagsPerBatch, len(req.Tags),
+		)
+	}
 	for _, metric := range req.Metrics {
 		if metric.Key == "" || metric.Timestamp == 0 {
 			return api.NewInvalidParameterValueError("Invalid value for parameter 'metrics' supplied")
@@ -1,6 +1,8 @@
 package run
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,9 +10,40 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
+func TestValidateCreateRunRequest_Ok(t *testing.T) {
+	err := ValidateCreateRunRequest(&request.CreateRunRequest{
+		IdempotencyKey: strings.Repeat("a", MaxIdempotencyKeyLength),
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateRunRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateRunRequest
+	}{
+		{
+			name:  "TooLongIdempotencyKey",
+			error: api.NewInvalidParameterValueError("'idempotency_key' parameter can't be longer than 256 characters"),
+			request: &request.CreateRunRequest{
+				IdempotencyKey: strings.Repeat("a", MaxIdempotencyKeyLength+1),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateRunRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateUpdateRunRequest_Ok(t *testing.T) {
 	err := ValidateUpdateRunRequest(&request.UpdateRunRequest{
 		RunID:   "id",
@@ -40,11 +73,79 @@ func TestValidateUpdateRunRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateFinalizeRunRequest_Ok(t *testing.T) {
+	err := ValidateFinalizeRunRequest(&request.FinalizeRunRequest{
+		RunID:   "id",
+		Status:  string(models.StatusKilled),
+		EndTime: 1234567890,
+		Tags: []request.RunTagPartialRequest{
+			{Key: "key", Value: "value"},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateFinalizeRunRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.FinalizeRunRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.FinalizeRunRequest{},
+		},
+		{
+			name: "NotTerminalStatus",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'status': 'RUNNING' is not a terminal run status, " +
+					"supported values are: FINISHED, FAILED, KILLED",
+			),
+			request: &request.FinalizeRunRequest{
+				RunID:  "id",
+				Status: string(models.StatusRunning),
+			},
+		},
+		{
+			name:  "NegativeEndTime",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'end_time': has to be positive"),
+			request: &request.FinalizeRunRequest{
+				RunID:   "id",
+				EndTime: -1,
+			},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags.key'"),
+			request: &request.FinalizeRunRequest{
+				RunID: "id",
+				Tags: []request.RunTagPartialRequest{
+					{Value: "value"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFinalizeRunRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateGetRunRequest_Ok(t *testing.T) {
 	err := ValidateGetRunRequest(&request.GetRunRequest{
 		RunID: "id",
 	})
 	require.Nil(t, err)
+
+	err = ValidateGetRunRequest(&request.GetRunRequest{
+		RunID:  "id",
+		Fields: "info, params,tags,metrics",
+	})
+	require.Nil(t, err)
 }
 
 func TestValidateGetRunRequest_Error(t *testing.T) {
@@ -58,6 +159,17 @@ func TestValidateGetRunRequest_Error(t *testing.T) {
 			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
 			request: &request.GetRunRequest{},
 		},
+		{
+			name: "UnsupportedField",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'fields': unsupported field 'artifacts', " +
+					"supported fields are: info, params, tags, metrics",
+			),
+			request: &request.GetRunRequest{
+				RunID:  "id",
+				Fields: "info,artifacts",
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -207,6 +319,66 @@ func TestValidateLogParamRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateLogParamsRequest_Ok(t *testing.T) {
+	err := ValidateLogParamsRequest(&request.LogParamsRequest{
+		RunID: "id",
+		Params: []request.ParamPartialRequest{
+			{Key: "key"},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogParamsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogParamsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogParamsRequest{},
+		},
+		{
+			name:  "EmptyParams",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'params'"),
+			request: &request.LogParamsRequest{
+				RunID: "id",
+			},
+		},
+		{
+			name: "TooManyParams",
+			error: api.NewInvalidParameterValueError(
+				"A params logging request can contain at most 100 params. Got 101 params. " +
+					"Please split up params across multiple requests and try again.",
+			),
+			request: &request.LogParamsRequest{
+				RunID:  "id",
+				Params: make([]request.ParamPartialRequest, MaxParamsPerBatch+1),
+			},
+		},
+		{
+			name:  "EmptyParamKey",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'params' supplied"),
+			request: &request.LogParamsRequest{
+				RunID: "id",
+				Params: []request.ParamPartialRequest{
+					{Key: "key"},
+					{Key: ""},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogParamsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateSetRunTagRequest_Ok(t *testing.T) {
 	err := ValidateSetRunTagRequest(&request.SetRunTagRequest{
 		RunID:   "id",
@@ -244,6 +416,79 @@ func TestValidateSetRunTagRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateSetRunTagLength_Ok(t *testing.T) {
+	testData := []struct {
+		name           string
+		keyMaxLength   int
+		valueMaxLength int
+		request        *request.SetRunTagRequest
+	}{
+		{
+			name:           "KeyAndValueHaveMaxLength",
+			keyMaxLength:   5,
+			valueMaxLength: 10,
+			request: &request.SetRunTagRequest{
+				Key:   strings.Repeat("k", 5),
+				Value: strings.Repeat("v", 10),
+			},
+		},
+		{
+			name:           "LengthIsCountedInCharacters",
+			keyMaxLength:   5,
+			valueMaxLength: 10,
+			request: &request.SetRunTagRequest{
+				Key:   "ключk",
+				Value: "значениеvv",
+			},
+		},
+		{
+			name: "ChecksAreDisabled",
+			request: &request.SetRunTagRequest{
+				Key:   strings.Repeat("k", 500),
+				Value: strings.Repeat("v", 10000),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateSetRunTagLength(tt.request, tt.keyMaxLength, tt.valueMaxLength))
+		})
+	}
+}
+
+func TestValidateSetRunTagLength_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetRunTagRequest
+	}{
+		{
+			name:  "KeyIsTooLong",
+			error: api.NewInvalidParameterValueError("'key' parameter can't be longer than 5 characters"),
+			request: &request.SetRunTagRequest{
+				Key:   strings.Repeat("k", 6),
+				Value: strings.Repeat("v", 10),
+			},
+		},
+		{
+			name:  "ValueIsTooLong",
+			error: api.NewInvalidParameterValueError("'value' parameter can't be longer than 10 characters"),
+			request: &request.SetRunTagRequest{
+				Key:   strings.Repeat("k", 5),
+				Value: strings.Repeat("v", 11),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetRunTagLength(tt.request, 5, 10)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateDeleteRunTagRequest_Ok(t *testing.T) {
 	err := ValidateDeleteRunTagRequest(&request.DeleteRunTagRequest{
 		RunID: "id",
@@ -279,6 +524,99 @@ func TestValidateLogBatchRequest_Ok(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestValidateLogBatchRequest_LimitsOk(t *testing.T) {
+	err := ValidateLogBatchRequest(&request.LogBatchRequest{
+		RunID:   "id",
+		Metrics: newLogBatchMetrics(MaxMetricsPerBatch),
+		Params:  newLogBatchParams(MaxParamsPerBatch),
+		Tags:    newLogBatchTags(MaxTagsPerBatch),
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogBatchRequest_LimitsError(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogBatchRequest
+	}{
+		{
+			name: "TooManyMetrics",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 1000 metrics. Got 1001 metrics. " +
+					"Please split up metrics across multiple requests and try again.",
+			),
+			request: &request.LogBatchRequest{
+				RunID:   "id",
+				Metrics: newLogBatchMetrics(MaxMetricsPerBatch + 1),
+			},
+		},
+		{
+			name: "TooManyParams",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 100 params. Got 101 params. " +
+					"Please split up params across multiple requests and try again.",
+			),
+			request: &request.LogBatchRequest{
+				RunID:  "id",
+				Params: newLogBatchParams(MaxParamsPerBatch + 1),
+			},
+		},
+		{
+			name: "TooManyTags",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 100 tags. Got 101 tags. " +
+					"Please split up tags across multiple requests and try again.",
+			),
+			request: &request.LogBatchRequest{
+				RunID: "id",
+				Tags:  newLogBatchTags(MaxTagsPerBatch + 1),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogBatchRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func newLogBatchMetrics(count int) []request.MetricPartialRequest {
+	metrics := make([]request.MetricPartialRequest, count)
+	for i := range metrics {
+		metrics[i] = request.MetricPartialRequest{
+			Key:       fmt.Sprintf("key%d", i),
+			Value:     1.0,
+			Timestamp: 123456789,
+		}
+	}
+	return metrics
+}
+
+func newLogBatchParams(count int) []request.ParamPartialRequest {
+	params := make([]request.ParamPartialRequest, count)
+	for i := range params {
+		params[i] = request.ParamPartialRequest{
+			Key:      fmt.Sprintf("key%d", i),
+			ValueStr: common.GetPointer("value"),
+		}
+	}
+	return params
+}
+
+func newLogBatchTags(count int) []request.TagPartialRequest {
+	tags := make([]request.TagPartialRequest, count)
+	for i := range tags {
+		tags[i] = request.TagPartialRequest{
+			Key:   fmt.Sprintf("key%d", i),
+			Value: "value",
+		}
+	}
+	return tags
+}
+
 func TestValidateLogBatchRequest_Error(t *testing.T) {
 	testData := []struct {
 		name    string
@@ -26,6 +26,8 @@ func importCmd(cmd *cobra.Command, args []string) error {
 		viper.GetString("input-database-uri"),
 		time.Second*1,
 		20,
+		nil,
+		nil,
 	)
 	if err != nil {
 		return fmt.Errorf("error connecting to input DB: %w", err)
@@ -35,6 +37,8 @@ func importCmd(cmd *cobra.Command, args []string) error {
 		viper.GetString("output-database-uri"),
 		time.Second*1,
 		20,
+		nil,
+		nil,
 	)
 	if err != nil {
 		return fmt.Errorf("error connecting to output DB: %w", err)
@@ -34,14 +34,17 @@ func initCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	level, err := log.ParseLevel(viper.GetString("log-level"))
+	return setLogLevel(viper.GetString("log-level"))
+}
+
+// setLogLevel sets the log level of the standard logger.
+func setLogLevel(levelName string) error {
+	level, err := log.ParseLevel(levelName)
 	if err != nil {
-		return fmt.Errorf(`invalid log level "%s"`, viper.GetString("log-level"))
+		return fmt.Errorf(`invalid log level "%s"`, levelName)
 	}
 	log.SetLevel(level)
-	if log.IsLevelEnabled(log.DebugLevel) {
-		log.SetReportCaller(true)
-	}
+	log.SetReportCaller(log.IsLevelEnabled(log.DebugLevel))
 	return nil
 }
 
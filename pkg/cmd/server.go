@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/server"
 )
@@ -24,6 +25,18 @@ var ServerCmd = &cobra.Command{
 }
 
 func serverCmd(cmd *cobra.Command, args []string) error {
+	// read configuration file, if any. flags and environment variables take precedence over it.
+	if configFile := viper.GetString("config-file"); configFile != "" {
+		viper.SetConfigFile(configFile)
+		if err := viper.ReadInConfig(); err != nil {
+			return fmt.Errorf("error reading configuration file: %v", err)
+		}
+		// the log level could be provided by the configuration file as well.
+		if err := setLogLevel(viper.GetString("log-level")); err != nil {
+			return err
+		}
+	}
+
 	// process config parameters.
 	mlflowConfig := config.NewConfig()
 	if err := mlflowConfig.Validate(); err != nil {
@@ -38,6 +51,22 @@ func serverCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	go func() {
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+		defer signal.Stop(sighup)
+		for {
+			select {
+			case <-sighup:
+				if err := reloadConfig(mlflowConfig); err != nil {
+					log.Errorf("Error reloading configuration: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
 	isRunning := make(chan struct{})
 	go func() {
 		sigint := make(chan os.Signal, 1)
@@ -61,12 +90,44 @@ func serverCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// reloadConfig re-reads the configuration and applies the settings, which could be changed without restart.
+// The other settings keep their startup values.
+func reloadConfig(mlflowConfig *config.Config) error {
+	if viper.ConfigFileUsed() != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			return fmt.Errorf("error reading configuration file: %v", err)
+		}
+	}
+	reloadedConfig := config.NewConfig()
+	if err := reloadedConfig.Validate(); err != nil {
+		return err
+	}
+	reloadableConfig := reloadedConfig.NewReloadableConfig()
+	if err := setLogLevel(reloadableConfig.LogLevel); err != nil {
+		return err
+	}
+	mlflowConfig.Reloadable.Set(reloadableConfig)
+	log.Infof(
+		"Configuration reloaded: log level %s, rate limit %v requests/sec with burst of %d, slow SQL threshold %v",
+		reloadableConfig.LogLevel, reloadableConfig.RateLimitRPS, reloadableConfig.RateLimitBurst,
+		reloadableConfig.DatabaseSlowThreshold,
+	)
+	return nil
+}
+
 // nolint:errcheck,gosec
 func init() {
 	RootCmd.AddCommand(ServerCmd)
 
 	ServerCmd.Flags().StringP("listen-address", "a", "localhost:5000", "Address (host:post) to listen to")
+	ServerCmd.Flags().String(
+		"config-file", "", "Configuration file (YAML, JSON or TOML), log level, rate limits and "+
+			"slow SQL threshold are reloaded from it on SIGHUP",
+	)
 	ServerCmd.Flags().String("default-artifact-root", "./artifacts", "Default artifact root")
+	ServerCmd.Flags().String(
+		"artifact-dedup-dir", "", "Directory to store deduplicated content of local artifacts (dedup is disabled if empty)",
+	)
 	ServerCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
 	ServerCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
 	ServerCmd.Flags().MarkHidden("gs-endpoint-uri")
@@ -79,17 +140,94 @@ func init() {
 	ServerCmd.Flags().String("auth-oidc-scopes", "", "OIDC requested scopes")
 	ServerCmd.Flags().String("auth-oidc-admin-role", "", "OIDC admin role identifier")
 	ServerCmd.Flags().String("auth-oidc-claim-roles", "", "OIDC claim to inspect for roles")
-	ServerCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
+	ServerCmd.Flags().StringP(
+		"database-uri", "d", "sqlite://fasttrackml.db",
+		"Database URI (SQLite pragmas default to journal_mode=WAL, synchronous=NORMAL, busy_timeout=5000 and "+
+			"cache_size=-64000, override them with _journal_mode, _synchronous, _busy_timeout and _cache_size "+
+			"URI parameters)",
+	)
 	ServerCmd.Flags().Int("database-pool-max", 20, "Maximum number of database connections in the pool")
 	ServerCmd.Flags().Duration("database-slow-threshold", 1*time.Second, "Slow SQL warning threshold")
 	ServerCmd.Flags().Bool("database-migrate", true, "Run database migrations")
+	ServerCmd.Flags().Bool(
+		"database-prepared-statements", false, "Enable prepared statement cache (enabled by default for Postgres only)",
+	)
+	ServerCmd.Flags().StringSlice(
+		"database-replica-uri", []string{}, "Read replica database URI used by search endpoints (can be repeated)",
+	)
+	ServerCmd.Flags().Int(
+		"database-retry-max-attempts", 3, "Maximum number of attempts of read queries failing with transient errors",
+	)
+	ServerCmd.Flags().Duration(
+		"database-retry-backoff", 100*time.Millisecond, "Delay before the first read query retry, doubled on every retry",
+	)
 	ServerCmd.Flags().Bool("database-reset", false, "Reinitialize database - WARNING all data will be lost!")
 	ServerCmd.Flags().Bool("live-updates-enabled", false, "Enable 'live updates' in the Aim UI")
 	ServerCmd.Flags().MarkHidden("database-reset")
+	ServerCmd.Flags().Bool(
+		"experiment-auto-create", false, "Create missing experiments when runs are created by experiment name",
+	)
+	ServerCmd.Flags().Bool("metrics-enabled", false, "Expose Prometheus metrics on the /metrics endpoint")
+	ServerCmd.Flags().Int(
+		"metric-history-cache-size", 1000, "Maximum number of cached downsampled metric histories (0 disables the cache)",
+	)
+	ServerCmd.Flags().Int(
+		"metric-history-max-points", 1000000,
+		"Maximum number of metric points a single metric history request can return (0 disables the limit)",
+	)
+	ServerCmd.Flags().String(
+		"namespace-default", models.DefaultNamespaceCode, "Namespace used when the request doesn't provide any",
+	)
+	ServerCmd.Flags().StringSlice(
+		"namespace-resolution-order",
+		config.DefaultNamespaceResolutionOrder,
+		"Order in which the requested namespace is looked up, supported values: header, path, basic-auth",
+	)
+	ServerCmd.Flags().StringSlice(
+		"trusted-proxies",
+		[]string{},
+		"IPs or CIDR ranges of proxies allowed to set X-Forwarded-* and namespace headers (any if empty)",
+	)
 	ServerCmd.Flags().Bool("dev-mode", false, "Development mode - enable CORS")
 	ServerCmd.Flags().MarkHidden("dev-mode")
 	ServerCmd.Flags().Int("log-output-max", 2000, "Maximum log rows per run to retain.")
 	ServerCmd.Flags().Duration("log-output-retention", 7*24*time.Hour, "Run logs retention period")
+	ServerCmd.Flags().Bool("run-name-unique", false, "Reject creation of runs with names already used in the experiment")
+	ServerCmd.Flags().Bool(
+		"run-strict-steps", false, "Reject metrics with decreasing steps for runs tagged with 'fasttrackml.strictSteps'",
+	)
+	ServerCmd.Flags().String(
+		"run-id-format", "uuid", "Format of the generated run ids, supported values: uuid, ulid (time-sortable)",
+	)
+	ServerCmd.Flags().Duration(
+		"purge-deleted-ttl", 0, "Permanently remove experiments and runs deleted longer ago than this (0 disables purging)",
+	)
+	ServerCmd.Flags().Float64(
+		"rate-limit-rps", 0, "Maximum API requests per second per namespace (0 disables rate limiting)",
+	)
+	ServerCmd.Flags().Int("rate-limit-burst", 100, "Maximum API requests burst per namespace")
+	ServerCmd.Flags().Int("search-max-results", 1000, "Default number of results returned by search endpoints")
+	ServerCmd.Flags().Int(
+		"search-max-results-limit", 50000, "Maximum number of results returned by search endpoints (0 disables the limit)",
+	)
+	ServerCmd.Flags().Int(
+		"search-max-query-joins", 50, "Maximum number of joins a single search query may produce (0 disables the limit)",
+	)
+	ServerCmd.Flags().String(
+		"search-timezone",
+		"",
+		"Timezone of search query datetime values without offset, e.g. Europe/London (client timezone if empty)",
+	)
+	ServerCmd.Flags().Int("tag-key-max-length", 250, "Maximum length of experiment and run tag keys (0 disables the check)")
+	ServerCmd.Flags().Int(
+		"tag-value-max-length", 5000, "Maximum length of experiment and run tag values (0 disables the check)",
+	)
+	ServerCmd.Flags().String(
+		"tracing-exporter", "", "OpenTelemetry traces exporter, supported values: otlp (empty disables tracing)",
+	)
+	ServerCmd.Flags().String(
+		"tracing-otlp-endpoint", "", "OTLP traces endpoint URL (defaults to OTEL_EXPORTER_OTLP_ENDPOINT)",
+	)
 	viper.BindEnv("auth-username", "MLFLOW_TRACKING_USERNAME")
 	viper.BindEnv("auth-password", "MLFLOW_TRACKING_PASSWORD")
 }
@@ -33,6 +33,7 @@ const (
 	ErrorCodeEndpointNotFound       = "ENDPOINT_NOT_FOUND"
 	ErrorCodeResourceAlreadyExists  = "RESOURCE_ALREADY_EXISTS"
 	ErrorCodeResourceDoesNotExist   = "RESOURCE_DOES_NOT_EXIST"
+	ErrorCodeRequestLimitExceeded   = "REQUEST_LIMIT_EXCEEDED"
 )
 
 // NewBadRequestError creates new Response object with ErrorCodeBadRequest.
@@ -88,3 +89,12 @@ func NewEndpointNotFound(msg string, args ...any) *ErrorResponse {
 		StatusCode: http.StatusNotFound,
 	}
 }
+
+// NewRequestLimitExceededError creates new Response object with ErrorCodeRequestLimitExceeded.
+func NewRequestLimitExceededError(msg string, args ...any) *ErrorResponse {
+	return &ErrorResponse{
+		Message:    fmt.Sprintf(msg, args...),
+		ErrorCode:  ErrorCodeRequestLimitExceeded,
+		StatusCode: http.StatusTooManyRequests,
+	}
+}
@@ -29,3 +29,50 @@ func (r GetArtifactRequest) GetRunID() string {
 	}
 	return r.RunUUID
 }
+
+// UploadArtifactRequest is a request object for `POST /mlflow/artifacts/upload` endpoint.
+// The artifact content is the request body.
+type UploadArtifactRequest struct {
+	Path    string `query:"path"`
+	RunID   string `query:"run_id"`
+	RunUUID string `query:"run_uuid"`
+}
+
+// GetRunID returns RunID if available, otherwise RunUUID.
+func (r UploadArtifactRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
+// DeleteArtifactRequest is a request object for `POST /mlflow/artifacts/delete` endpoint.
+type DeleteArtifactRequest struct {
+	Path    string `json:"path"`
+	RunID   string `json:"run_id"`
+	RunUUID string `json:"run_uuid"`
+}
+
+// GetRunID returns RunID if available, otherwise RunUUID.
+func (r DeleteArtifactRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
+// StreamRunLogsRequest is a request object for `GET /mlflow/runs/logs/stream` endpoint.
+type StreamRunLogsRequest struct {
+	Path    string `query:"path"`
+	RunID   string `query:"run_id"`
+	RunUUID string `query:"run_uuid"`
+	Follow  bool   `query:"follow"`
+}
+
+// GetRunID returns RunID if available, otherwise RunUUID.
+func (r StreamRunLogsRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
@@ -7,6 +7,7 @@ type FilePartialResponse struct {
 	Path     string `json:"path"`
 	IsDir    bool   `json:"is_dir"`
 	FileSize int64  `json:"file_size"`
+	Hash     string `json:"hash,omitempty"`
 }
 
 // ListArtifactsResponse is a response object for `GET mlflow/artifacts/list` endpoint.
@@ -29,8 +30,25 @@ func NewListArtifactsResponse(
 			Path:     artifact.GetPath(),
 			IsDir:    artifact.IsDirectory(),
 			FileSize: artifact.GetSize(),
+			Hash:     artifact.GetHash(),
 		}
 	}
 
 	return &response
 }
+
+// UploadArtifactResponse is a response object for `POST mlflow/artifacts/upload` endpoint.
+type UploadArtifactResponse struct {
+	File FilePartialResponse `json:"file"`
+}
+
+// NewUploadArtifactResponse creates new instance of UploadArtifactResponse.
+func NewUploadArtifactResponse(path string, size int64, hash string) *UploadArtifactResponse {
+	return &UploadArtifactResponse{
+		File: FilePartialResponse{
+			Path:     path,
+			FileSize: size,
+			Hash:     hash,
+		},
+	}
+}
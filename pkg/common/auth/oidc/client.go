@@ -75,7 +75,13 @@ func (c Client) Verify(ctx context.Context, accessToken string) (*User, error) {
 	if err != nil {
 		return nil, eris.Wrapf(err, "error converting claim %s property", c.config.Auth.AuthOIDCClaimRoles)
 	}
+	// prefer human-readable email over the subject, which is usually an opaque identifier.
+	name := idToken.Subject
+	if email, ok := claims["email"].(string); ok && email != "" {
+		name = email
+	}
 	return &User{
+		name:    name,
 		roles:   roles,
 		isAdmin: slices.Contains(roles, c.config.Auth.AuthOIDCAdminRole),
 	}, nil
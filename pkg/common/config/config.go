@@ -1,13 +1,17 @@
 package config
 
 import (
+	"net"
 	"net/url"
 	"path"
 	"path/filepath"
 	"slices"
 	"time"
+	// embed the timezone database, so the configured search timezone is available in minimal images.
+	_ "time/tzdata"
 
 	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 
 	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
@@ -15,25 +19,83 @@ import (
 
 // Config represents main service configuration.
 type Config struct {
-	Auth                  auth.Config
-	DevMode               bool
-	ListenAddress         string
-	DefaultArtifactRoot   string
-	S3EndpointURI         string
-	GSEndpointURI         string
-	DatabaseURI           string
-	DatabaseReset         bool
-	DatabasePoolMax       int
-	DatabaseMigrate       bool
-	DatabaseSlowThreshold time.Duration
-	LiveUpdatesEnabled    bool
-	RunLogOutputMax       int
-	RunLogOutputRetain    time.Duration
+	Auth                       auth.Config
+	DevMode                    bool
+	ListenAddress              string
+	DefaultArtifactRoot        string
+	ArtifactDedupDir           string
+	S3EndpointURI              string
+	GSEndpointURI              string
+	DatabaseURI                string
+	DatabaseReset              bool
+	DatabasePoolMax            int
+	DatabaseMigrate            bool
+	DatabaseSlowThreshold      time.Duration
+	DatabasePreparedStatements *bool
+	DatabaseReplicaURIs        []string
+	DatabaseRetryMaxAttempts   int
+	DatabaseRetryBackoff       time.Duration
+	ExperimentAutoCreate       bool
+	LiveUpdatesEnabled         bool
+	LogLevel                   string
+	MetricsEnabled             bool
+	MetricHistoryCacheSize     int
+	MetricHistoryMaxPoints     int
+	NamespaceDefault           string
+	NamespaceResolutionOrder   []string
+	PurgeDeletedTTL            time.Duration
+	RunIDFormat                string
+	RunLogOutputMax            int
+	RunNameUnique              bool
+	RunStrictSteps             bool
+	RunLogOutputRetain         time.Duration
+	RateLimitRPS               float64
+	RateLimitBurst             int
+	SearchMaxResults           int
+	SearchMaxResultsLimit      int
+	SearchMaxQueryJoins        int
+	SearchTimezone             string
+	TagKeyMaxLength            int
+	TagValueMaxLength          int
+	TracingExporter            string
+	TracingOTLPEndpoint        string
+	TrustedProxies             []string
+	// Reloadable holds the current values of the settings, which are reloaded without restart.
+	// When it isn't set, the startup values are used.
+	Reloadable *ReloadableConfigHolder
+}
+
+// DefaultSearchMaxResults is the amount of results returned by search endpoints
+// when neither client nor configuration provides any value.
+const DefaultSearchMaxResults = 1000
+
+// TracingExporterOTLP exports traces using OTLP over HTTP.
+const TracingExporterOTLP = "otlp"
+
+// supported formats of the generated run ids.
+const (
+	RunIDFormatUUID = "uuid"
+	RunIDFormatULID = "ulid"
+)
+
+// supported sources of the requested namespace.
+const (
+	NamespaceSourceHeader    = "header"
+	NamespaceSourcePath      = "path"
+	NamespaceSourceBasicAuth = "basic-auth"
+)
+
+// DefaultNamespaceResolutionOrder is the order in which namespace sources are checked
+// when no order was configured. The configured default namespace is always the last resort.
+var DefaultNamespaceResolutionOrder = []string{
+	NamespaceSourceHeader,
+	NamespaceSourcePath,
+	NamespaceSourceBasicAuth,
 }
 
 // NewConfig creates a new instance of Config.
 func NewConfig() *Config {
-	return &Config{
+	config := Config{
 		Auth: auth.Config{
 			AuthUsername:             viper.GetString("auth-username"),
 			AuthPassword:             viper.GetString("auth-password"),
@@ -45,20 +107,53 @@ func NewConfig() *Config {
 			AuthOIDCClientSecret:     viper.GetString("auth-oidc-client-secret"),
 			AuthOIDCProviderEndpoint: viper.GetString("auth-oidc-provider-endpoint"),
 		},
-		DevMode:               viper.GetBool("dev-mode"),
-		ListenAddress:         viper.GetString("listen-address"),
-		DefaultArtifactRoot:   viper.GetString("default-artifact-root"),
-		S3EndpointURI:         viper.GetString("s3-endpoint-uri"),
-		GSEndpointURI:         viper.GetString("gs-endpoint-uri"),
-		DatabaseURI:           viper.GetString("database-uri"),
-		DatabaseReset:         viper.GetBool("database-reset"),
-		DatabasePoolMax:       viper.GetInt("database-pool-max"),
-		DatabaseMigrate:       viper.GetBool("database-migrate"),
-		DatabaseSlowThreshold: viper.GetDuration("database-slow-threshold"),
-		LiveUpdatesEnabled:    viper.GetBool("live-updates-enabled"),
-		RunLogOutputMax:       viper.GetInt("log-output-max"),
-		RunLogOutputRetain:    viper.GetDuration("log-output-retention"),
+		DevMode:                  viper.GetBool("dev-mode"),
+		ListenAddress:            viper.GetString("listen-address"),
+		DefaultArtifactRoot:      viper.GetString("default-artifact-root"),
+		ArtifactDedupDir:         viper.GetString("artifact-dedup-dir"),
+		S3EndpointURI:            viper.GetString("s3-endpoint-uri"),
+		GSEndpointURI:            viper.GetString("gs-endpoint-uri"),
+		DatabaseURI:              viper.GetString("database-uri"),
+		DatabaseReset:            viper.GetBool("database-reset"),
+		DatabasePoolMax:          viper.GetInt("database-pool-max"),
+		DatabaseMigrate:          viper.GetBool("database-migrate"),
+		DatabaseSlowThreshold:    viper.GetDuration("database-slow-threshold"),
+		DatabaseReplicaURIs:      viper.GetStringSlice("database-replica-uri"),
+		DatabaseRetryMaxAttempts: viper.GetInt("database-retry-max-attempts"),
+		DatabaseRetryBackoff:     viper.GetDuration("database-retry-backoff"),
+		ExperimentAutoCreate:     viper.GetBool("experiment-auto-create"),
+		LiveUpdatesEnabled:       viper.GetBool("live-updates-enabled"),
+		LogLevel:                 viper.GetString("log-level"),
+		MetricsEnabled:           viper.GetBool("metrics-enabled"),
+		MetricHistoryCacheSize:   viper.GetInt("metric-history-cache-size"),
+		MetricHistoryMaxPoints:   viper.GetInt("metric-history-max-points"),
+		NamespaceDefault:         viper.GetString("namespace-default"),
+		NamespaceResolutionOrder: viper.GetStringSlice("namespace-resolution-order"),
+		PurgeDeletedTTL:          viper.GetDuration("purge-deleted-ttl"),
+		RunIDFormat:              viper.GetString("run-id-format"),
+		RunLogOutputMax:          viper.GetInt("log-output-max"),
+		RunLogOutputRetain:       viper.GetDuration("log-output-retention"),
+		RunNameUnique:            viper.GetBool("run-name-unique"),
+		RunStrictSteps:           viper.GetBool("run-strict-steps"),
+		RateLimitRPS:             viper.GetFloat64("rate-limit-rps"),
+		RateLimitBurst:           viper.GetInt("rate-limit-burst"),
+		SearchMaxResults:         viper.GetInt("search-max-results"),
+		SearchMaxResultsLimit:    viper.GetInt("search-max-results-limit"),
+		SearchMaxQueryJoins:      viper.GetInt("search-max-query-joins"),
+		SearchTimezone:           viper.GetString("search-timezone"),
+		TagKeyMaxLength:          viper.GetInt("tag-key-max-length"),
+		TagValueMaxLength:        viper.GetInt("tag-value-max-length"),
+		TracingExporter:          viper.GetString("tracing-exporter"),
+		TracingOTLPEndpoint:      viper.GetString("tracing-otlp-endpoint"),
+		TrustedProxies:           viper.GetStringSlice("trusted-proxies"),
+	}
+	// prepared statements default depends on the database, so the value is only set when the flag is provided.
+	if viper.IsSet("database-prepared-statements") {
+		preparedStatements := viper.GetBool("database-prepared-statements")
+		config.DatabasePreparedStatements = &preparedStatements
 	}
+	config.Reloadable = NewReloadableConfigHolder(config.NewReloadableConfig())
+	return &config
 }
 
 // Validate validates service configuration.
@@ -92,6 +187,96 @@ func (c *Config) validateConfiguration() error {
 		return eris.Wrap(err, "error validating auth configuration")
 	}
 
+	// 2. validate rate limit configuration parameters.
+	if c.RateLimitRPS < 0 {
+		return eris.New("'rate-limit-rps' flag has to be a non-negative number")
+	}
+	if c.RateLimitRPS > 0 && c.RateLimitBurst < 1 {
+		return eris.New("'rate-limit-burst' flag has to be greater than 0 when rate limiting is enabled")
+	}
+
+	// 3. validate search limit configuration parameters.
+	if c.SearchMaxResults < 0 {
+		return eris.New("'search-max-results' flag has to be a non-negative number")
+	}
+	if c.SearchMaxResultsLimit < 0 {
+		return eris.New("'search-max-results-limit' flag has to be a non-negative number")
+	}
+	if c.SearchMaxResultsLimit > 0 && c.SearchMaxResults > c.SearchMaxResultsLimit {
+		return eris.New("'search-max-results' flag can't be greater than 'search-max-results-limit' flag")
+	}
+	if c.SearchMaxQueryJoins < 0 {
+		return eris.New("'search-max-query-joins' flag has to be a non-negative number")
+	}
+	if _, err := time.LoadLocation(c.SearchTimezone); err != nil {
+		return eris.Errorf("unsupported value of 'search-timezone' flag: %s", c.SearchTimezone)
+	}
+
+	// 4. validate metric history configuration parameters.
+	if c.MetricHistoryCacheSize < 0 {
+		return eris.New("'metric-history-cache-size' flag has to be a non-negative number")
+	}
+	if c.MetricHistoryMaxPoints < 0 {
+		return eris.New("'metric-history-max-points' flag has to be a non-negative number")
+	}
+
+	// 5. validate purge configuration parameters.
+	if c.PurgeDeletedTTL < 0 {
+		return eris.New("'purge-deleted-ttl' flag has to be a non-negative duration")
+	}
+
+	// 6. validate namespace resolution configuration parameters.
+	for i, source := range c.NamespaceResolutionOrder {
+		if !slices.Contains(DefaultNamespaceResolutionOrder, source) {
+			return eris.Errorf("unsupported value of 'namespace-resolution-order' flag: %s", source)
+		}
+		if slices.Contains(c.NamespaceResolutionOrder[:i], source) {
+			return eris.Errorf("duplicate value of 'namespace-resolution-order' flag: %s", source)
+		}
+	}
+
+	// 7. validate tag configuration parameters.
+	if c.TagKeyMaxLength < 0 {
+		return eris.New("'tag-key-max-length' flag has to be a non-negative number")
+	}
+	if c.TagValueMaxLength < 0 {
+		return eris.New("'tag-value-max-length' flag has to be a non-negative number")
+	}
+
+	// 8. validate tracing configuration parameters.
+	if !slices.Contains([]string{"", TracingExporterOTLP}, c.TracingExporter) {
+		return eris.Errorf("unsupported value of 'tracing-exporter' flag: %s", c.TracingExporter)
+	}
+
+	// 9. validate trusted proxy configuration parameters.
+	for _, proxy := range c.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return eris.Errorf("unsupported value of 'trusted-proxies' flag: %s", proxy)
+			}
+		}
+	}
+
+	// 10. validate log level configuration parameter.
+	if c.LogLevel != "" {
+		if _, err := log.ParseLevel(c.LogLevel); err != nil {
+			return eris.Errorf("unsupported value of 'log-level' flag: %s", c.LogLevel)
+		}
+	}
+
+	// 11. validate database retry configuration parameters.
+	if c.DatabaseRetryMaxAttempts < 0 {
+		return eris.New("'database-retry-max-attempts' flag has to be a non-negative number")
+	}
+	if c.DatabaseRetryBackoff < 0 {
+		return eris.New("'database-retry-backoff' flag has to be a non-negative duration")
+	}
+
+	// 12. validate run id format configuration parameter.
+	if !slices.Contains([]string{"", RunIDFormatUUID, RunIDFormatULID}, c.RunIDFormat) {
+		return eris.Errorf("unsupported value of 'run-id-format' flag: %s", c.RunIDFormat)
+	}
+
 	return nil
 }
 
@@ -116,3 +301,71 @@ func (c *Config) normalizeConfiguration() error {
 
 	return nil
 }
+
+// IsTracingEnabled makes check that traces have to be exported.
+func (c *Config) IsTracingEnabled() bool {
+	return c.TracingExporter != ""
+}
+
+// GetNamespaceResolutionOrder returns the order in which namespace sources have to be checked.
+func (c *Config) GetNamespaceResolutionOrder() []string {
+	if len(c.NamespaceResolutionOrder) == 0 {
+		return DefaultNamespaceResolutionOrder
+	}
+	return c.NamespaceResolutionOrder
+}
+
+// IsTrustedProxyCheckEnabled makes check that forwarded and namespace headers have to be honored
+// only when the request comes from one of the trusted proxies.
+func (c *Config) IsTrustedProxyCheckEnabled() bool {
+	return len(c.TrustedProxies) > 0
+}
+
+// IsRateLimitEnabled makes check that per-namespace rate limiting is enabled.
+func (c *Config) IsRateLimitEnabled() bool {
+	return c.RateLimitRPS > 0
+}
+
+// NewReloadableConfig creates ReloadableConfig from the configuration values.
+func (c *Config) NewReloadableConfig() *ReloadableConfig {
+	return &ReloadableConfig{
+		LogLevel:              c.LogLevel,
+		RateLimitRPS:          c.RateLimitRPS,
+		RateLimitBurst:        c.RateLimitBurst,
+		DatabaseSlowThreshold: c.DatabaseSlowThreshold,
+	}
+}
+
+// GetReloadableConfig returns the current values of the settings, which are reloaded without restart.
+func (c *Config) GetReloadableConfig() *ReloadableConfig {
+	if c.Reloadable == nil {
+		return c.NewReloadableConfig()
+	}
+	return c.Reloadable.Get()
+}
+
+// IsPurgeDeletedEnabled makes check that deleted experiments and runs have to be purged.
+func (c *Config) IsPurgeDeletedEnabled() bool {
+	return c.PurgeDeletedTTL > 0
+}
+
+// GetSearchMaxResults returns the amount of results search endpoints have to return for the requested amount.
+// When nothing was requested the configured default is used, and the result never exceeds the hard cap.
+func (c *Config) GetSearchMaxResults(requested int) int {
+	if requested <= 0 {
+		requested = c.SearchMaxResults
+		if requested <= 0 {
+			requested = DefaultSearchMaxResults
+		}
+	}
+	return c.LimitSearchMaxResults(requested)
+}
+
+// LimitSearchMaxResults clamps the requested amount of results to the hard cap.
+// Zero means an unbounded request, so it is clamped to the hard cap as well.
+func (c *Config) LimitSearchMaxResults(requested int) int {
+	if c.SearchMaxResultsLimit > 0 && (requested <= 0 || requested > c.SearchMaxResultsLimit) {
+		return c.SearchMaxResultsLimit
+	}
+	return requested
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/stretchr/testify/assert"
@@ -103,6 +104,186 @@ func TestConfig_Validate_Error(t *testing.T) {
 				DefaultArtifactRoot: "unsupported://something",
 			},
 		},
+		{
+			name: "RateLimitRPSIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'rate-limit-rps' flag has to be a non-negative number",
+			),
+			config: &Config{
+				RateLimitRPS: -1,
+			},
+		},
+		{
+			name: "RateLimitBurstIsZero",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'rate-limit-burst' flag has to be greater than 0 when rate limiting is enabled",
+			),
+			config: &Config{
+				RateLimitRPS:   10,
+				RateLimitBurst: 0,
+			},
+		},
+		{
+			name: "SearchMaxResultsIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'search-max-results' flag has to be a non-negative number",
+			),
+			config: &Config{
+				SearchMaxResults: -1,
+			},
+		},
+		{
+			name: "SearchMaxResultsIsGreaterThanLimit",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'search-max-results' flag can't be greater than 'search-max-results-limit' flag",
+			),
+			config: &Config{
+				SearchMaxResults:      100,
+				SearchMaxResultsLimit: 10,
+			},
+		},
+		{
+			name: "SearchMaxQueryJoinsIsNegative",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'search-max-query-joins' flag has to be a non-negative number",
+			),
+			config: &Config{
+				SearchMaxQueryJoins: -1,
+			},
+		},
+		{
+			name: "SearchTimezoneIsUnknown",
+			error: eris.New(
+				"error validating service configuration: " +
+					"unsupported value of 'search-timezone' flag: Mars/Olympus",
+			),
+			config: &Config{
+				SearchTimezone: "Mars/Olympus",
+			},
+		},
+		{
+			name: "MetricHistoryCacheSizeIsNegative",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'metric-history-cache-size' flag has to be a non-negative number",
+			),
+			config: &Config{
+				MetricHistoryCacheSize: -1,
+			},
+		},
+		{
+			name: "MetricHistoryMaxPointsIsNegative",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'metric-history-max-points' flag has to be a non-negative number",
+			),
+			config: &Config{
+				MetricHistoryMaxPoints: -1,
+			},
+		},
+		{
+			name: "PurgeDeletedTTLIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'purge-deleted-ttl' flag has to be a non-negative duration",
+			),
+			config: &Config{
+				PurgeDeletedTTL: -time.Hour,
+			},
+		},
+		{
+			name: "NamespaceResolutionOrderIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: unsupported value of 'namespace-resolution-order' flag: cookie",
+			),
+			config: &Config{
+				NamespaceResolutionOrder: []string{"header", "cookie"},
+			},
+		},
+		{
+			name: "NamespaceResolutionOrderHasDuplicates",
+			error: eris.New(
+				"error validating service configuration: duplicate value of 'namespace-resolution-order' flag: path",
+			),
+			config: &Config{
+				NamespaceResolutionOrder: []string{"path", "header", "path"},
+			},
+		},
+		{
+			name: "TrustedProxyIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: unsupported value of 'trusted-proxies' flag: proxy.local",
+			),
+			config: &Config{
+				TrustedProxies: []string{"10.0.0.1", "10.1.0.0/16", "proxy.local"},
+			},
+		},
+		{
+			name: "LogLevelIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: unsupported value of 'log-level' flag: verbose",
+			),
+			config: &Config{
+				LogLevel: "verbose",
+			},
+		},
+		{
+			name: "DatabaseRetryMaxAttemptsIsNegative",
+			error: eris.New(
+				"error validating service configuration: " +
+					"'database-retry-max-attempts' flag has to be a non-negative number",
+			),
+			config: &Config{
+				DatabaseRetryMaxAttempts: -1,
+			},
+		},
+		{
+			name: "DatabaseRetryBackoffIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'database-retry-backoff' flag has to be a non-negative duration",
+			),
+			config: &Config{
+				DatabaseRetryBackoff: -time.Second,
+			},
+		},
+		{
+			name: "TagKeyMaxLengthIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'tag-key-max-length' flag has to be a non-negative number",
+			),
+			config: &Config{
+				TagKeyMaxLength: -1,
+			},
+		},
+		{
+			name: "TagValueMaxLengthIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'tag-value-max-length' flag has to be a non-negative number",
+			),
+			config: &Config{
+				TagValueMaxLength: -1,
+			},
+		},
+		{
+			name: "TracingExporterIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: unsupported value of 'tracing-exporter' flag: zipkin",
+			),
+			config: &Config{
+				TracingExporter: "zipkin",
+			},
+		},
+		{
+			name: "RunIDFormatIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: unsupported value of 'run-id-format' flag: uuid7",
+			),
+			config: &Config{
+				RunIDFormat: "uuid7",
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -111,3 +292,89 @@ func TestConfig_Validate_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_GetSearchMaxResults_Ok(t *testing.T) {
+	testData := []struct {
+		name      string
+		config    *Config
+		requested int
+		expected  int
+	}{
+		{
+			name:      "NothingRequestedAndNothingConfigured",
+			config:    &Config{},
+			requested: 0,
+			expected:  DefaultSearchMaxResults,
+		},
+		{
+			name:      "NothingRequestedUsesConfiguredDefault",
+			config:    &Config{SearchMaxResults: 50, SearchMaxResultsLimit: 100},
+			requested: 0,
+			expected:  50,
+		},
+		{
+			name:      "RequestedBelowLimit",
+			config:    &Config{SearchMaxResults: 50, SearchMaxResultsLimit: 100},
+			requested: 70,
+			expected:  70,
+		},
+		{
+			name:      "RequestedAboveLimitIsClamped",
+			config:    &Config{SearchMaxResults: 50, SearchMaxResultsLimit: 100},
+			requested: 1000,
+			expected:  100,
+		},
+		{
+			name:      "RequestedWithoutLimit",
+			config:    &Config{SearchMaxResults: 50},
+			requested: 1000000,
+			expected:  1000000,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.GetSearchMaxResults(tt.requested))
+		})
+	}
+}
+
+func TestConfig_LimitSearchMaxResults_Ok(t *testing.T) {
+	testData := []struct {
+		name      string
+		config    *Config
+		requested int
+		expected  int
+	}{
+		{
+			name:      "UnboundedRequestWithoutLimit",
+			config:    &Config{},
+			requested: 0,
+			expected:  0,
+		},
+		{
+			name:      "UnboundedRequestIsClamped",
+			config:    &Config{SearchMaxResultsLimit: 100},
+			requested: 0,
+			expected:  100,
+		},
+		{
+			name:      "RequestedBelowLimit",
+			config:    &Config{SearchMaxResultsLimit: 100},
+			requested: 45,
+			expected:  45,
+		},
+		{
+			name:      "RequestedAboveLimitIsClamped",
+			config:    &Config{SearchMaxResultsLimit: 100},
+			requested: 500,
+			expected:  100,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.LimitSearchMaxResults(tt.requested))
+		})
+	}
+}
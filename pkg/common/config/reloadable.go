@@ -0,0 +1,53 @@
+package config
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ReloadableConfig represents the part of service configuration, which could be changed without restart.
+type ReloadableConfig struct {
+	LogLevel              string
+	RateLimitRPS          float64
+	RateLimitBurst        int
+	DatabaseSlowThreshold time.Duration
+}
+
+// ReloadableConfigHolder holds the current ReloadableConfig. It is safe for concurrent use,
+// so the configuration could be replaced while requests are being served.
+type ReloadableConfigHolder struct {
+	value     atomic.Pointer[ReloadableConfig]
+	mutex     sync.Mutex
+	listeners []func(*ReloadableConfig)
+}
+
+// NewReloadableConfigHolder creates new holder of ReloadableConfig with provided initial configuration.
+func NewReloadableConfigHolder(config *ReloadableConfig) *ReloadableConfigHolder {
+	holder := ReloadableConfigHolder{}
+	holder.value.Store(config)
+	return &holder
+}
+
+// Get returns the current configuration.
+func (h *ReloadableConfigHolder) Get() *ReloadableConfig {
+	return h.value.Load()
+}
+
+// Set replaces the current configuration and notifies the listeners about the change.
+func (h *ReloadableConfigHolder) Set(config *ReloadableConfig) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.value.Store(config)
+	for _, listener := range h.listeners {
+		listener(config)
+	}
+}
+
+// OnChange registers listener, which is called every time the configuration is replaced.
+// It is meant for the components, which can't read the configuration on every use.
+func (h *ReloadableConfigHolder) OnChange(listener func(*ReloadableConfig)) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.listeners = append(h.listeners, listener)
+}
@@ -1,9 +1,14 @@
 package models
 
-import "fmt"
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
 
 // BasicAuthToken represents object to store auth information related to Basic Auth.
 type BasicAuthToken struct {
+	name  string
 	roles map[string]struct{}
 }
 
@@ -23,6 +28,22 @@ func (p BasicAuthToken) HasUserAccess(namespace string) bool {
 	return true
 }
 
+// GetNamespaces returns codes of the namespaces the user has direct access to.
+func (p BasicAuthToken) GetNamespaces() []string {
+	var namespaces []string
+	for role := range p.roles {
+		if namespace, ok := strings.CutPrefix(role, "ns:"); ok {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// GetName returns name of the User current Auth token belongs to.
+func (p BasicAuthToken) GetName() string {
+	return p.name
+}
+
 // GetRoles returns User roles assigned to current Auth token.
 func (p BasicAuthToken) GetRoles() map[string]struct{} {
 	return p.roles
@@ -56,7 +77,11 @@ func (p UserPermissions) ValidateAuthToken(authToken string) *BasicAuthToken {
 		return nil
 	}
 
+	// the token is a known one, so it is a valid base64 encoded `name:password` pair.
+	login, _ := base64.StdEncoding.DecodeString(authToken)
+	name, _, _ := strings.Cut(string(login), ":")
 	return &BasicAuthToken{
+		name:  name,
 		roles: roles,
 	}
 }
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// SearchRunsFilter is a hook invoked by the runs, metrics and artifacts search, which allows to append
+// additional constraints on the `runs` table based on the request context, e.g. to hide runs the caller
+// is not allowed to see.
+type SearchRunsFilter interface {
+	Filter(ctx context.Context, tx *gorm.DB) *gorm.DB
+}
+
+// SearchRunsFilterFunc is an adapter to use ordinary functions as SearchRunsFilter.
+type SearchRunsFilterFunc func(ctx context.Context, tx *gorm.DB) *gorm.DB
+
+// Filter calls f(ctx, tx).
+func (f SearchRunsFilterFunc) Filter(ctx context.Context, tx *gorm.DB) *gorm.DB {
+	return f(ctx, tx)
+}
+
+// NoopSearchRunsFilter is a default SearchRunsFilter, which doesn't add any constraints.
+type NoopSearchRunsFilter struct{}
+
+// Filter returns search query as is.
+func (f NoopSearchRunsFilter) Filter(_ context.Context, tx *gorm.DB) *gorm.DB {
+	return tx
+}
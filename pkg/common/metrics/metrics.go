@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// namespace is the prefix of all the exposed metrics.
+const namespace = "fasttrackml"
+
+// Metrics represents Prometheus registry with API level collectors.
+type Metrics struct {
+	registry        *prometheus.Registry
+	requestsTotal   *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+}
+
+// NewMetrics creates new Metrics instance with its own registry, so several servers could live in one process.
+func NewMetrics(db database.DBProvider) (*Metrics, error) {
+	metrics := Metrics{
+		registry: prometheus.NewRegistry(),
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Total number of HTTP requests by route, method, status and namespace.",
+		}, []string{"route", "method", "status", "namespace"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of HTTP requests by route, method, status and namespace.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method", "status", "namespace"}),
+	}
+
+	sqlDB, err := db.GormDB().DB()
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting database connection")
+	}
+
+	for _, collector := range []prometheus.Collector{
+		metrics.requestsTotal,
+		metrics.requestDuration,
+		collectors.NewDBStatsCollector(sqlDB, db.GormDB().Dialector.Name()),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	} {
+		if err := metrics.registry.Register(collector); err != nil {
+			return nil, eris.Wrap(err, "error registering metrics collector")
+		}
+	}
+	return &metrics, nil
+}
+
+// ObserveRequest records the request in the request counter and latency histogram.
+func (m Metrics) ObserveRequest(route, method, status, namespace string, seconds float64) {
+	m.requestsTotal.WithLabelValues(route, method, status, namespace).Inc()
+	m.requestDuration.WithLabelValues(route, method, status, namespace).Observe(seconds)
+}
+
+// Handler returns handler which exposes metrics in Prometheus format.
+func (m Metrics) Handler() fiber.Handler {
+	return adaptor.HTTPHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+)
+
+// nolint:gosec
+const (
+	actorContextKey = "actor"
+)
+
+// GetActorFromContext returns name of the authenticated user, who makes the request.
+// Empty string is returned when authentication is disabled.
+func GetActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey).(string); ok {
+		return actor
+	}
+	// single user Basic Auth keeps the name under its own key.
+	if actor, ok := ctx.Value(basicauth.ConfigDefault.ContextUsername).(string); ok {
+		return actor
+	}
+	return ""
+}
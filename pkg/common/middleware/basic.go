@@ -50,6 +50,7 @@ func (m BasicAuthMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx, authToke
 	if authToken == nil || !authToken.HasAdminAccess() {
 		return ctx.Redirect("/errors/not-found", http.StatusMovedPermanently)
 	}
+	ctx.Locals(actorContextKey, authToken.GetName())
 	return ctx.Next()
 }
 
@@ -95,6 +96,7 @@ func (m BasicAuthMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx, auth
 			api.NewResourceDoesNotExistError("unable to find namespace with code: %s", namespace.Code),
 		)
 	}
+	ctx.Locals(actorContextKey, authToken.GetName())
 	return ctx.Next()
 }
 
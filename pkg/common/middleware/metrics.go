@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/metrics"
+)
+
+// MetricsMiddleware represents middleware which records request metrics.
+type MetricsMiddleware struct {
+	metrics *metrics.Metrics
+}
+
+// NewMetricsMiddleware creates new middleware which records request count and latency
+// labeled by route, method, status and namespace.
+func NewMetricsMiddleware(metrics *metrics.Metrics) fiber.Handler {
+	return MetricsMiddleware{
+		metrics: metrics,
+	}.Handle()
+}
+
+// Handle handles metrics middleware logic.
+func (m MetricsMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		start := time.Now()
+		if err := ctx.Next(); err != nil {
+			// the error has to be rendered right away, otherwise the final status code is unknown.
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				//nolint:errcheck,gosec
+				ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		namespaceCode := ""
+		if namespace, err := GetNamespaceFromContext(ctx.Context()); err == nil && namespace != nil {
+			namespaceCode = namespace.Code
+		}
+		m.metrics.ObserveRequest(
+			ctx.Route().Path,
+			ctx.Method(),
+			strconv.Itoa(ctx.Response().StatusCode()),
+			namespaceCode,
+			time.Since(start).Seconds(),
+		)
+		return nil
+	}
+}
@@ -14,23 +14,94 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	commonModels "github.com/G-Research/fasttrackml/pkg/common/dao/models"
 )
 
 const (
 	namespaceContextKey = "namespace"
 )
 
+// NamespaceHeader is a request header which can be used to select the namespace.
+const NamespaceHeader = "X-Fasttrackml-Namespace"
+
 var namespaceRegexp = regexp.MustCompile(`^/ns/([^/]+)/`)
 
+// NamespaceResolver resolves the namespace requested by the client.
+type NamespaceResolver struct {
+	order           []string
+	defaultCode     string
+	userPermissions *commonModels.UserPermissions
+}
+
+// NewNamespaceResolver creates new NamespaceResolver instance.
+func NewNamespaceResolver(config *config.Config) *NamespaceResolver {
+	defaultCode := config.NamespaceDefault
+	if defaultCode == "" {
+		defaultCode = models.DefaultNamespaceCode
+	}
+	return &NamespaceResolver{
+		order:           config.GetNamespaceResolutionOrder(),
+		defaultCode:     defaultCode,
+		userPermissions: config.Auth.AuthParsedUserPermissions,
+	}
+}
+
+// ResolveNamespace returns code of the requested namespace. Sources are checked in the configured
+// order, by default: the `X-Fasttrackml-Namespace` header, the `/ns/:code/` path prefix and the only
+// namespace the Basic Auth user has access to. The configured default namespace is used as the last resort.
+// The header is ignored when trusted proxies are configured and the request didn't come from one of them.
+func (r NamespaceResolver) ResolveNamespace(ctx *fiber.Ctx) string {
+	for _, source := range r.order {
+		var namespaceCode string
+		switch source {
+		case config.NamespaceSourceHeader:
+			if ctx.IsProxyTrusted() {
+				namespaceCode = ctx.Get(NamespaceHeader)
+			}
+		case config.NamespaceSourcePath:
+			if matches := namespaceRegexp.FindStringSubmatch(ctx.Path()); matches != nil {
+				namespaceCode = matches[1]
+			}
+		case config.NamespaceSourceBasicAuth:
+			namespaceCode = r.getBasicAuthNamespace(ctx)
+		}
+		if namespaceCode != "" {
+			return strings.Clone(namespaceCode)
+		}
+	}
+	return r.defaultCode
+}
+
+// getBasicAuthNamespace returns the namespace of the Basic Auth user, if the user has access to exactly one.
+func (r NamespaceResolver) getBasicAuthNamespace(ctx *fiber.Ctx) string {
+	if r.userPermissions == nil {
+		return ""
+	}
+	token, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Basic ")
+	if !ok {
+		return ""
+	}
+	authToken := r.userPermissions.ValidateAuthToken(token)
+	if authToken == nil {
+		return ""
+	}
+	if namespaces := authToken.GetNamespaces(); len(namespaces) == 1 {
+		return namespaces[0]
+	}
+	return ""
+}
+
 // NewNamespaceMiddleware creates new Middleware instance.
-func NewNamespaceMiddleware(namespaceRepository repositories.NamespaceRepositoryProvider) fiber.Handler {
+func NewNamespaceMiddleware(
+	namespaceRepository repositories.NamespaceRepositoryProvider, namespaceResolver *NamespaceResolver,
+) fiber.Handler {
 	return func(ctx *fiber.Ctx) (err error) {
 		log.Debugf("checking namespace for path: %s", ctx.Path())
-		// if namespace exists in the request, then try to process it, otherwise fallback to default namespace.
-		namespaceCode := models.DefaultNamespaceCode
+		namespaceCode := namespaceResolver.ResolveNamespace(ctx)
+		// namespace prefix has to be removed from the path for routing, even if the namespace came from another source.
 		if matches := namespaceRegexp.FindStringSubmatch(ctx.Path()); matches != nil {
-			namespaceCode = strings.Clone(matches[1])
-			ctx.Path(strings.TrimPrefix(ctx.Path(), fmt.Sprintf("/ns/%s", namespaceCode)))
+			ctx.Path(strings.TrimPrefix(ctx.Path(), fmt.Sprintf("/ns/%s", matches[1])))
 		}
 		namespace, err := namespaceRepository.GetByCode(ctx.Context(), namespaceCode)
 		if err != nil {
@@ -0,0 +1,212 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/models"
+)
+
+func TestNamespaceResolver_ResolveNamespace(t *testing.T) {
+	userPermissions := models.NewUserPermissions(map[string]map[string]struct{}{
+		"single": {"ns:basic": {}},
+		"multi":  {"ns:basic": {}, "ns:other": {}},
+		"admin":  {"admin": {}},
+	})
+	testData := []struct {
+		name              string
+		config            *config.Config
+		path              string
+		headers           map[string]string
+		expectedNamespace string
+	}{
+		{
+			name:              "NoSourceFallsBackToDefault",
+			config:            &config.Config{},
+			path:              "/aim/api/runs",
+			expectedNamespace: "default",
+		},
+		{
+			name:              "NoSourceFallsBackToConfiguredDefault",
+			config:            &config.Config{NamespaceDefault: "configured"},
+			path:              "/aim/api/runs",
+			expectedNamespace: "configured",
+		},
+		{
+			name:              "Header",
+			config:            &config.Config{},
+			path:              "/aim/api/runs",
+			headers:           map[string]string{NamespaceHeader: "header"},
+			expectedNamespace: "header",
+		},
+		{
+			name:              "Path",
+			config:            &config.Config{},
+			path:              "/ns/path/aim/api/runs",
+			expectedNamespace: "path",
+		},
+		{
+			name: "BasicAuth",
+			config: &config.Config{
+				Auth: auth.Config{AuthParsedUserPermissions: userPermissions},
+			},
+			path:              "/aim/api/runs",
+			headers:           map[string]string{fiber.HeaderAuthorization: "Basic single"},
+			expectedNamespace: "basic",
+		},
+		{
+			name: "BasicAuthWithSeveralNamespacesFallsBackToDefault",
+			config: &config.Config{
+				Auth: auth.Config{AuthParsedUserPermissions: userPermissions},
+			},
+			path:              "/aim/api/runs",
+			headers:           map[string]string{fiber.HeaderAuthorization: "Basic multi"},
+			expectedNamespace: "default",
+		},
+		{
+			name: "BasicAuthWithoutNamespacesFallsBackToDefault",
+			config: &config.Config{
+				Auth: auth.Config{AuthParsedUserPermissions: userPermissions},
+			},
+			path:              "/aim/api/runs",
+			headers:           map[string]string{fiber.HeaderAuthorization: "Basic admin"},
+			expectedNamespace: "default",
+		},
+		{
+			name:              "BasicAuthIsIgnoredWithoutUserPermissions",
+			config:            &config.Config{},
+			path:              "/aim/api/runs",
+			headers:           map[string]string{fiber.HeaderAuthorization: "Basic single"},
+			expectedNamespace: "default",
+		},
+		{
+			name:              "HeaderWinsOverPath",
+			config:            &config.Config{},
+			path:              "/ns/path/aim/api/runs",
+			headers:           map[string]string{NamespaceHeader: "header"},
+			expectedNamespace: "header",
+		},
+		{
+			name: "PathWinsOverBasicAuth",
+			config: &config.Config{
+				Auth: auth.Config{AuthParsedUserPermissions: userPermissions},
+			},
+			path:              "/ns/path/aim/api/runs",
+			headers:           map[string]string{fiber.HeaderAuthorization: "Basic single"},
+			expectedNamespace: "path",
+		},
+		{
+			name: "ConfiguredOrderPathWinsOverHeader",
+			config: &config.Config{
+				NamespaceResolutionOrder: []string{config.NamespaceSourcePath, config.NamespaceSourceHeader},
+			},
+			path:              "/ns/path/aim/api/runs",
+			headers:           map[string]string{NamespaceHeader: "header"},
+			expectedNamespace: "path",
+		},
+		{
+			name: "ConfiguredOrderBasicAuthWinsOverHeader",
+			config: &config.Config{
+				Auth:                     auth.Config{AuthParsedUserPermissions: userPermissions},
+				NamespaceResolutionOrder: []string{config.NamespaceSourceBasicAuth, config.NamespaceSourceHeader},
+			},
+			path: "/aim/api/runs",
+			headers: map[string]string{
+				NamespaceHeader:           "header",
+				fiber.HeaderAuthorization: "Basic single",
+			},
+			expectedNamespace: "basic",
+		},
+		{
+			name: "ConfiguredOrderSkipsMissingSources",
+			config: &config.Config{
+				NamespaceDefault:         "configured",
+				NamespaceResolutionOrder: []string{config.NamespaceSourceHeader},
+			},
+			path:              "/ns/path/aim/api/runs",
+			expectedNamespace: "configured",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewNamespaceResolver(tt.config)
+			app := fiber.New()
+			app.Use(func(ctx *fiber.Ctx) error {
+				return ctx.SendString(resolver.ResolveNamespace(ctx))
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			resp, err := app.Test(req)
+			require.Nil(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expectedNamespace, string(body))
+		})
+	}
+}
+
+func TestNamespaceResolver_ResolveNamespace_TrustedProxies(t *testing.T) {
+	testData := []struct {
+		name              string
+		trustedProxies    []string
+		expectedNamespace string
+		expectedIP        string
+	}{
+		{
+			name:              "RequestFromTrustedProxy",
+			trustedProxies:    []string{"0.0.0.0"},
+			expectedNamespace: "header",
+			expectedIP:        "10.0.0.1",
+		},
+		{
+			name:              "RequestFromTrustedProxyRange",
+			trustedProxies:    []string{"0.0.0.0/8"},
+			expectedNamespace: "header",
+			expectedIP:        "10.0.0.1",
+		},
+		{
+			name:              "RequestFromUntrustedProxy",
+			trustedProxies:    []string{"192.168.0.1"},
+			expectedNamespace: "default",
+			expectedIP:        "0.0.0.0",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := NewNamespaceResolver(&config.Config{})
+			// requests made by app.Test() always come from 0.0.0.0.
+			app := fiber.New(fiber.Config{
+				EnableTrustedProxyCheck: true,
+				TrustedProxies:          tt.trustedProxies,
+				ProxyHeader:             fiber.HeaderXForwardedFor,
+			})
+			app.Use(func(ctx *fiber.Ctx) error {
+				return ctx.JSON(fiber.Map{"namespace": resolver.ResolveNamespace(ctx), "ip": ctx.IP()})
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/aim/api/runs", nil)
+			req.Header.Set(NamespaceHeader, "header")
+			req.Header.Set(fiber.HeaderXForwardedFor, "10.0.0.1")
+			resp, err := app.Test(req)
+			require.Nil(t, err)
+			body, err := io.ReadAll(resp.Body)
+			require.Nil(t, err)
+			assert.JSONEq(
+				t, fmt.Sprintf(`{"namespace": %q, "ip": %q}`, tt.expectedNamespace, tt.expectedIP), string(body),
+			)
+		})
+	}
+}
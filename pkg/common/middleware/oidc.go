@@ -69,6 +69,7 @@ func (m OIDCMiddleware) handleAdminResourceRequest(ctx *fiber.Ctx) error {
 	if !user.IsAdmin() {
 		return ctx.Redirect("/errors/not-found", http.StatusMovedPermanently)
 	}
+	ctx.Locals(actorContextKey, user.GetName())
 	return ctx.Next()
 }
 
@@ -107,6 +108,7 @@ func (m OIDCMiddleware) handleAimMlflowResourceRequest(ctx *fiber.Ctx) error {
 		)
 	}
 	log.Debugf("user has roles: %v associated", user.GetRoles())
+	ctx.Locals(actorContextKey, user.GetName())
 
 	if user.IsAdmin() {
 		return ctx.Next()
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+// RateLimitMiddleware represents namespace-aware rate limit middleware.
+type RateLimitMiddleware struct {
+	config   *config.Config
+	mutex    *sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimitMiddleware creates new namespace-aware rate limit middleware logic.
+// Each namespace gets its own token bucket refilled with `rate-limit-rps` tokens and holding up to
+// `rate-limit-burst` tokens. Both values are read on every request, so they could be reloaded without restart.
+func NewRateLimitMiddleware(config *config.Config) fiber.Handler {
+	return RateLimitMiddleware{
+		config:   config,
+		mutex:    &sync.Mutex{},
+		limiters: make(map[string]*rate.Limiter),
+	}.Handle()
+}
+
+// Handle handles rate limit middleware logic.
+func (m RateLimitMiddleware) Handle() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		// only API requests are limited, UI and static resources are always served.
+		if !MlflowAimPrefixRegexp.MatchString(ctx.Path()) {
+			return ctx.Next()
+		}
+
+		reloadableConfig := m.config.GetReloadableConfig()
+		if reloadableConfig.RateLimitRPS <= 0 {
+			return ctx.Next()
+		}
+
+		namespace, err := GetNamespaceFromContext(ctx.Context())
+		if err != nil {
+			return api.NewInternalError("error getting namespace from context")
+		}
+
+		reservation := m.getLimiter(
+			namespace.Code, rate.Limit(reloadableConfig.RateLimitRPS), reloadableConfig.RateLimitBurst,
+		).Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			// give the token back, the request is rejected, so it shouldn't consume the bucket.
+			reservation.Cancel()
+			log.Debugf("rate limit exceeded for %s namespace", namespace.Code)
+			ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			return ctx.Status(
+				http.StatusTooManyRequests,
+			).JSON(
+				api.NewRequestLimitExceededError("rate limit exceeded for namespace with code: %s", namespace.Code),
+			)
+		}
+		return ctx.Next()
+	}
+}
+
+// getLimiter returns the limiter of the namespace, creating it on first use.
+// Existing limiter is adjusted when the limits were reloaded.
+func (m RateLimitMiddleware) getLimiter(namespaceCode string, limit rate.Limit, burst int) *rate.Limiter {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	limiter, ok := m.limiters[namespaceCode]
+	if !ok {
+		limiter = rate.NewLimiter(limit, burst)
+		m.limiters[namespaceCode] = limiter
+	}
+	if limiter.Limit() != limit {
+		limiter.SetLimit(limit)
+	}
+	if limiter.Burst() != burst {
+		limiter.SetBurst(burst)
+	}
+	return limiter
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/G-Research/fasttrackml/pkg/common/tracing"
+)
+
+// NewTracingMiddleware creates new middleware which starts a span per API request,
+// continuing the trace propagated by the incoming request headers.
+func NewTracingMiddleware() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		// only API requests are traced, UI and static resources are skipped.
+		if !MlflowAimPrefixRegexp.MatchString(ctx.Path()) {
+			return ctx.Next()
+		}
+
+		parentCtx := otel.GetTextMapPropagator().Extract(
+			ctx.UserContext(), propagation.HeaderCarrier(http.Header(ctx.GetReqHeaders())),
+		)
+		spanCtx, span := tracing.StartSpan(
+			parentCtx, fmt.Sprintf("%s %s", ctx.Method(), ctx.Path()), trace.WithSpanKind(trace.SpanKindServer),
+		)
+		defer span.End()
+		ctx.SetUserContext(spanCtx)
+		tracing.SetRequestSpan(ctx.Context(), span)
+
+		if err := ctx.Next(); err != nil {
+			// the error has to be rendered right away, otherwise the final status code is unknown.
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				//nolint:errcheck,gosec
+				ctx.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := ctx.Response().StatusCode()
+		span.SetName(fmt.Sprintf("%s %s", ctx.Method(), ctx.Route().Path))
+		span.SetAttributes(
+			attribute.String("http.method", ctx.Method()),
+			attribute.String("http.route", ctx.Route().Path),
+			attribute.Int("http.status_code", status),
+		)
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return nil
+	}
+}
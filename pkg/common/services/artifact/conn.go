@@ -0,0 +1,30 @@
+package artifact
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// NewConnectionContext returns a copy of the context, which is done when the client closes the connection.
+// The request context of fasthttp is done on the server shutdown only, so the followed log stream relies on it
+// to stop for the disconnected clients without writing anything but the log artifact bytes.
+func NewConnectionContext(ctx context.Context, conn net.Conn) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(logStreamPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if isConnClosed(conn) {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+
+package artifact
+
+import (
+	"net"
+)
+
+// isConnClosed can't check the connection on the current platform, so the followed log stream is stopped
+// only when the run is terminated or the maximum follow duration is reached.
+func isConnClosed(conn net.Conn) bool {
+	return false
+}
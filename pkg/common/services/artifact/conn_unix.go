@@ -0,0 +1,44 @@
+//go:build linux || darwin
+
+package artifact
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// isConnClosed checks whether the peer has closed the connection. The pending bytes are peeked only,
+// so they are left for the server, which reads the next request of the connection.
+func isConnClosed(conn net.Conn) bool {
+	if tlsConn, ok := conn.(interface{ NetConn() net.Conn }); ok {
+		conn = tlsConn.NetConn()
+	}
+	syscallConn, ok := conn.(syscall.Conn)
+	if !ok {
+		return false
+	}
+	rawConn, err := syscallConn.SyscallConn()
+	if err != nil {
+		return false
+	}
+
+	closed := false
+	if err := rawConn.Read(func(fd uintptr) bool {
+		n, _, err := syscall.Recvfrom(int(fd), make([]byte, 1), syscall.MSG_PEEK|syscall.MSG_DONTWAIT)
+		switch {
+		case err == nil:
+			// the orderly shutdown of the peer is the end of the stream, otherwise there are pending bytes.
+			closed = n == 0
+		case errors.Is(err, syscall.EAGAIN), errors.Is(err, syscall.EINTR):
+			closed = false
+		default:
+			closed = true
+		}
+		// the result is ready, so the read isn't retried once the connection becomes readable.
+		return true
+	}); err != nil {
+		return true
+	}
+	return closed
+}
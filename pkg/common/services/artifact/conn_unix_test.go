@@ -0,0 +1,49 @@
+//go:build linux || darwin
+
+package artifact
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnectionContext_Ok(t *testing.T) {
+	logStreamPollInterval = time.Millisecond
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	//nolint:errcheck
+	defer listener.Close()
+	client, err := net.Dial("tcp", listener.Addr().String())
+	require.Nil(t, err)
+	conn, err := listener.Accept()
+	require.Nil(t, err)
+	//nolint:errcheck
+	defer conn.Close()
+
+	ctx, cancel := NewConnectionContext(context.Background(), conn)
+	defer cancel()
+
+	// 1. pending bytes of the next request are peeked only, so they are still readable by the server.
+	_, err = client.Write([]byte("GET"))
+	require.Nil(t, err)
+	time.Sleep(20 * time.Millisecond)
+	assert.Nil(t, ctx.Err())
+	buf := make([]byte, 3)
+	_, err = conn.Read(buf)
+	require.Nil(t, err)
+	assert.Equal(t, "GET", string(buf))
+
+	// 2. the context is done, once the client closes the connection.
+	require.Nil(t, client.Close())
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		assert.Fail(t, "context is not done after the connection has been closed")
+	}
+}
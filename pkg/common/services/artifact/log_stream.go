@@ -0,0 +1,114 @@
+package artifact
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+)
+
+// List of log stream settings.
+var (
+	// logStreamPollInterval is the interval between checks of the log artifact for the appended bytes.
+	logStreamPollInterval = time.Second
+	// logStreamMaxFollowDuration limits the time the log artifact is followed, so the forgotten streams are closed.
+	logStreamMaxFollowDuration = time.Hour
+)
+
+// LogStream streams the log artifact of the run.
+type LogStream struct {
+	run           *models.Run
+	path          string
+	follow        bool
+	reader        io.ReadCloser
+	namespaceID   uint
+	storage       storage.ArtifactStorageProvider
+	runRepository repositories.RunRepositoryProvider
+}
+
+// Close closes the log artifact, if it is open.
+func (s *LogStream) Close() error {
+	if s.reader != nil {
+		return s.reader.Close()
+	}
+	return nil
+}
+
+// WriteTo writes the log artifact into the writer. In follow mode the artifact is polled for the appended bytes
+// until the run is terminated or the context is done, and every new chunk is flushed as soon as it has been read.
+// Only the bytes of the artifact are written, see NewConnectionContext to stop following for disconnected clients.
+func (s *LogStream) WriteTo(ctx context.Context, w *bufio.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, logStreamMaxFollowDuration)
+	defer cancel()
+
+	written := int64(0)
+	for {
+		// the run is checked before the artifact is read, so the bytes appended right before
+		// the run has been terminated are still delivered.
+		terminated := true
+		if s.follow {
+			run, err := s.runRepository.GetByNamespaceIDAndRunIDWithRelations(ctx, s.namespaceID, s.run.ID, nil)
+			if err != nil {
+				return written, eris.Wrapf(err, "error getting run '%s'", s.run.ID)
+			}
+			terminated = run == nil || (run.Status != models.StatusRunning && run.Status != models.StatusScheduled)
+		}
+
+		n, err := s.writeFrom(ctx, w, written)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		if err := w.Flush(); err != nil {
+			return written, eris.Wrap(err, "error flushing output stream")
+		}
+
+		if terminated {
+			return written, nil
+		}
+		select {
+		case <-ctx.Done():
+			return written, nil
+		case <-time.After(logStreamPollInterval):
+		}
+	}
+}
+
+// writeFrom writes the bytes of the log artifact, which follow the offset, into the writer. The open local file
+// is read further, as the appended bytes become readable, while the other readers are exhausted at the size
+// the object had when it was opened, so they are closed and the next call opens the artifact at the offset.
+func (s *LogStream) writeFrom(ctx context.Context, w io.Writer, offset int64) (int64, error) {
+	if s.reader == nil {
+		reader, err := s.storage.GetFrom(ctx, s.run.ArtifactURI, s.path, offset)
+		if err != nil {
+			// the log artifact could be created by the run later, so it is just empty yet.
+			if s.follow && errors.Is(err, fs.ErrNotExist) {
+				return 0, nil
+			}
+			return 0, eris.Wrapf(err, "error getting log artifact '%s'", s.path)
+		}
+		s.reader = reader
+	}
+
+	n, err := io.CopyBuffer(w, s.reader, make([]byte, 4096))
+	if err != nil {
+		return n, eris.Wrap(err, "error copying log artifact Reader to output stream")
+	}
+
+	if _, ok := s.reader.(io.Seeker); !ok {
+		err := s.reader.Close()
+		s.reader = nil
+		if err != nil {
+			return n, eris.Wrap(err, "error closing log artifact Reader")
+		}
+	}
+	return n, nil
+}
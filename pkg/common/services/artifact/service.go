@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"slices"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
@@ -100,3 +101,124 @@ func (s Service) GetArtifact(
 	}
 	return artifactReader, nil
 }
+
+// UploadArtifact handles the business logic of `POST /artifacts/upload` endpoint.
+func (s Service) UploadArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.UploadArtifactRequest, content io.Reader,
+) (string, error) {
+	if err := ValidateUploadArtifactRequest(req); err != nil {
+		return "", err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return "", api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return "", api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return "", api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	hash, err := artifactStorage.Put(ctx, run.ArtifactURI, req.Path, content)
+	if err != nil {
+		return "", api.NewInternalError(
+			"error putting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path),
+		)
+	}
+	return hash, nil
+}
+
+// DeleteArtifact handles the business logic of `POST /artifacts/delete` endpoint.
+func (s Service) DeleteArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteArtifactRequest,
+) error {
+	if err := ValidateDeleteArtifactRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	if err := artifactStorage.Delete(ctx, run.ArtifactURI, req.Path); err != nil {
+		return api.NewInternalError(
+			"error deleting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path),
+		)
+	}
+	return nil
+}
+
+// StreamRunLogs handles the business logic of `GET /runs/logs/stream` endpoint. The log artifact is either
+// the requested one, or the one designated by the run tag. Errors are returned before anything is streamed,
+// so they are still reported with the appropriate status.
+func (s Service) StreamRunLogs(
+	ctx context.Context, namespace *models.Namespace, req *request.StreamRunLogsRequest,
+) (*LogStream, error) {
+	if err := ValidateStreamRunLogsRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+
+	path := req.Path
+	if path == "" {
+		for _, tag := range run.Tags {
+			if tag.Key == convertors.TagKeyLogArtifact {
+				path = tag.Value
+			}
+		}
+		if err := validatePath(path); err != nil {
+			return nil, err
+		}
+	}
+	if path == "" {
+		return nil, api.NewInvalidParameterValueError(
+			"run '%s' has no designated log artifact, set '%s' tag or provide 'path' parameter",
+			run.ID, convertors.TagKeyLogArtifact,
+		)
+	}
+
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	artifactReader, err := artifactStorage.Get(ctx, run.ArtifactURI, path)
+	if err != nil {
+		msg := fmt.Sprintf("error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, path))
+		// the followed log artifact could be created by the run later.
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, api.NewInternalError(msg)
+		}
+		if !req.Follow {
+			return nil, api.NewResourceDoesNotExistError(msg)
+		}
+	}
+
+	return &LogStream{
+		run:           run,
+		path:          path,
+		follow:        req.Follow,
+		reader:        artifactReader,
+		namespaceID:   namespace.ID,
+		storage:       artifactStorage,
+		runRepository: s.runRepository,
+	}, nil
+}
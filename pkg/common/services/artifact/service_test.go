@@ -1,16 +1,21 @@
 package artifact
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"io"
+	"io/fs"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
@@ -360,3 +365,408 @@ func TestService_GetArtifact_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestService_StreamRunLogs_Ok(t *testing.T) {
+	logStreamPollInterval = time.Millisecond
+
+	testData := []struct {
+		name     string
+		request  *request.StreamRunLogsRequest
+		expected string
+		service  func() *Service
+	}{
+		{
+			name: "PathFromRunTag",
+			request: &request.StreamRunLogsRequest{
+				RunID: "id",
+			},
+			expected: "line 1\n",
+			service: func() *Service {
+				artifactStorage := storage.MockArtifactStorageProvider{}
+				artifactStorage.On(
+					"Get", context.TODO(), "/artifact/uri", "logs/stdout.log",
+				).Return(
+					io.NopCloser(strings.NewReader("line 1\n")), nil,
+				)
+
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifact/uri",
+				).Return(&artifactStorage, nil)
+
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+					Tags: []models.Tag{
+						{
+							Key:   convertors.TagKeyLogArtifact,
+							Value: "logs/stdout.log",
+						},
+					},
+				}, nil)
+				return NewService(&runRepository, &artifactStorageFactory)
+			},
+		},
+		{
+			name: "FollowUntilRunTerminated",
+			request: &request.StreamRunLogsRequest{
+				RunID:  "id",
+				Path:   "stdout.log",
+				Follow: true,
+			},
+			expected: "line 1\nline 2\n",
+			service: func() *Service {
+				artifactStorage := storage.MockArtifactStorageProvider{}
+				artifactStorage.On(
+					"Get", context.TODO(), "/artifact/uri", "stdout.log",
+				).Return(
+					io.NopCloser(strings.NewReader("line 1\n")), nil,
+				).Once()
+				artifactStorage.On(
+					"GetFrom", mock.Anything, "/artifact/uri", "stdout.log", int64(7),
+				).Return(
+					io.NopCloser(strings.NewReader("line 2\n")), nil,
+				).Once()
+
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifact/uri",
+				).Return(&artifactStorage, nil)
+
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+				}, nil)
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations",
+					mock.Anything,
+					uint(1),
+					"id",
+					[]string(nil),
+				).Return(&models.Run{
+					ID:     "id",
+					Status: models.StatusRunning,
+				}, nil).Once()
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations",
+					mock.Anything,
+					uint(1),
+					"id",
+					[]string(nil),
+				).Return(&models.Run{
+					ID:     "id",
+					Status: models.StatusFinished,
+				}, nil).Once()
+				return NewService(&runRepository, &artifactStorageFactory)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			stream, err := tt.service().StreamRunLogs(context.TODO(), &models.Namespace{
+				ID: 1,
+			}, tt.request)
+			require.Nil(t, err)
+
+			result := new(bytes.Buffer)
+			w := bufio.NewWriter(result)
+			written, err := stream.WriteTo(context.TODO(), w)
+			require.Nil(t, err)
+			assert.Equal(t, int64(len(tt.expected)), written)
+			assert.Equal(t, tt.expected, result.String())
+		})
+	}
+}
+
+func TestService_StreamRunLogs_FollowIdle(t *testing.T) {
+	logStreamPollInterval = time.Millisecond
+
+	// the artifact doesn't grow for a few polls, before the next line is appended.
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "stdout.log",
+	).Return(
+		io.NopCloser(strings.NewReader("line 1\n")), nil,
+	).Once()
+	artifactStorage.On(
+		"GetFrom", mock.Anything, "/artifact/uri", "stdout.log", int64(7),
+	).Return(
+		func(context.Context, string, string, int64) io.ReadCloser {
+			return io.NopCloser(strings.NewReader(""))
+		}, nil,
+	).Times(5)
+	artifactStorage.On(
+		"GetFrom", mock.Anything, "/artifact/uri", "stdout.log", int64(7),
+	).Return(
+		io.NopCloser(strings.NewReader("line 2\n")), nil,
+	).Once()
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(&artifactStorage, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+	runRepository.On(
+		"GetByNamespaceIDAndRunIDWithRelations",
+		mock.Anything,
+		uint(1),
+		"id",
+		[]string(nil),
+	).Return(&models.Run{
+		ID:     "id",
+		Status: models.StatusRunning,
+	}, nil).Times(6)
+	runRepository.On(
+		"GetByNamespaceIDAndRunIDWithRelations",
+		mock.Anything,
+		uint(1),
+		"id",
+		[]string(nil),
+	).Return(&models.Run{
+		ID:     "id",
+		Status: models.StatusFinished,
+	}, nil).Once()
+
+	// call service under testing.
+	stream, err := NewService(&runRepository, &artifactStorageFactory).StreamRunLogs(
+		context.TODO(), &models.Namespace{ID: 1}, &request.StreamRunLogsRequest{
+			RunID:  "id",
+			Path:   "stdout.log",
+			Follow: true,
+		},
+	)
+	require.Nil(t, err)
+
+	// the streamed bytes are exactly the artifact bytes, nothing is written while the stream is idle.
+	result := new(bytes.Buffer)
+	written, err := stream.WriteTo(context.TODO(), bufio.NewWriter(result))
+	require.Nil(t, err)
+	assert.Equal(t, "line 1\nline 2\n", result.String())
+	assert.Equal(t, int64(result.Len()), written)
+	artifactStorage.AssertExpectations(t)
+}
+
+func TestService_StreamRunLogs_ClientDisconnected(t *testing.T) {
+	logStreamPollInterval = time.Millisecond
+
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "stdout.log",
+	).Return(
+		io.NopCloser(strings.NewReader("line 1\n")), nil,
+	)
+	artifactStorage.On(
+		"GetFrom", mock.Anything, "/artifact/uri", "stdout.log", int64(7),
+	).Return(
+		func(context.Context, string, string, int64) io.ReadCloser {
+			return io.NopCloser(strings.NewReader(""))
+		}, nil,
+	)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(&artifactStorage, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+	runRepository.On(
+		"GetByNamespaceIDAndRunIDWithRelations",
+		mock.Anything,
+		uint(1),
+		"id",
+		[]string(nil),
+	).Return(&models.Run{
+		ID:     "id",
+		Status: models.StatusRunning,
+	}, nil)
+
+	// call service under testing.
+	stream, err := NewService(&runRepository, &artifactStorageFactory).StreamRunLogs(
+		context.TODO(), &models.Namespace{ID: 1}, &request.StreamRunLogsRequest{
+			RunID:  "id",
+			Path:   "stdout.log",
+			Follow: true,
+		},
+	)
+	require.Nil(t, err)
+
+	// the client disconnects while the run is still running, the stream stops following with the context.
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+	result := new(bytes.Buffer)
+	written, err := stream.WriteTo(ctx, bufio.NewWriter(result))
+	require.Nil(t, err)
+	assert.Equal(t, "line 1\n", result.String())
+	assert.Equal(t, int64(7), written)
+}
+
+func TestService_StreamRunLogs_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.StreamRunLogsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.StreamRunLogsRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockRunRepositoryProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "RunNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+			request: &request.StreamRunLogsRequest{
+				RunID: "id",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(nil, nil)
+				return NewService(
+					&runRepository,
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name: "NoDesignatedLogArtifact",
+			error: api.NewInvalidParameterValueError(
+				"run 'id' has no designated log artifact, set 'fasttrackml.logArtifact' tag or provide 'path' parameter",
+			),
+			request: &request.StreamRunLogsRequest{
+				RunID: "id",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+				}, nil)
+				return NewService(
+					&runRepository,
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "IncorrectPathInRunTag",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.StreamRunLogsRequest{
+				RunID: "id",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+					Tags: []models.Tag{
+						{
+							Key:   convertors.TagKeyLogArtifact,
+							Value: "../stdout.log",
+						},
+					},
+				}, nil)
+				return NewService(
+					&runRepository,
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "LogArtifactNotFound",
+			error: api.NewResourceDoesNotExistError("error getting artifact object for URI: /artifact/uri/stdout.log"),
+			request: &request.StreamRunLogsRequest{
+				RunID: "id",
+				Path:  "stdout.log",
+			},
+			service: func() *Service {
+				artifactStorage := storage.MockArtifactStorageProvider{}
+				artifactStorage.On(
+					"Get", context.TODO(), "/artifact/uri", "stdout.log",
+				).Return(
+					nil, fs.ErrNotExist,
+				)
+
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifact/uri",
+				).Return(&artifactStorage, nil)
+
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+				}, nil)
+				return NewService(&runRepository, &artifactStorageFactory)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().StreamRunLogs(context.TODO(), &models.Namespace{
+				ID: 1,
+			}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -112,3 +112,60 @@ func (s GS) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return reader, nil
 }
+
+// Put implements ArtifactStorageProvider interface.
+func (s GS) Put(ctx context.Context, artifactURI, path string, content io.Reader) (string, error) {
+	// 1. extract bucket and prefix from the uri.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. write object to gcp storage, the object is created, when the writer is closed.
+	writer := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewWriter(ctx)
+	if _, err := io.Copy(writer, content); err != nil {
+		//nolint:errcheck
+		writer.Close()
+		return "", eris.Wrap(err, "error writing object")
+	}
+	if err := writer.Close(); err != nil {
+		return "", eris.Wrap(err, "error writing object")
+	}
+	return "", nil
+}
+
+// Delete implements ArtifactStorageProvider interface.
+func (s GS) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. extract bucket and prefix from the uri.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. delete object from gcp storage.
+	if err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).Delete(ctx); err != nil &&
+		!errors.Is(err, storage.ErrObjectNotExist) {
+		return eris.Wrap(err, "error deleting object")
+	}
+	return nil
+}
+
+// GetFrom implements ArtifactStorageProvider interface.
+func (s GS) GetFrom(ctx context.Context, artifactURI, path string, offset int64) (io.ReadCloser, error) {
+	// 1. extract bucket and prefix from the uri.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. get object range from gcp storage, the offset at the end of the object gives an empty reader.
+	reader, err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewRangeReader(ctx, offset, -1)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object")
+	}
+
+	return reader, nil
+}
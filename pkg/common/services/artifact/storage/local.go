@@ -2,12 +2,17 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
@@ -20,12 +25,45 @@ const (
 	LocalStorageName = "file"
 )
 
+// dedupIndexFileName is the name of the file keeping hashes of the deduplicated artifacts of a directory.
+const dedupIndexFileName = ".artifact-hashes.json"
+
+// linkFile links the artifact to its deduplicated content.
+var linkFile = os.Link
+
+// dedupLocks keeps the mutex of every dedup directory, so that all Local instances sharing the directory
+// use the same one.
+var dedupLocks sync.Map
+
+// getDedupLock returns the mutex guarding reference counters of the provided dedup directory.
+func getDedupLock(dedupDir string) *sync.Mutex {
+	lock, _ := dedupLocks.LoadOrStore(filepath.Clean(dedupDir), &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
 // Local represents local file storage adapter to work with artifacts.
-type Local struct{}
+// When `dedupDir` is set, content of the artifacts stored by Put is kept once per SHA-256 hash in that directory,
+// every artifact is a hard link to its content and the content is removed when no artifact references it anymore.
+// The references are counted in `.refs` files next to the content and the counters are guarded by the in-process
+// mutex of the dedup directory only, so the dedup directory mustn't be shared by several server processes.
+type Local struct {
+	dedupDir string
+	mutex    *sync.Mutex
+}
 
 // NewLocal creates new Local storage instance.
 func NewLocal(config *config.Config) (*Local, error) {
-	return &Local{}, nil
+	storage := Local{
+		mutex: &sync.Mutex{},
+	}
+	if config != nil && config.ArtifactDedupDir != "" {
+		if err := os.MkdirAll(config.ArtifactDedupDir, os.ModePerm); err != nil {
+			return nil, eris.Wrapf(err, "error creating artifact dedup directory: %s", config.ArtifactDedupDir)
+		}
+		storage.dedupDir = config.ArtifactDedupDir
+		storage.mutex = getDedupLock(config.ArtifactDedupDir)
+	}
+	return &storage, nil
 }
 
 // List implements ArtifactStorageProvider interface.
@@ -44,10 +82,17 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 		}
 		return nil, eris.Wrapf(err, "error reading object from local storage")
 	}
+	index, err := readDedupIndex(absPath)
+	if err != nil {
+		return nil, err
+	}
 
 	log.Debugf("got %d objects from local storage for path %q", len(objects), absPath)
-	artifactList := make([]ArtifactObject, len(objects))
-	for i, object := range objects {
+	artifactList := make([]ArtifactObject, 0, len(objects))
+	for _, object := range objects {
+		if object.Name() == dedupIndexFileName {
+			continue
+		}
 		info, err := object.Info()
 		if err != nil {
 			if errors.Is(err, fs.ErrNotExist) {
@@ -56,13 +101,18 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 			}
 			return nil, eris.Wrapf(err, "error getting info for object: %s", object.Name())
 		}
-		artifactList[i] = ArtifactObject{
+		artifact := ArtifactObject{
 			Path:  filepath.Join(path, info.Name()),
 			IsDir: object.IsDir(),
 		}
 		if !object.IsDir() {
-			artifactList[i].Size = info.Size()
+			artifact.Size = info.Size()
+			// the artifact could have been replaced bypassing the storage, so check that it is still the content.
+			if hash, ok := index[info.Name()]; ok && s.isContentOf(hash, info) {
+				artifact.Hash = hash
+			}
 		}
+		artifactList = append(artifactList, artifact)
 	}
 
 	return artifactList, nil
@@ -95,3 +145,241 @@ func (s Local) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser
 
 	return file, nil
 }
+
+// GetFrom implements ArtifactStorageProvider interface.
+func (s Local) GetFrom(ctx context.Context, artifactURI, path string, offset int64) (io.ReadCloser, error) {
+	reader, err := s.Get(ctx, artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	file := reader.(*os.File)
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		//nolint:errcheck
+		file.Close()
+		return nil, eris.Wrap(err, "unable to seek file")
+	}
+	return file, nil
+}
+
+// Put stores the content as an artifact at the storage location, replacing the existing one.
+// When deduplication is enabled, SHA-256 hash of the content is returned, otherwise the hash is empty.
+// The artifact, which can't be linked to the content, e.g. because it is on another device, is a copy of it.
+func (s Local) Put(ctx context.Context, artifactURI, path string, content io.Reader) (string, error) {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter.
+	absPath := filepath.Join(artifactURI, path)
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return "", eris.Wrap(err, "error creating artifact directory")
+	}
+
+	// 3. without deduplication the content is written in place.
+	if s.dedupDir == "" {
+		// #nosec G304
+		file, err := os.Create(absPath)
+		if err != nil {
+			return "", eris.Wrap(err, "error creating artifact file")
+		}
+		//nolint:errcheck
+		defer file.Close()
+		if _, err := io.Copy(file, content); err != nil {
+			return "", eris.Wrap(err, "error writing artifact file")
+		}
+		return "", file.Close()
+	}
+
+	// 4. otherwise the content is written to the dedup directory, hashing it on the way.
+	upload, err := os.CreateTemp(s.dedupDir, "upload-*")
+	if err != nil {
+		return "", eris.Wrap(err, "error creating artifact upload file")
+	}
+	//nolint:errcheck
+	defer os.Remove(upload.Name())
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(upload, hasher), content); err != nil {
+		//nolint:errcheck
+		upload.Close()
+		return "", eris.Wrap(err, "error writing artifact upload file")
+	}
+	if err := upload.Close(); err != nil {
+		return "", eris.Wrap(err, "error closing artifact upload file")
+	}
+	hash := hex.EncodeToString(hasher.Sum(nil))
+
+	// 5. keep the content, unless the same one is already stored, and link the artifact to it.
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	contentPath := s.getContentPath(hash)
+	if _, err := os.Stat(contentPath); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", eris.Wrap(err, "error checking artifact content")
+		}
+		if err := os.MkdirAll(filepath.Dir(contentPath), os.ModePerm); err != nil {
+			return "", eris.Wrap(err, "error creating artifact content directory")
+		}
+		if err := os.Rename(upload.Name(), contentPath); err != nil {
+			return "", eris.Wrap(err, "error storing artifact content")
+		}
+	}
+	// the reference is taken before the replaced artifact releases its own, which might be to the same content.
+	if err := s.changeReferences(hash, 1); err != nil {
+		return "", err
+	}
+	if err := s.remove(absPath); err != nil {
+		return "", err
+	}
+	if err := linkFile(contentPath, absPath); err != nil {
+		log.Debugf("error linking artifact %s to its content, copying it instead: %s", absPath, err)
+		if err := copyFile(contentPath, absPath); err != nil {
+			//nolint:errcheck
+			s.changeReferences(hash, -1)
+			return "", err
+		}
+		return "", s.changeReferences(hash, -1)
+	}
+	index, err := readDedupIndex(filepath.Dir(absPath))
+	if err != nil {
+		return "", err
+	}
+	index[filepath.Base(absPath)] = hash
+	if err := writeDedupIndex(filepath.Dir(absPath), index); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// Delete removes an artifact at the storage location along with its content, when it isn't referenced anymore.
+// Removing the artifact, which doesn't exist, isn't an error.
+func (s Local) Delete(ctx context.Context, artifactURI, path string) error {
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.remove(filepath.Join(artifactURI, path))
+}
+
+// remove removes an artifact and releases its reference to the deduplicated content.
+func (s Local) remove(absPath string) error {
+	if err := os.Remove(absPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return eris.Wrap(err, "error removing artifact")
+	}
+	if s.dedupDir == "" {
+		return nil
+	}
+	index, err := readDedupIndex(filepath.Dir(absPath))
+	if err != nil {
+		return err
+	}
+	hash, ok := index[filepath.Base(absPath)]
+	if !ok {
+		return nil
+	}
+	delete(index, filepath.Base(absPath))
+	if err := writeDedupIndex(filepath.Dir(absPath), index); err != nil {
+		return err
+	}
+	return s.changeReferences(hash, -1)
+}
+
+// changeReferences changes the number of artifacts referencing the content.
+// Content, which isn't referenced anymore, is removed.
+func (s Local) changeReferences(hash string, delta int) error {
+	referencesPath := s.getContentPath(hash) + ".refs"
+	references := 0
+	// #nosec G304
+	data, err := os.ReadFile(referencesPath)
+	switch {
+	case err == nil:
+		if references, err = strconv.Atoi(string(data)); err != nil {
+			return eris.Wrapf(err, "error parsing references of artifact content %s", hash)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return eris.Wrapf(err, "error reading references of artifact content %s", hash)
+	}
+
+	references += delta
+	if references > 0 {
+		if err := os.WriteFile(referencesPath, []byte(strconv.Itoa(references)), 0o600); err != nil {
+			return eris.Wrapf(err, "error writing references of artifact content %s", hash)
+		}
+		return nil
+	}
+	for _, path := range []string{s.getContentPath(hash), referencesPath} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return eris.Wrapf(err, "error removing artifact content %s", hash)
+		}
+	}
+	return nil
+}
+
+// isContentOf makes check that the file is the deduplicated content with the provided hash.
+func (s Local) isContentOf(hash string, info fs.FileInfo) bool {
+	if s.dedupDir == "" {
+		return false
+	}
+	contentInfo, err := os.Stat(s.getContentPath(hash))
+	return err == nil && os.SameFile(contentInfo, info)
+}
+
+// getContentPath returns path of the deduplicated content with the provided hash.
+func (s Local) getContentPath(hash string) string {
+	return filepath.Join(s.dedupDir, hash[:2], hash)
+}
+
+// copyFile copies the file content into the new file.
+func copyFile(source, destination string) error {
+	// #nosec G304
+	src, err := os.Open(source)
+	if err != nil {
+		return eris.Wrap(err, "error opening artifact content")
+	}
+	//nolint:errcheck
+	defer src.Close()
+	// #nosec G304
+	dst, err := os.Create(destination)
+	if err != nil {
+		return eris.Wrap(err, "error creating artifact file")
+	}
+	//nolint:errcheck
+	defer dst.Close()
+	if _, err := io.Copy(dst, src); err != nil {
+		return eris.Wrap(err, "error copying artifact content")
+	}
+	return dst.Close()
+}
+
+// readDedupIndex reads hashes of the deduplicated artifacts of the directory by their names.
+func readDedupIndex(dir string) (map[string]string, error) {
+	index := map[string]string{}
+	// #nosec G304
+	data, err := os.ReadFile(filepath.Join(dir, dedupIndexFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return index, nil
+		}
+		return nil, eris.Wrapf(err, "error reading artifact hashes of %s", dir)
+	}
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, eris.Wrapf(err, "error parsing artifact hashes of %s", dir)
+	}
+	return index, nil
+}
+
+// writeDedupIndex writes hashes of the deduplicated artifacts of the directory, empty index is removed.
+func writeDedupIndex(dir string, index map[string]string) error {
+	path := filepath.Join(dir, dedupIndexFileName)
+	if len(index) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return eris.Wrapf(err, "error removing artifact hashes of %s", dir)
+		}
+		return nil
+	}
+	data, err := json.Marshal(index)
+	if err != nil {
+		return eris.Wrapf(err, "error serializing artifact hashes of %s", dir)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return eris.Wrapf(err, "error writing artifact hashes of %s", dir)
+	}
+	return nil
+}
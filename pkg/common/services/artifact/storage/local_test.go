@@ -2,13 +2,20 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
 )
 
 func TestGetArtifact_Ok(t *testing.T) {
@@ -132,3 +139,156 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 		})
 	}
 }
+
+func TestLocal_PutArtifacts_Dedup_Ok(t *testing.T) {
+	dedupDir := t.TempDir()
+	runArtifactDir := t.TempDir()
+	otherRunArtifactDir := t.TempDir()
+
+	storage, err := NewLocal(&config.Config{ArtifactDedupDir: dedupDir})
+	require.Nil(t, err)
+
+	// 1. upload the same content under two paths of different runs.
+	hash, err := storage.Put(context.Background(), runArtifactDir, "model/weights.bin", strings.NewReader("content"))
+	require.Nil(t, err)
+	otherHash, err := storage.Put(
+		context.Background(), "file://"+otherRunArtifactDir, "weights.bin", strings.NewReader("content"),
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73", hash)
+	assert.Equal(t, hash, otherHash)
+
+	// 2. only one blob is stored and both artifacts share it.
+	assert.Equal(t, []string{filepath.Join(dedupDir, hash[:2], hash)}, listBlobs(t, dedupDir))
+	file, err := storage.Get(context.Background(), otherRunArtifactDir, "weights.bin")
+	require.Nil(t, err)
+	content, err := io.ReadAll(file)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+	assert.Equal(t, "content", string(content))
+
+	// 3. the hash is exposed in the artifact listing.
+	artifacts, err := storage.List(context.Background(), runArtifactDir, "model")
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{
+			Path: "model/weights.bin",
+			Size: 7,
+			Hash: hash,
+		},
+	}, artifacts)
+
+	// 4. the blob is kept until the last artifact referencing it is removed.
+	require.Nil(t, storage.Delete(context.Background(), runArtifactDir, "model/weights.bin"))
+	assert.Equal(t, []string{filepath.Join(dedupDir, hash[:2], hash)}, listBlobs(t, dedupDir))
+	_, err = storage.Put(context.Background(), otherRunArtifactDir, "weights.bin", strings.NewReader("other"))
+	require.Nil(t, err)
+	assert.NotContains(t, listBlobs(t, dedupDir), filepath.Join(dedupDir, hash[:2], hash))
+	assert.Len(t, listBlobs(t, dedupDir), 1)
+}
+
+func TestLocal_PutArtifacts_Dedup_LinkFailed_Ok(t *testing.T) {
+	dedupDir := t.TempDir()
+	runArtifactDir := t.TempDir()
+
+	// the artifact directory is on another device, than the dedup directory.
+	linkFile = func(string, string) error {
+		return &os.LinkError{Op: "link", Err: syscall.EXDEV}
+	}
+	defer func() {
+		linkFile = os.Link
+	}()
+
+	storage, err := NewLocal(&config.Config{ArtifactDedupDir: dedupDir})
+	require.Nil(t, err)
+
+	// the artifact is a copy of the content, which isn't kept in the dedup directory.
+	hash, err := storage.Put(context.Background(), runArtifactDir, "artifact.file", strings.NewReader("content"))
+	require.Nil(t, err)
+	assert.Empty(t, hash)
+	assert.Empty(t, listBlobs(t, dedupDir))
+
+	content, err := os.ReadFile(filepath.Join(runArtifactDir, "artifact.file"))
+	require.Nil(t, err)
+	assert.Equal(t, "content", string(content))
+
+	artifacts, err := storage.List(context.Background(), runArtifactDir, "")
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{
+			Path: "artifact.file",
+			Size: 7,
+		},
+	}, artifacts)
+}
+
+func TestLocal_PutArtifacts_WithoutDedup_Ok(t *testing.T) {
+	runArtifactDir := t.TempDir()
+
+	storage, err := NewLocal(&config.Config{})
+	require.Nil(t, err)
+
+	hash, err := storage.Put(context.Background(), runArtifactDir, "artifact.file", strings.NewReader("content"))
+	require.Nil(t, err)
+	assert.Empty(t, hash)
+
+	artifacts, err := storage.List(context.Background(), runArtifactDir, "")
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{
+			Path: "artifact.file",
+			Size: 7,
+		},
+	}, artifacts)
+}
+
+func TestLocal_PutArtifacts_Dedup_SharedDir_Ok(t *testing.T) {
+	dedupDir := t.TempDir()
+	runArtifactDir := t.TempDir()
+
+	// 1. two instances share the dedup directory, like the ones created for `file://` and scheme-less roots.
+	storage, err := NewLocal(&config.Config{ArtifactDedupDir: dedupDir})
+	require.Nil(t, err)
+	otherStorage, err := NewLocal(&config.Config{ArtifactDedupDir: dedupDir + "/"})
+	require.Nil(t, err)
+
+	// 2. upload and remove the same content concurrently through both instances, keeping the last artifact.
+	const artifactsCount = 50
+	var wg sync.WaitGroup
+	for i := 0; i < artifactsCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := storage
+			if i%2 == 0 {
+				s = otherStorage
+			}
+			path := fmt.Sprintf("artifact-%d.file", i)
+			for j := 0; j < 10; j++ {
+				_, err := s.Put(context.Background(), runArtifactDir, path, strings.NewReader("content"))
+				assert.Nil(t, err)
+				if i != 0 {
+					assert.Nil(t, s.Delete(context.Background(), runArtifactDir, path))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// 3. the content is still referenced by the remaining artifact and removed together with it.
+	require.Len(t, listBlobs(t, dedupDir), 1)
+	require.Nil(t, storage.Delete(context.Background(), runArtifactDir, "artifact-0.file"))
+	assert.Empty(t, listBlobs(t, dedupDir))
+}
+
+// listBlobs returns paths of the content blobs stored in the dedup directory.
+func listBlobs(t *testing.T, dedupDir string) []string {
+	var blobs []string
+	require.Nil(t, filepath.WalkDir(dedupDir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && filepath.Ext(path) != ".refs" {
+			blobs = append(blobs, path)
+		}
+		return err
+	}))
+	return blobs
+}
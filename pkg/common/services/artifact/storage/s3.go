@@ -3,11 +3,15 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
+	"net/http"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -133,3 +137,77 @@ func (s S3) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return resp.Body, nil
 }
+
+// Put implements ArtifactStorageProvider interface.
+func (s S3) Put(ctx context.Context, artifactURI, path string, content io.Reader) (string, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Body:   content,
+	}
+
+	// 2. put object to s3 storage.
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return "", eris.Wrap(err, "error putting object")
+	}
+	return "", nil
+}
+
+// Delete implements ArtifactStorageProvider interface.
+func (s S3) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+	}
+
+	// 2. delete object from s3 storage.
+	if _, err := s.client.DeleteObject(ctx, input); err != nil {
+		return eris.Wrap(err, "error deleting object")
+	}
+	return nil
+}
+
+// GetFrom implements ArtifactStorageProvider interface.
+func (s S3) GetFrom(ctx context.Context, artifactURI, path string, offset int64) (io.ReadCloser, error) {
+	// 1. create s3 request input.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-", offset)),
+	}
+
+	// 2. get object range from s3 storage.
+	resp, err := s.client.GetObject(ctx, input)
+	if err != nil {
+		var s3NoSuchKey *types.NoSuchKey
+		if errors.As(err, &s3NoSuchKey) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		// the offset at the end of the object is not a satisfiable range, but there is just nothing to read.
+		var responseError *awshttp.ResponseError
+		if errors.As(err, &responseError) &&
+			responseError.HTTPStatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			return io.NopCloser(strings.NewReader("")), nil
+		}
+		return nil, eris.Wrap(err, "error getting object")
+	}
+
+	return resp.Body, nil
+}
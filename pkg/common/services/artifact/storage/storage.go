@@ -16,6 +16,7 @@ type ArtifactObject struct {
 	Path  string
 	Size  int64 // artifact object size in bytes.
 	IsDir bool
+	Hash  string // SHA-256 hash of the content, only known for deduplicated artifacts.
 }
 
 // GetPath returns Artifact Path.
@@ -28,6 +29,11 @@ func (o ArtifactObject) GetSize() int64 {
 	return o.Size
 }
 
+// GetHash returns Artifact content Hash.
+func (o ArtifactObject) GetHash() string {
+	return o.Hash
+}
+
 // IsDirectory show that object is directly or not.
 func (o ArtifactObject) IsDirectory() bool {
 	return o.IsDir
@@ -37,8 +43,15 @@ func (o ArtifactObject) IsDirectory() bool {
 type ArtifactStorageProvider interface {
 	// Get returns an io.ReadCloser for specific artifact.
 	Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error)
+	// GetFrom returns an io.ReadCloser for specific artifact, which starts at the offset.
+	GetFrom(ctx context.Context, artifactURI, path string, offset int64) (io.ReadCloser, error)
 	// List lists all artifact objects under a provided path.
 	List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error)
+	// Put stores the content as an artifact, replacing the existing one, and returns hash of the content,
+	// when the storage deduplicates it.
+	Put(ctx context.Context, artifactURI, path string, content io.Reader) (string, error)
+	// Delete removes an artifact.
+	Delete(ctx context.Context, artifactURI, path string) error
 }
 
 // ArtifactStorageFactoryProvider provides an interface provider to work with Artifact Storage.
@@ -71,7 +84,11 @@ func (s *ArtifactStorageFactory) GetStorage(
 		return nil, eris.Wrap(err, "error parsing artifact root")
 	}
 
+	// artifact roots without scheme are stored locally, so they share the local storage instance.
 	storageName := u.Scheme
+	if storageName == "" {
+		storageName = LocalStorageName
+	}
 	if storage, ok := s.storageList.Load(storageName); ok {
 		return storage.(ArtifactStorageProvider), nil
 	}
@@ -90,7 +107,7 @@ func (s *ArtifactStorageFactory) GetStorage(
 		if err != nil {
 			return nil, eris.Wrap(err, "error initializing s3 artifact storage")
 		}
-	case "", LocalStorageName:
+	case LocalStorageName:
 		var err error
 		storage, err = NewLocal(s.config)
 		if err != nil {
@@ -100,6 +117,7 @@ func (s *ArtifactStorageFactory) GetStorage(
 		return nil, eris.Errorf("unsupported schema has been provided: %s", u.Scheme)
 	}
 
-	s.storageList.Store(storageName, storage)
-	return storage, nil
+	// concurrent calls could have initialized the storage meanwhile, only the first stored instance is used.
+	actual, _ := s.storageList.LoadOrStore(storageName, storage)
+	return actual.(ArtifactStorageProvider), nil
 }
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+)
+
+func TestArtifactStorageFactory_GetStorage_Local_Ok(t *testing.T) {
+	factory, err := NewArtifactStorageFactory(&config.Config{ArtifactDedupDir: t.TempDir()})
+	require.Nil(t, err)
+
+	storage, err := factory.GetStorage(context.Background(), "/artifacts")
+	require.Nil(t, err)
+	fileStorage, err := factory.GetStorage(context.Background(), "file:///artifacts")
+	require.Nil(t, err)
+	assert.Same(t, storage, fileStorage)
+}
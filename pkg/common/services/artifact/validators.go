@@ -28,6 +28,39 @@ func ValidateGetArtifactRequest(req *request.GetArtifactRequest) error {
 	return validatePath(req.Path)
 }
 
+// ValidateUploadArtifactRequest validates `POST /artifacts/upload` request.
+func ValidateUploadArtifactRequest(req *request.UploadArtifactRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Path == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+
+	return validatePath(req.Path)
+}
+
+// ValidateDeleteArtifactRequest validates `POST /artifacts/delete` request.
+func ValidateDeleteArtifactRequest(req *request.DeleteArtifactRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Path == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+
+	return validatePath(req.Path)
+}
+
+// ValidateStreamRunLogsRequest validates `GET /runs/logs/stream` request.
+func ValidateStreamRunLogsRequest(req *request.StreamRunLogsRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+
+	return validatePath(req.Path)
+}
+
 // validatePath validates path parameter.
 func validatePath(path string) error {
 	parsedUrl, err := url.Parse(path)
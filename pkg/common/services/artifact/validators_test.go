@@ -300,3 +300,140 @@ func TestValidateGetArtifactRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUploadArtifactRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.UploadArtifactRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.UploadArtifactRequest{},
+		},
+		{
+			name:  "EmptyPath",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.UploadArtifactRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name:  "PathOutsideOfArtifactRoot",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadArtifactRequest{
+				RunID: "run_id",
+				Path:  "../other_run/artifacts/model.bin",
+			},
+		},
+		{
+			name:  "AbsolutePath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadArtifactRequest{
+				RunID: "run_id",
+				Path:  "/etc/passwd",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUploadArtifactRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateDeleteArtifactRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteArtifactRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DeleteArtifactRequest{},
+		},
+		{
+			name:  "EmptyPath",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.DeleteArtifactRequest{
+				RunUUID: "run_id",
+			},
+		},
+		{
+			name:  "PathOutsideOfArtifactRoot",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DeleteArtifactRequest{
+				RunID: "run_id",
+				Path:  "model/../../other_run/artifacts/model.bin",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeleteArtifactRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateStreamRunLogsRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.StreamRunLogsRequest
+	}{
+		{
+			name: "EmptyPath",
+			request: &request.StreamRunLogsRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name: "NotEmptyPathWithFollow",
+			request: &request.StreamRunLogsRequest{
+				RunUUID: "run_id",
+				Path:    "logs/stdout.log",
+				Follow:  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateStreamRunLogsRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateStreamRunLogsRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.StreamRunLogsRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.StreamRunLogsRequest{},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.StreamRunLogsRequest{
+				RunID: "run_id",
+				Path:  "foo/../bar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStreamRunLogsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/version"
+)
+
+// TracerName is the name of the tracer used for all the FastTrackML spans.
+const TracerName = "github.com/G-Research/fasttrackml"
+
+// requestSpanKey is the key of the request span stored in the request context.
+type requestSpanKey struct{}
+
+// NewTracerProvider creates a new tracer provider with the configured exporter
+// and installs it, together with the W3C trace context propagator, as the global one.
+func NewTracerProvider(ctx context.Context, config *config.Config) (*sdktrace.TracerProvider, error) {
+	var options []otlptracehttp.Option
+	if config.TracingOTLPEndpoint != "" {
+		options = append(options, otlptracehttp.WithEndpointURL(config.TracingOTLPEndpoint))
+	}
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating OTLP trace exporter")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName("fasttrackml"),
+			semconv.ServiceVersion(version.Version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+	return provider, nil
+}
+
+// SetRequestSpan stores the span of the API request in the request context,
+// so that spans started deeper in the call chain become its children.
+func SetRequestSpan(ctx interface{ SetUserValue(key, value any) }, span trace.Span) {
+	ctx.SetUserValue(requestSpanKey{}, span)
+}
+
+// StartSpan starts a new span as a child of the span from the context or of the API request span.
+func StartSpan(
+	ctx context.Context, name string, options ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		if span, ok := ctx.Value(requestSpanKey{}).(trace.Span); ok {
+			ctx = trace.ContextWithSpan(ctx, span)
+		}
+	}
+	return otel.Tracer(TracerName).Start(ctx, name, options...)
+}
@@ -12,3 +12,7 @@ const (
 	SQLiteDialectorName   = "sqlite"
 	PostgresDialectorName = "postgres"
 )
+
+// SearchResolverName is the name of the dbresolver configuration used by read-only search queries.
+// When no search replicas are configured, such queries fall back to the default connection.
+const SearchResolverName = "search"
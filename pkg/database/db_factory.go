@@ -8,19 +8,27 @@ import (
 )
 
 // NewDBProvider creates a DBProvider of the correct type from the parameters.
+// When `preparedStatements` isn't provided, gorm prepared statement cache is enabled for Postgres only.
+// Optional `replicaDSNs` have to be of the same database type and are used by read-only search queries.
 func NewDBProvider(
-	dsn string, slowThreshold time.Duration, poolMax int,
+	dsn string, slowThreshold time.Duration, poolMax int, preparedStatements *bool, replicaDSNs []string,
 ) (db DBProvider, err error) {
 	dsnURL, err := url.Parse(dsn)
 	if err != nil {
 		return nil, eris.Wrap(err, "invalid database URL")
 	}
+	replicaURLs, err := parseReplicaURLs(dsnURL.Scheme, replicaDSNs)
+	if err != nil {
+		return nil, err
+	}
 	switch dsnURL.Scheme {
 	case SQLiteSchemaName:
 		db, err = NewSqliteDBInstance(
 			*dsnURL,
 			slowThreshold,
 			poolMax,
+			isPreparedStatementsEnabled(dsnURL.Scheme, preparedStatements),
+			replicaURLs,
 		)
 		if err != nil {
 			return nil, eris.Wrap(err, "error creating sqlite provider")
@@ -30,6 +38,8 @@ func NewDBProvider(
 			*dsnURL,
 			slowThreshold,
 			poolMax,
+			isPreparedStatementsEnabled(dsnURL.Scheme, preparedStatements),
+			replicaURLs,
 		)
 		if err != nil {
 			return nil, eris.Wrap(err, "error creating postgres provider")
@@ -40,3 +50,46 @@ func NewDBProvider(
 
 	return db, nil
 }
+
+// isPreparedStatementsEnabled returns whether gorm prepared statement cache has to be enabled.
+// The provided option always wins, otherwise it is enabled for Postgres only, because
+// it can misbehave with some SQLite setups.
+func isPreparedStatementsEnabled(schema string, preparedStatements *bool) bool {
+	if preparedStatements != nil {
+		return *preparedStatements
+	}
+	switch schema {
+	case PostgresSchemaName, PostgresQLSchemaName:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseReplicaURLs parses replica DSNs and makes sure that they point to the same database type as primary.
+func parseReplicaURLs(schema string, replicaDSNs []string) ([]url.URL, error) {
+	replicaURLs := make([]url.URL, 0, len(replicaDSNs))
+	for _, replicaDSN := range replicaDSNs {
+		replicaURL, err := url.Parse(replicaDSN)
+		if err != nil {
+			return nil, eris.Wrap(err, "invalid replica database URL")
+		}
+		if databaseType(replicaURL.Scheme) != databaseType(schema) {
+			return nil, eris.Errorf(
+				"replica database URL has to be of the same type as primary: %s", replicaURL.Redacted(),
+			)
+		}
+		replicaURLs = append(replicaURLs, *replicaURL)
+	}
+	return replicaURLs, nil
+}
+
+// databaseType returns the type of database for the provided schema.
+func databaseType(schema string) string {
+	switch schema {
+	case PostgresSchemaName, PostgresQLSchemaName:
+		return PostgresSchemaName
+	default:
+		return schema
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
 // DBProvider is the interface to access the DB.
@@ -17,6 +18,12 @@ type DBProvider interface {
 // DB is a global gorm.DB reference
 var DB *gorm.DB
 
+// WithSearchReplicas returns a new session of the DB which routes read-only queries to search replicas.
+// Writes and transactions always stay on primary.
+func WithSearchReplicas(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Use(SearchResolverName)).Session(&gorm.Session{})
+}
+
 // DBInstance is the base concrete type for DbProvider.
 type DBInstance struct {
 	*gorm.DB
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+)
+
+// postgresUniqueViolationCode is the Postgres error code for `unique_violation`.
+const postgresUniqueViolationCode = "23505"
+
+// postgresConnectionExceptionClass is the Postgres error class of connection exceptions.
+const postgresConnectionExceptionClass = "08"
+
+// postgresTransientErrorCodes are Postgres error codes of failures, which may succeed when retried:
+// `serialization_failure`, `deadlock_detected`, `admin_shutdown`, `crash_shutdown` and `cannot_connect_now`.
+var postgresTransientErrorCodes = []string{"40001", "40P01", "57P01", "57P02", "57P03"}
+
+// IsUniqueConstraintError checks if the error was caused by a unique constraint violation.
+func IsUniqueConstraintError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == postgresUniqueViolationCode
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+	return false
+}
+
+// IsTransientError checks if the error was caused by a temporary failure, so the same query may succeed when retried.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, postgresConnectionExceptionClass) ||
+			slices.Contains(postgresTransientErrorCodes, pgErr.Code)
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		pgconn.SafeToRetry(err)
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"database/sql/driver"
+	"errors"
+	"syscall"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUniqueConstraintError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "PostgresUniqueViolation",
+			err:      eris.Wrap(&pgconn.PgError{Code: "23505"}, "error creating entity"),
+			expected: true,
+		},
+		{
+			name:     "PostgresOtherError",
+			err:      eris.Wrap(&pgconn.PgError{Code: "23503"}, "error creating entity"),
+			expected: false,
+		},
+		{
+			name:     "SqliteUniqueViolation",
+			err:      eris.Wrap(sqlite3.Error{ExtendedCode: sqlite3.ErrConstraintUnique}, "error creating entity"),
+			expected: true,
+		},
+		{
+			name:     "SqliteOtherError",
+			err:      eris.Wrap(sqlite3.Error{ExtendedCode: sqlite3.ErrConstraintForeignKey}, "error creating entity"),
+			expected: false,
+		},
+		{
+			name:     "GenericError",
+			err:      errors.New("database error"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsUniqueConstraintError(tt.err))
+		})
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "NoError",
+			expected: false,
+		},
+		{
+			name:     "PostgresSerializationFailure",
+			err:      eris.Wrap(&pgconn.PgError{Code: "40001"}, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "PostgresConnectionFailure",
+			err:      eris.Wrap(&pgconn.PgError{Code: "08006"}, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "PostgresAdminShutdown",
+			err:      eris.Wrap(&pgconn.PgError{Code: "57P01"}, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "PostgresOtherError",
+			err:      eris.Wrap(&pgconn.PgError{Code: "23505"}, "error getting entity"),
+			expected: false,
+		},
+		{
+			name:     "SqliteBusy",
+			err:      eris.Wrap(sqlite3.Error{Code: sqlite3.ErrBusy}, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "SqliteOtherError",
+			err:      eris.Wrap(sqlite3.Error{Code: sqlite3.ErrConstraint}, "error getting entity"),
+			expected: false,
+		},
+		{
+			name:     "ConnectionReset",
+			err:      eris.Wrap(syscall.ECONNRESET, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "BadConnection",
+			err:      eris.Wrap(driver.ErrBadConn, "error getting entity"),
+			expected: true,
+		},
+		{
+			name:     "GenericError",
+			err:      errors.New("database error"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsTransientError(tt.err))
+		})
+	}
+}
@@ -84,6 +84,7 @@ func (s *Importer) Import() error {
 		"experiments",
 		"experiment_tags",
 		"runs",
+		"run_idempotency_keys",
 		"tags",
 		"params",
 		"contexts",
@@ -316,7 +317,7 @@ func (s *Importer) updateNamespaceDefaultExperiment() error {
 // ApplyNamespaceRestriction overwrite Namespace if it is needed.
 func ApplyNamespaceRestriction(table string, item map[string]any, namespace *Namespace) map[string]any {
 	if namespace != nil {
-		if slices.Contains([]string{"apps", "experiments"}, table) {
+		if slices.Contains([]string{"apps", "experiments", "run_idempotency_keys"}, table) {
 			item["namespace_id"] = namespace.ID
 		}
 	}
@@ -351,7 +352,7 @@ func EntityLimitedByNamespace(table string, db *gorm.DB, namespace *Namespace) *
 			).Where(
 				"experiments.namespace_id = ?", namespace.ID,
 			)
-		case "apps", "experiments":
+		case "apps", "experiments", "run_idempotency_keys":
 			return db.Where(fmt.Sprintf("%s.namespace_id = ?", table), namespace.ID)
 		case "dashboards":
 			return db.Joins(
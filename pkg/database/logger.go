@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -19,8 +20,9 @@ const (
 )
 
 type loggerAdaptor struct {
-	Logger *logrus.Logger
-	Config LoggerAdaptorConfig
+	Logger        *logrus.Logger
+	Config        LoggerAdaptorConfig
+	slowThreshold *atomic.Int64
 }
 
 type LoggerAdaptorConfig struct {
@@ -31,7 +33,16 @@ type LoggerAdaptorConfig struct {
 
 // NewLoggerAdaptor creates a new logger adaptor.
 func NewLoggerAdaptor(l *logrus.Logger, cfg LoggerAdaptorConfig) logger.Interface {
-	return &loggerAdaptor{l, cfg}
+	slowThreshold := &atomic.Int64{}
+	slowThreshold.Store(int64(cfg.SlowThreshold))
+	return &loggerAdaptor{l, cfg, slowThreshold}
+}
+
+// SetSlowThreshold changes slow SQL warning threshold of the database logger without reconnecting.
+func SetSlowThreshold(db *gorm.DB, threshold time.Duration) {
+	if l, ok := db.Logger.(*loggerAdaptor); ok {
+		l.slowThreshold.Store(int64(threshold))
+	}
 }
 
 // LogMode implements the gorm.io/gorm/logger.Interface interface and is a no-op.
@@ -68,15 +79,16 @@ func (l *loggerAdaptor) Trace(
 
 	// This logic is similar to the default logger in gorm.io/gorm/logger.
 	elapsed := time.Since(begin)
+	slowThreshold := time.Duration(l.slowThreshold.Load())
 	switch {
 	case err != nil &&
 		l.Logger.IsLevelEnabled(logrus.ErrorLevel) &&
 		(!errors.Is(err, gorm.ErrRecordNotFound) || !l.Config.IgnoreRecordNotFoundError):
 		l.getLoggerEntryWithSql(ctx, elapsed, fc).WithError(err).Error("SQL error")
-	case elapsed > l.Config.SlowThreshold &&
-		l.Config.SlowThreshold != 0 &&
+	case elapsed > slowThreshold &&
+		slowThreshold != 0 &&
 		l.Logger.IsLevelEnabled(logrus.WarnLevel):
-		l.getLoggerEntryWithSql(ctx, elapsed, fc).Warnf("SLOW SQL >= %v", l.Config.SlowThreshold)
+		l.getLoggerEntryWithSql(ctx, elapsed, fc).Warnf("SLOW SQL >= %v", slowThreshold)
 	case l.Logger.IsLevelEnabled(logrus.DebugLevel):
 		l.getLoggerEntryWithSql(ctx, elapsed, fc).Debug("SQL trace")
 	}
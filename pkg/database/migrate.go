@@ -138,6 +138,7 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				&Experiment{},
 				&ExperimentTag{},
 				&Run{},
+				&RunIdempotencyKey{},
 				&Param{},
 				&Tag{},
 				&SharedTag{},
@@ -150,6 +151,9 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				&SchemaVersion{},
 				&Log{},
 				&Artifact{},
+				&RegisteredModel{},
+				&ModelVersion{},
+				&AuditLog{},
 			); err != nil {
 				return fmt.Errorf("error initializing database: %w", err)
 			}
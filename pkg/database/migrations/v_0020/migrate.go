@@ -0,0 +1,25 @@
+package v_0020
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018040134"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Migrator().AutoMigrate(&RegisteredModel{}, &ModelVersion{}); err != nil {
+				return err
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
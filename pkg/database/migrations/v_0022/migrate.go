@@ -0,0 +1,36 @@
+package v_0022
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018051326"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&Metric{}, "Kind"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&LatestMetric{}, "Kind"); err != nil {
+				return err
+			}
+			// metrics logged with the system key prefix before the column existed are system metrics.
+			for _, table := range []string{"metrics", "latest_metrics"} {
+				if err := tx.Table(table).Where(
+					"SUBSTR(key, 1, ?) = ?", len("__system__"), "__system__",
+				).Update("kind", "system").Error; err != nil {
+					return err
+				}
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,34 @@
+package v_0026
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018101532"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			for _, column := range []string{"CreationTime", "LastUpdateTime"} {
+				if err := tx.Migrator().AddColumn(&Run{}, column); err != nil {
+					return err
+				}
+			}
+			// existing runs have been created when they started and updated at the latest when they ended.
+			if err := tx.Exec(
+				"UPDATE runs SET creation_time = start_time, " +
+					"last_update_time = CASE WHEN end_time > start_time THEN end_time ELSE start_time END",
+			).Error; err != nil {
+				return err
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
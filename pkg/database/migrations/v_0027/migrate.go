@@ -0,0 +1,31 @@
+package v_0027
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018213207"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&Experiment{}, "DeletedTime"); err != nil {
+				return err
+			}
+			// deleted experiments have not been updated since they were deleted.
+			if err := tx.Exec(
+				"UPDATE experiments SET deleted_time = last_update_time WHERE lifecycle_stage = 'deleted'",
+			).Error; err != nil {
+				return err
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
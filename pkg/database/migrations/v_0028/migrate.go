@@ -0,0 +1,35 @@
+package v_0028
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018215615"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&Run{}, "DeletedWithExperiment"); err != nil {
+				return err
+			}
+			// runs of the deleted experiments have been deleted together with the experiment at its deletion time.
+			if err := tx.Exec(
+				"UPDATE runs SET deleted_with_experiment = true " +
+					"WHERE lifecycle_stage = 'deleted' AND EXISTS (" +
+					"SELECT 1 FROM experiments WHERE experiments.experiment_id = runs.experiment_id " +
+					"AND experiments.lifecycle_stage = 'deleted' " +
+					"AND experiments.deleted_time = runs.deleted_time)",
+			).Error; err != nil {
+				return err
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
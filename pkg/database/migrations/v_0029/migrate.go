@@ -0,0 +1,81 @@
+package v_0029
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "20261018221904"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// temporary index makes the lookups of the same metric step below fast.
+			if err := tx.Exec(
+				"CREATE INDEX idx_metrics_step_tmp ON metrics (run_uuid, key, step, context_id)",
+			).Error; err != nil {
+				return err
+			}
+			// keep only the first logged value of every metric step, otherwise the unique index can't be created.
+			if err := tx.Exec(
+				`DELETE FROM metrics WHERE EXISTS (
+					SELECT 1 FROM metrics m
+					WHERE m.run_uuid = metrics.run_uuid
+					AND m.key = metrics.key
+					AND m.step = metrics.step
+					AND m.context_id = metrics.context_id
+					AND (
+						m.iter < metrics.iter OR (m.iter = metrics.iter AND (
+							m.timestamp < metrics.timestamp OR (m.timestamp = metrics.timestamp AND (
+								m.value < metrics.value OR (m.value = metrics.value AND m.is_nan < metrics.is_nan)
+							))
+						))
+					)
+				)`,
+			).Error; err != nil {
+				return err
+			}
+			// latest metrics, which values have been removed above, take the kept value of the same step.
+			if err := tx.Exec(
+				`UPDATE latest_metrics SET
+					value = (` + keptMetric("m.value") + `),
+					timestamp = (` + keptMetric("m.timestamp") + `),
+					is_nan = (` + keptMetric("m.is_nan") + `)
+				WHERE NOT EXISTS (
+					SELECT 1 FROM metrics m
+					WHERE m.run_uuid = latest_metrics.run_uuid
+					AND m.key = latest_metrics.key
+					AND m.context_id = latest_metrics.context_id
+					AND m.step = latest_metrics.step
+					AND m.value = latest_metrics.value
+					AND m.timestamp = latest_metrics.timestamp
+					AND m.is_nan = latest_metrics.is_nan
+				) AND EXISTS (` + keptMetric("1") + `)`,
+			).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropIndex(&Metric{}, "idx_metrics_step_tmp"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().CreateIndex(&Metric{}, "idx_metrics_step"); err != nil {
+				return err
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
+
+// keptMetric selects the expression of the metric kept for the step of the latest metric.
+func keptMetric(expression string) string {
+	return `SELECT ` + expression + ` FROM metrics m
+		WHERE m.run_uuid = latest_metrics.run_uuid
+		AND m.key = latest_metrics.key
+		AND m.context_id = latest_metrics.context_id
+		AND m.step = latest_metrics.step`
+}
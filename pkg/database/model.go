@@ -45,6 +45,7 @@ type Namespace struct {
 	Apps                []App          `gorm:"constraint:OnDelete:CASCADE" json:"apps"`
 	Code                string         `gorm:"unique;index;not null" json:"code"`
 	Description         string         `json:"description"`
+	ArtifactRoot        string         `json:"artifact_root"`
 	CreatedAt           time.Time      `json:"created_at"`
 	UpdatedAt           time.Time      `json:"updated_at"`
 	DeletedAt           gorm.DeletedAt `gorm:"index" json:"deleted_at"`
@@ -59,6 +60,7 @@ type Experiment struct {
 	LifecycleStage   LifecycleStage `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
 	CreationTime     sql.NullInt64  `gorm:"type:bigint"`
 	LastUpdateTime   sql.NullInt64  `gorm:"type:bigint"`
+	DeletedTime      sql.NullInt64  `gorm:"type:bigint"`
 	NamespaceID      uint           `gorm:"not null;index:,unique,composite:name"`
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
@@ -78,28 +80,39 @@ type ExperimentTag struct {
 
 //nolint:lll
 type Run struct {
-	ID             string         `gorm:"<-:create;column:run_uuid;type:varchar(32);not null;primaryKey"`
-	Name           string         `gorm:"type:varchar(250)"`
-	SourceType     string         `gorm:"<-:create;type:varchar(20);check:source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"`
-	SourceName     string         `gorm:"<-:create;type:varchar(500)"`
-	EntryPointName string         `gorm:"<-:create;type:varchar(50)"`
-	UserID         string         `gorm:"<-:create;type:varchar(256)"`
-	Status         Status         `gorm:"type:varchar(9);check:status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"`
-	StartTime      sql.NullInt64  `gorm:"<-:create;type:bigint"`
-	EndTime        sql.NullInt64  `gorm:"type:bigint"`
-	SourceVersion  string         `gorm:"<-:create;type:varchar(50)"`
-	LifecycleStage LifecycleStage `gorm:"type:varchar(20);check:lifecycle_stage IN ('active', 'deleted')"`
-	ArtifactURI    string         `gorm:"<-:create;type:varchar(200)"`
-	ExperimentID   int32
-	Experiment     Experiment
-	DeletedTime    sql.NullInt64  `gorm:"type:bigint"`
-	RowNum         RowNum         `gorm:"<-:create;index"`
-	Params         []Param        `gorm:"constraint:OnDelete:CASCADE"`
-	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
-	SharedTags     []SharedTag    `gorm:"many2many:run_shared_tags"`
-	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
-	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
-	Logs           []Log          `gorm:"constraing:OnDelete:CASCADE"`
+	ID                    string         `gorm:"<-:create;column:run_uuid;type:varchar(32);not null;primaryKey"`
+	Name                  string         `gorm:"type:varchar(250);index:,composite:experiment_name,priority:2"`
+	SourceType            string         `gorm:"<-:create;type:varchar(20);check:source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"`
+	SourceName            string         `gorm:"<-:create;type:varchar(500)"`
+	EntryPointName        string         `gorm:"<-:create;type:varchar(50)"`
+	UserID                string         `gorm:"<-:create;type:varchar(256)"`
+	Status                Status         `gorm:"type:varchar(9);check:status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"`
+	StartTime             sql.NullInt64  `gorm:"<-:create;type:bigint"`
+	EndTime               sql.NullInt64  `gorm:"type:bigint"`
+	CreationTime          sql.NullInt64  `gorm:"<-:create;type:bigint"`
+	LastUpdateTime        sql.NullInt64  `gorm:"type:bigint"`
+	SourceVersion         string         `gorm:"<-:create;type:varchar(50)"`
+	LifecycleStage        LifecycleStage `gorm:"type:varchar(20);check:lifecycle_stage IN ('active', 'deleted')"`
+	ArtifactURI           string         `gorm:"<-:create;type:varchar(200)"`
+	ExperimentID          int32          `gorm:"index:,composite:experiment_name,priority:1"`
+	Experiment            Experiment
+	DeletedTime           sql.NullInt64  `gorm:"type:bigint"`
+	DeletedWithExperiment bool           `gorm:"not null;default:false"`
+	RowNum                RowNum         `gorm:"<-:create;index"`
+	Params                []Param        `gorm:"constraint:OnDelete:CASCADE"`
+	Tags                  []Tag          `gorm:"constraint:OnDelete:CASCADE"`
+	SharedTags            []SharedTag    `gorm:"many2many:run_shared_tags"`
+	Metrics               []Metric       `gorm:"constraint:OnDelete:CASCADE"`
+	LatestMetrics         []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Logs                  []Log          `gorm:"constraing:OnDelete:CASCADE"`
+}
+
+type RunIdempotencyKey struct {
+	NamespaceID uint      `gorm:"primaryKey"`
+	Namespace   Namespace `gorm:"constraint:OnDelete:CASCADE"`
+	Key         string    `gorm:"type:varchar(256);primaryKey"`
+	RunID       string    `gorm:"column:run_uuid;not null;index"`
+	Run         Run       `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 type RowNum int64
@@ -139,9 +152,10 @@ type Param struct {
 
 // Tag represents metadata about a particular run (for Mlflow).
 type Tag struct {
-	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
-	Value string `gorm:"type:varchar(5000)"`
-	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	// idx_tags_run_key_value covers only the value prefix, whole values exceed the Postgres btree row size.
+	Key   string `gorm:"type:varchar(250);not null;primaryKey;index:idx_tags_run_key_value,priority:2"`
+	Value string `gorm:"type:varchar(5000);index:idx_tags_run_key_value,priority:3,expression:substr(value\\,1\\,256)"`
+	RunID string `gorm:"column:run_uuid;not null;primaryKey;index;index:idx_tags_run_key_value,priority:1"`
 }
 
 // SharedTag represents a tag which can label multiple runs (for Aim).
@@ -162,15 +176,16 @@ type RunSharedTag struct {
 }
 
 type Metric struct {
-	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Key       string  `gorm:"type:varchar(250);not null;primaryKey;uniqueIndex:idx_metrics_step,priority:2"`
 	Value     float64 `gorm:"type:double precision;not null;primaryKey"`
 	Timestamp int64   `gorm:"not null;primaryKey"`
-	RunID     string  `gorm:"column:run_uuid;not null;primaryKey;index"`
-	Step      int64   `gorm:"default:0;not null;primaryKey"`
+	RunID     string  `gorm:"column:run_uuid;not null;primaryKey;index;uniqueIndex:idx_metrics_step,priority:1"`
+	Step      int64   `gorm:"default:0;not null;primaryKey;uniqueIndex:idx_metrics_step,priority:3"`
 	IsNan     bool    `gorm:"default:false;not null;primaryKey"`
 	Iter      int64   `gorm:"index"`
-	ContextID uint    `gorm:"not null;primaryKey"`
+	ContextID uint    `gorm:"not null;primaryKey;uniqueIndex:idx_metrics_step,priority:4"`
 	Context   Context
+	Kind      string `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 type LatestMetric struct {
@@ -183,6 +198,7 @@ type LatestMetric struct {
 	LastIter  int64
 	ContextID uint `gorm:"not null;primaryKey"`
 	Context   Context
+	Kind      string `gorm:"type:varchar(16);not null;default:'user'"`
 }
 
 type Log struct {
@@ -324,3 +340,46 @@ type Artifact struct {
 	Caption string
 	BlobURI string
 }
+
+type RegisteredModel struct {
+	ID              uint           `gorm:"primaryKey;autoIncrement"`
+	Name            string         `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string         `gorm:"type:varchar(5000)"`
+	CreationTime    int64          `gorm:"type:bigint"`
+	LastUpdatedTime int64          `gorm:"type:bigint"`
+	NamespaceID     uint           `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace      `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func (RegisteredModel) TableName() string {
+	return "registry_models"
+}
+
+type AuditLog struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	NamespaceID uint      `gorm:"not null;index"`
+	Actor       string    `gorm:"not null"`
+	EntityType  string    `gorm:"type:varchar(16);not null;index:,composite:entity"`
+	EntityID    string    `gorm:"not null;index:,composite:entity"`
+	Action      string    `gorm:"type:varchar(16);not null"`
+	CreatedAt   time.Time `gorm:"not null;index"`
+}
+
+type ModelVersion struct {
+	ID                uint   `gorm:"primaryKey;autoIncrement"`
+	RegisteredModelID uint   `gorm:"not null;index:,unique,composite:version"`
+	Version           int64  `gorm:"not null;index:,unique,composite:version"`
+	Description       string `gorm:"type:varchar(5000)"`
+	Source            string `gorm:"type:varchar(500);not null"`
+	Status            string `gorm:"type:varchar(20);not null"`
+	CurrentStage      string `gorm:"type:varchar(20);not null;default:None"`
+	CreationTime      int64  `gorm:"type:bigint"`
+	LastUpdatedTime   int64  `gorm:"type:bigint"`
+	RunID             string `gorm:"column:run_uuid;type:varchar(32);not null;index"`
+	Run               Run    `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func (ModelVersion) TableName() string {
+	return "registry_model_versions"
+}
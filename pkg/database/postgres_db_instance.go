@@ -9,6 +9,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
 // PostgresDBInstance is the Postgres-specific DbInstance variant.
@@ -18,7 +19,7 @@ type PostgresDBInstance struct {
 
 // NewPostgresDBInstance constructs a Postgres DbInstance.
 func NewPostgresDBInstance(
-	dsnURL url.URL, slowThreshold time.Duration, poolMax int,
+	dsnURL url.URL, slowThreshold time.Duration, poolMax int, preparedStatements bool, replicaURLs []url.URL,
 ) (*PostgresDBInstance, error) {
 	db := PostgresDBInstance{
 		DBInstance: DBInstance{dsn: dsnURL.String()},
@@ -33,6 +34,7 @@ func NewPostgresDBInstance(
 			SlowThreshold:             slowThreshold,
 			IgnoreRecordNotFoundError: true,
 		}),
+		PrepareStmt: preparedStatements,
 	})
 	if err != nil {
 		return nil, eris.Wrap(err, "failed to connect to database")
@@ -47,6 +49,25 @@ func NewPostgresDBInstance(
 	sqlDB.SetMaxIdleConns(poolMax)
 	sqlDB.SetMaxOpenConns(poolMax)
 
+	if len(replicaURLs) > 0 {
+		replicaConns := make([]gorm.Dialector, 0, len(replicaURLs))
+		for _, replicaURL := range replicaURLs {
+			log.Infof("Using replica database %s", replicaURL.Redacted())
+			replicaConns = append(replicaConns, postgres.Open(replicaURL.String()))
+		}
+		// only search queries are explicitly routed to replicas, everything else stays on primary.
+		if err := db.Use(
+			dbresolver.Register(dbresolver.Config{
+				Replicas: replicaConns,
+			}, SearchResolverName).
+				SetConnMaxIdleTime(time.Minute).
+				SetMaxIdleConns(poolMax).
+				SetMaxOpenConns(poolMax),
+		); err != nil {
+			return nil, eris.Wrap(err, "error attaching plugin")
+		}
+	}
+
 	return &db, nil
 }
 
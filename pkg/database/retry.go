@@ -0,0 +1,67 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+)
+
+// RetryPlugin is a gorm plugin which retries read queries failed with transient errors, e.g. during failover.
+// Writes are never retried, same as reads inside transactions, which have to be rolled back as a whole.
+type RetryPlugin struct {
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewRetryPlugin creates new RetryPlugin instance.
+// `maxAttempts` is the total number of attempts of a read query, values below 2 disable retries.
+// `backoff` is the delay before the first retry, which is doubled on every next retry.
+func NewRetryPlugin(maxAttempts int, backoff time.Duration) *RetryPlugin {
+	return &RetryPlugin{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Name returns the plugin name.
+func (p RetryPlugin) Name() string {
+	return "retry"
+}
+
+// Initialize replaces gorm read query callbacks with the retrying ones.
+func (p RetryPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Query().Replace("gorm:query", p.retry(callbacks.Query)),
+		callback.Row().Replace("gorm:row", p.retry(callbacks.RowQuery)),
+	)
+}
+
+// retry wraps the read query callback, so that it is executed again while it fails with transient errors.
+func (p RetryPlugin) retry(query func(*gorm.DB)) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		// errors of the previous callbacks aren't caused by the query, so they are never retried.
+		retryable := db.Error == nil
+		query(db)
+		if _, inTransaction := db.Statement.ConnPool.(gorm.TxCommitter); !retryable || inTransaction {
+			return
+		}
+		backoff := p.backoff
+		for attempt := 1; attempt < p.maxAttempts && IsTransientError(db.Error); attempt++ {
+			log.Warnf("retrying database query after transient error (attempt %d): %s", attempt, db.Error)
+			select {
+			case <-db.Statement.Context.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+
+			// the statement has already been built, so the same SQL is executed again.
+			db.Error = nil
+			query(db)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type retryTestEntity struct {
+	ID   int
+	Name string
+}
+
+// newRetryTestDB creates gorm DB on top of the mock connection with the retry plugin registered.
+func newRetryTestDB(t *testing.T, maxAttempts int) (*gorm.DB, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		//nolint:errcheck
+		mockDB.Close()
+	})
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		Conn:       mockDB,
+		DriverName: "postgres",
+	}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	require.Nil(t, err)
+	require.Nil(t, db.Use(NewRetryPlugin(maxAttempts, time.Millisecond)))
+	return db, mock
+}
+
+func TestRetryPlugin_Ok(t *testing.T) {
+	testData := []struct {
+		name     string
+		failures []error
+	}{
+		{
+			name: "WithoutFailures",
+		},
+		{
+			name:     "WithConnectionReset",
+			failures: []error{syscall.ECONNRESET},
+		},
+		{
+			name: "WithSerializationFailureAndAdminShutdown",
+			failures: []error{
+				&pgconn.PgError{Code: "40001"},
+				&pgconn.PgError{Code: "57P01"},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newRetryTestDB(t, 3)
+			for _, failure := range tt.failures {
+				mock.ExpectQuery(`SELECT \* FROM "retry_test_entities"`).WillReturnError(failure)
+			}
+			mock.ExpectQuery(`SELECT \* FROM "retry_test_entities"`).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "name"),
+			)
+
+			var entities []retryTestEntity
+			require.Nil(t, db.Find(&entities).Error)
+			assert.Equal(t, []retryTestEntity{{ID: 1, Name: "name"}}, entities)
+			assert.Nil(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRetryPlugin_Error(t *testing.T) {
+	testData := []struct {
+		name        string
+		maxAttempts int
+		error       error
+		attempts    int
+	}{
+		{
+			name:        "AttemptsExhausted",
+			maxAttempts: 3,
+			error:       syscall.ECONNRESET,
+			attempts:    3,
+		},
+		{
+			name:        "RetriesDisabled",
+			maxAttempts: 0,
+			error:       syscall.ECONNRESET,
+			attempts:    1,
+		},
+		{
+			name:        "NotTransientError",
+			maxAttempts: 3,
+			error:       &pgconn.PgError{Code: "42P01"},
+			attempts:    1,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := newRetryTestDB(t, tt.maxAttempts)
+			for i := 0; i < tt.attempts; i++ {
+				mock.ExpectQuery(`SELECT \* FROM "retry_test_entities"`).WillReturnError(tt.error)
+			}
+
+			var entities []retryTestEntity
+			err := db.Find(&entities).Error
+			assert.True(t, errors.Is(err, tt.error))
+			assert.Nil(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRetryPlugin_WritesAndTransactionsAreNotRetried(t *testing.T) {
+	db, mock := newRetryTestDB(t, 3)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "retry_test_entities"`).WillReturnError(syscall.ECONNRESET)
+	mock.ExpectRollback()
+	err := db.Model(&retryTestEntity{}).Where("id = ?", 1).Update("name", "name").Error
+	assert.True(t, errors.Is(err, syscall.ECONNRESET))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "retry_test_entities"`).WillReturnError(syscall.ECONNRESET)
+	mock.ExpectRollback()
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var entities []retryTestEntity
+		return tx.Find(&entities).Error
+	})
+	assert.True(t, errors.Is(err, syscall.ECONNRESET))
+
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
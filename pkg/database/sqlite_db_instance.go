@@ -22,6 +22,18 @@ const (
 	SQLiteCustomDriverName = "sqlite3_custom_driver"
 )
 
+// Default sqlite pragmas applied to every connection, unless provided in the database URL.
+// They reduce writer contention when metrics are logged concurrently. Each of them is overridden
+// by the `_journal_mode` (`_journal`), `_synchronous` (`_sync`), `_busy_timeout` (`_timeout`)
+// or `_cache_size` database URL parameter, e.g. `sqlite://fasttrackml.db?_synchronous=FULL`.
+const (
+	SQLiteDefaultJournalMode = "WAL"
+	SQLiteDefaultSynchronous = "NORMAL"
+	SQLiteDefaultBusyTimeout = "5000"
+	// SQLiteDefaultCacheSize is negative, so it is interpreted as KiB rather than pages.
+	SQLiteDefaultCacheSize = "-64000"
+)
+
 // SqliteDBInstance is the sqlite specific variant of DbInstance.
 type SqliteDBInstance struct {
 	DBInstance
@@ -29,7 +41,7 @@ type SqliteDBInstance struct {
 
 // NewSqliteDBInstance creates a SqliteDBInstance.
 func NewSqliteDBInstance(
-	dsnURL url.URL, slowThreshold time.Duration, poolMax int,
+	dsnURL url.URL, slowThreshold time.Duration, poolMax int, preparedStatements bool, replicaURLs []url.URL,
 ) (*SqliteDBInstance, error) {
 	db := SqliteDBInstance{
 		DBInstance: DBInstance{dsn: dsnURL.String()},
@@ -37,12 +49,7 @@ func NewSqliteDBInstance(
 	var sourceConn gorm.Dialector
 	var replicaConn gorm.Dialector
 
-	query := dsnURL.Query()
-	query.Set("_case_sensitive_like", "true")
-	query.Set("_mutex", "no")
-	if query.Get("mode") != "memory" && !(query.Has("_journal") || query.Has("_journal_mode")) {
-		query.Set("_journal", "WAL")
-	}
+	query := sqliteConnectionQuery(dsnURL)
 	sourceURL := dsnURL
 	sourceURL.RawQuery = query.Encode()
 
@@ -82,21 +89,31 @@ func NewSqliteDBInstance(
 		Conn: sourceDB,
 	}
 
-	query.Set("_query_only", "true")
-	replicaURL := dsnURL
-	replicaURL.RawQuery = query.Encode()
-	replicaDB, err := sql.Open(SQLiteCustomDriverName, strings.Replace(replicaURL.String(), "sqlite://", "file:", 1))
+	replicaDB, err := openSqliteReplica(dsnURL, poolMax)
 	if err != nil {
 		//nolint:errcheck,gosec
 		db.Close()
 		return nil, eris.Wrap(err, "failed to connect to database")
 	}
 	db.closers = append(db.closers, replicaDB)
-	replicaDB.SetMaxOpenConns(poolMax)
 	replicaConn = sqlite.Dialector{
 		Conn: replicaDB,
 	}
 
+	searchReplicaConns := make([]gorm.Dialector, 0, len(replicaURLs))
+	for _, replicaURL := range replicaURLs {
+		searchReplicaDB, err := openSqliteReplica(replicaURL, poolMax)
+		if err != nil {
+			//nolint:errcheck,gosec
+			db.Close()
+			return nil, eris.Wrap(err, "failed to connect to replica database")
+		}
+		db.closers = append(db.closers, searchReplicaDB)
+		searchReplicaConns = append(searchReplicaConns, sqlite.Dialector{
+			Conn: searchReplicaDB,
+		})
+	}
+
 	logURL := dsnURL
 	query = logURL.Query()
 	if query.Has("_key") {
@@ -110,6 +127,7 @@ func NewSqliteDBInstance(
 			SlowThreshold:             slowThreshold,
 			IgnoreRecordNotFoundError: true,
 		}),
+		PrepareStmt: preparedStatements,
 	})
 	if err != nil {
 		//nolint:errcheck,gosec
@@ -117,19 +135,58 @@ func NewSqliteDBInstance(
 		return nil, eris.Wrap(err, "failed to connect to database")
 	}
 
-	if err := db.Use(
-		dbresolver.Register(dbresolver.Config{
-			Replicas: []gorm.Dialector{
-				replicaConn,
-			},
-		}),
-	); err != nil {
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: []gorm.Dialector{
+			replicaConn,
+		},
+	})
+	if len(searchReplicaConns) > 0 {
+		resolver = resolver.Register(dbresolver.Config{
+			Replicas: searchReplicaConns,
+		}, SearchResolverName)
+	}
+	if err := db.Use(resolver); err != nil {
 		return nil, eris.Wrap(err, "error attaching plugin")
 	}
 
 	return &db, nil
 }
 
+// sqliteConnectionQuery returns connection parameters shared by all the sqlite connections.
+// Pragmas which are already provided in the database URL take precedence over the defaults.
+func sqliteConnectionQuery(dsnURL url.URL) url.Values {
+	query := dsnURL.Query()
+	query.Set("_case_sensitive_like", "true")
+	query.Set("_mutex", "no")
+	if query.Get("mode") != "memory" && !(query.Has("_journal") || query.Has("_journal_mode")) {
+		query.Set("_journal", SQLiteDefaultJournalMode)
+	}
+	if !(query.Has("_sync") || query.Has("_synchronous")) {
+		query.Set("_sync", SQLiteDefaultSynchronous)
+	}
+	if !(query.Has("_timeout") || query.Has("_busy_timeout")) {
+		query.Set("_busy_timeout", SQLiteDefaultBusyTimeout)
+	}
+	if !query.Has("_cache_size") {
+		query.Set("_cache_size", SQLiteDefaultCacheSize)
+	}
+	return query
+}
+
+// openSqliteReplica opens read-only connection pool to sqlite database.
+func openSqliteReplica(dsnURL url.URL, poolMax int) (*sql.DB, error) {
+	query := sqliteConnectionQuery(dsnURL)
+	query.Set("_query_only", "true")
+	replicaURL := dsnURL
+	replicaURL.RawQuery = query.Encode()
+	replicaDB, err := sql.Open(SQLiteCustomDriverName, strings.Replace(replicaURL.String(), "sqlite://", "file:", 1))
+	if err != nil {
+		return nil, err
+	}
+	replicaDB.SetMaxOpenConns(poolMax)
+	return replicaDB, nil
+}
+
 // Reset resets database.
 func (db SqliteDBInstance) Reset() error {
 	log.Info("Resetting database schema")
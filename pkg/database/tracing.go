@@ -0,0 +1,78 @@
+package database
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/common/tracing"
+)
+
+// tracingSpanKey is the key of the span stored in the gorm statement instance.
+const tracingSpanKey = "tracing:span"
+
+// TracingPlugin is a gorm plugin which wraps every database call into a tracing span.
+type TracingPlugin struct{}
+
+// NewTracingPlugin creates new TracingPlugin instance.
+func NewTracingPlugin() *TracingPlugin {
+	return &TracingPlugin{}
+}
+
+// Name returns the plugin name.
+func (p TracingPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize registers the plugin callbacks around all the gorm operations.
+func (p TracingPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("gorm:create").Register("tracing:before_create", p.startSpan("gorm.create")),
+		callback.Create().After("gorm:create").Register("tracing:after_create", p.endSpan),
+		callback.Query().Before("gorm:query").Register("tracing:before_query", p.startSpan("gorm.query")),
+		callback.Query().After("gorm:query").Register("tracing:after_query", p.endSpan),
+		callback.Update().Before("gorm:update").Register("tracing:before_update", p.startSpan("gorm.update")),
+		callback.Update().After("gorm:update").Register("tracing:after_update", p.endSpan),
+		callback.Delete().Before("gorm:delete").Register("tracing:before_delete", p.startSpan("gorm.delete")),
+		callback.Delete().After("gorm:delete").Register("tracing:after_delete", p.endSpan),
+		callback.Row().Before("gorm:row").Register("tracing:before_row", p.startSpan("gorm.row")),
+		callback.Row().After("gorm:row").Register("tracing:after_row", p.endSpan),
+		callback.Raw().Before("gorm:raw").Register("tracing:before_raw", p.startSpan("gorm.raw")),
+		callback.Raw().After("gorm:raw").Register("tracing:after_raw", p.endSpan),
+	)
+}
+
+// startSpan starts a span for the gorm operation.
+func (p TracingPlugin) startSpan(name string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		_, span := tracing.StartSpan(db.Statement.Context, name, trace.WithSpanKind(trace.SpanKindClient))
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// endSpan ends the span of the gorm operation with the executed statement.
+func (p TracingPlugin) endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", db.Dialector.Name()),
+		attribute.String("db.statement", db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+// ulidEncoding is the Crockford's base32 alphabet in lower case, so the ids look like the hex encoded UUIDs.
+// The alphabet is sorted, so lexical order of the encoded ids is the same as the order of their bytes.
+const ulidEncoding = "0123456789abcdefghjkmnpqrstvwxyz"
+
+// ulidGenerator keeps the last generated ULID, so the ids generated within the same millisecond
+// are still increasing.
+type ulidGenerator struct {
+	sync.Mutex
+	lastTime    uint64
+	lastEntropy [10]byte
+}
+
+// defaultULIDGenerator is shared by all the ULIDs generated by the server.
+var defaultULIDGenerator = &ulidGenerator{}
+
+// NewULID generates a new lexically sortable ULID, which consists of 48 bits of timestamp in milliseconds
+// and 80 bits of entropy, encoded into 26 characters. The ids generated within the same millisecond
+// increment the entropy of the previous one, so the generated ids are monotonic.
+func NewULID() string {
+	return defaultULIDGenerator.generate(uint64(time.Now().UTC().UnixMilli()))
+}
+
+// generate generates a new ULID for the given timestamp in milliseconds.
+func (g *ulidGenerator) generate(now uint64) string {
+	g.Lock()
+	defer g.Unlock()
+
+	if now <= g.lastTime {
+		// keep the last timestamp, so the clock going backward doesn't break monotonicity either.
+		now = g.lastTime
+		incrementEntropy(&g.lastEntropy)
+	} else {
+		if _, err := rand.Read(g.lastEntropy[:]); err != nil {
+			panic(err)
+		}
+		g.lastTime = now
+	}
+
+	var id [16]byte
+	for i := 0; i < 6; i++ {
+		id[i] = byte(now >> (40 - 8*i))
+	}
+	copy(id[6:], g.lastEntropy[:])
+	return encodeULID(id)
+}
+
+// incrementEntropy increments the entropy as one big-endian number. The entropy wraps around on overflow,
+// which is practically impossible, as it would require 2^80 ids within the same millisecond.
+func incrementEntropy(entropy *[10]byte) {
+	for i := len(entropy) - 1; i >= 0; i-- {
+		entropy[i]++
+		if entropy[i] != 0 {
+			return
+		}
+	}
+}
+
+// encodeULID encodes 128 bits of the id into 26 characters of 5 bits each, the first character holds
+// the 3 most significant bits only.
+func encodeULID(id [16]byte) string {
+	var r [26]byte
+	hi := uint64(id[0])<<56 | uint64(id[1])<<48 | uint64(id[2])<<40 | uint64(id[3])<<32 |
+		uint64(id[4])<<24 | uint64(id[5])<<16 | uint64(id[6])<<8 | uint64(id[7])
+	lo := uint64(id[8])<<56 | uint64(id[9])<<48 | uint64(id[10])<<40 | uint64(id[11])<<32 |
+		uint64(id[12])<<24 | uint64(id[13])<<16 | uint64(id[14])<<8 | uint64(id[15])
+	for i := len(r) - 1; i >= 0; i-- {
+		r[i] = ulidEncoding[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(r[:])
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ulidRegexp = regexp.MustCompile(`^[0-7][0-9abcdefghjkmnpqrstvwxyz]{25}$`)
+
+func TestNewULID_Monotonic(t *testing.T) {
+	now := uint64(time.Now().UTC().UnixMilli())
+	tests := []struct {
+		name  string
+		times []uint64
+	}{
+		{
+			name:  "IncreasingTime",
+			times: []uint64{now + 1, now + 2, now + 3},
+		},
+		{
+			name:  "SameMillisecond",
+			times: []uint64{now + 10, now + 10, now + 10},
+		},
+		{
+			name:  "ClockGoingBackward",
+			times: []uint64{now + 20, now + 19, now + 18},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator := &ulidGenerator{}
+			previous := ""
+			for _, ts := range tt.times {
+				id := generator.generate(ts)
+				assert.Regexp(t, ulidRegexp, id)
+				assert.Greater(t, id, previous)
+				previous = id
+			}
+		})
+	}
+
+	previous := ""
+	for i := 0; i < 10000; i++ {
+		id := NewULID()
+		require.Len(t, id, 26)
+		require.Greater(t, id, previous)
+		previous = id
+	}
+}
+
+func TestNewULID_Timestamp(t *testing.T) {
+	// the first 10 characters encode the timestamp, so ids of later milliseconds are always greater.
+	ts := uint64(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	generator := &ulidGenerator{}
+	id := generator.generate(ts)
+	assert.Less(t, id, generator.generate(ts+1))
+
+	decoded := uint64(0)
+	for _, c := range id[:10] {
+		decoded = decoded<<5 | uint64(strings.IndexRune(ulidEncoding, c))
+	}
+	assert.Equal(t, ts, decoded)
+}
+
+func TestNewUUID(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		id := NewUUID()
+		require.Len(t, id, 32)
+		b, err := hex.DecodeString(id)
+		require.Nil(t, err)
+		u, err := uuid.FromBytes(b)
+		require.Nil(t, err)
+		assert.Equal(t, uuid.Version(4), u.Version())
+	}
+}
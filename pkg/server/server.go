@@ -37,12 +37,16 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/dao"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/metrics"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 	artifactService "github.com/G-Research/fasttrackml/pkg/common/services/artifact"
 	"github.com/G-Research/fasttrackml/pkg/common/services/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/tracing"
 	"github.com/G-Research/fasttrackml/pkg/database"
 	adminUI "github.com/G-Research/fasttrackml/pkg/ui/admin"
 	adminUIController "github.com/G-Research/fasttrackml/pkg/ui/admin/controller"
+	adminUIAuditService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/audit"
+	adminUIMetricService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/metric"
 	adminUINamespaceService "github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
 	aimUI "github.com/G-Research/fasttrackml/pkg/ui/aim"
 	"github.com/G-Research/fasttrackml/pkg/ui/chooser"
@@ -62,13 +66,44 @@ type server struct {
 	*fiber.App
 }
 
+// Options represents optional server dependencies, which can't be provided by the configuration.
+type Options struct {
+	searchRunsFilter repositories.SearchRunsFilter
+	stateSchemas     *aimAppService.StateSchemaRegistry
+}
+
+// WithSearchRunsFilter sets the hook invoked by the runs, metrics and artifacts search.
+func WithSearchRunsFilter(filter repositories.SearchRunsFilter) func(options *Options) {
+	return func(o *Options) {
+		o.searchRunsFilter = filter
+	}
+}
+
+// WithStateSchemaRegistry sets the registry of JSON Schemas used to validate the state of aim apps.
+func WithStateSchemaRegistry(registry *aimAppService.StateSchemaRegistry) func(options *Options) {
+	return func(o *Options) {
+		o.stateSchemas = registry
+	}
+}
+
 // NewServer creates a new server instance.
-func NewServer(ctx context.Context, config *config.Config) (Server, error) {
+func NewServer(ctx context.Context, config *config.Config, options ...func(options *Options)) (Server, error) {
+	serverOptions := Options{
+		searchRunsFilter: repositories.NoopSearchRunsFilter{},
+		stateSchemas:     aimAppService.NewStateSchemaRegistry(),
+	}
+	for _, o := range options {
+		o(&serverOptions)
+	}
+
 	// create database provider.
 	db, err := createDBProvider(ctx, config)
 	if err != nil {
 		return nil, err
 	}
+	if config.Reloadable != nil {
+		watchSlowThreshold(config.Reloadable, db)
+	}
 
 	// create artifact storage factory.
 	artifactStorageFactory, err := storage.NewArtifactStorageFactory(config)
@@ -78,7 +113,7 @@ func NewServer(ctx context.Context, config *config.Config) (Server, error) {
 
 	// create fiber app.
 	//nolint:contextcheck
-	app, err := createApp(ctx, config, db, artifactStorageFactory)
+	app, err := createApp(ctx, config, db, artifactStorageFactory, serverOptions)
 	if err != nil {
 		return nil, eris.Wrapf(err, "error creating application")
 	}
@@ -86,12 +121,21 @@ func NewServer(ctx context.Context, config *config.Config) (Server, error) {
 	return server{app}, nil
 }
 
+// watchSlowThreshold applies slow SQL warning threshold to the database every time the configuration is reloaded.
+func watchSlowThreshold(reloadable *config.ReloadableConfigHolder, db database.DBProvider) {
+	reloadable.OnChange(func(reloadableConfig *config.ReloadableConfig) {
+		database.SetSlowThreshold(db.GormDB(), reloadableConfig.DatabaseSlowThreshold)
+	})
+}
+
 // createDBProvider creates a new DB provider.
 func createDBProvider(ctx context.Context, config *config.Config) (database.DBProvider, error) {
 	db, err := database.NewDBProvider(
 		config.DatabaseURI,
 		config.DatabaseSlowThreshold,
 		config.DatabasePoolMax,
+		config.DatabasePreparedStatements,
+		config.DatabaseReplicaURIs,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to DB: %w", err)
@@ -120,6 +164,16 @@ func createDBProvider(ctx context.Context, config *config.Config) (database.DBPr
 		return nil, eris.Wrap(err, "error creating default context")
 	}
 
+	if err := db.GormDB().Use(database.NewTracingPlugin()); err != nil {
+		return nil, eris.Wrap(err, "error registering database tracing plugin")
+	}
+
+	if err := db.GormDB().Use(
+		database.NewRetryPlugin(config.DatabaseRetryMaxAttempts, config.DatabaseRetryBackoff),
+	); err != nil {
+		return nil, eris.Wrap(err, "error registering database retry plugin")
+	}
+
 	// cache a global reference to the gorm.DB
 	database.DB = db.GormDB()
 	return db, nil
@@ -133,8 +187,9 @@ func createApp(
 	config *config.Config,
 	db database.DBProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+	options Options,
 ) (*fiber.App, error) {
-	app := fiber.New(fiber.Config{
+	fiberConfig := fiber.Config{
 		BodyLimit:             16 * 1024 * 1024,
 		ReadBufferSize:        16384,
 		ReadTimeout:           5 * time.Second,
@@ -156,13 +211,32 @@ func createApp(
 				return fiber.DefaultErrorHandler(c, err)
 			}
 		},
-	})
+	}
+	if config.IsTrustedProxyCheckEnabled() {
+		log.Infof("Proxy - trusting forwarded and namespace headers only from %s", strings.Join(config.TrustedProxies, ", "))
+		fiberConfig.EnableTrustedProxyCheck = true
+		fiberConfig.TrustedProxies = config.TrustedProxies
+		fiberConfig.ProxyHeader = fiber.HeaderXForwardedFor
+	}
+	app := fiber.New(fiberConfig)
 
 	app.Hooks().OnShutdown(func() error {
 		log.Info("Shutting down database connection")
 		return db.Close()
 	})
 
+	if config.IsTracingEnabled() {
+		log.Infof("Tracing - enabling %s traces exporter", config.TracingExporter)
+		tracerProvider, err := tracing.NewTracerProvider(ctx, config)
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating tracer provider")
+		}
+		app.Hooks().OnShutdown(func() error {
+			log.Info("Shutting down tracer provider")
+			return tracerProvider.Shutdown(context.Background())
+		})
+	}
+
 	if config.DevMode {
 		log.Info("Development mode - enabling CORS")
 		app.Use(cors.New())
@@ -189,6 +263,11 @@ func createApp(
 
 	namespaceEventListener.Listen()
 
+	metricHistoryCache, err := mlflowMetricService.NewHistoryCache(config.MetricHistoryCacheSize)
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating metric history cache")
+	}
+
 	// attach global middlewares.
 	if config.Auth.AuthUsername != "" && config.Auth.AuthPassword != "" {
 		log.Info("Auth - enabling Basic Auth")
@@ -198,7 +277,27 @@ func createApp(
 			},
 		}))
 	}
-	app.Use(middleware.NewNamespaceMiddleware(namespaceCachedRepository))
+	app.Use(middleware.NewNamespaceMiddleware(
+		namespaceCachedRepository, middleware.NewNamespaceResolver(config),
+	))
+	app.Use(middleware.NewTracingMiddleware())
+	if config.MetricsEnabled {
+		log.Info("Metrics - enabling Prometheus metrics on /metrics")
+		apiMetrics, err := metrics.NewMetrics(db)
+		if err != nil {
+			return nil, eris.Wrap(err, "error creating metrics")
+		}
+		app.Use(middleware.NewMetricsMiddleware(apiMetrics))
+		app.Get("/metrics", apiMetrics.Handler())
+	}
+	if config.IsRateLimitEnabled() {
+		log.Infof(
+			"Rate limit - enabling %v requests/sec with burst of %d per namespace",
+			config.RateLimitRPS, config.RateLimitBurst,
+		)
+	}
+	// rate limits could be enabled on reload, so the middleware is always in place.
+	app.Use(middleware.NewRateLimitMiddleware(config))
 
 	app.Use(compress.New(compress.Config{
 		Next: func(c *fiber.Ctx) bool {
@@ -275,8 +374,10 @@ func createApp(
 			),
 			aimAppService.NewService(
 				aimRepositories.NewAppRepository(db.GormDB()),
+				options.stateSchemas,
 			),
 			aimRunService.NewService(
+				config,
 				aimRepositories.NewRunRepository(db.GormDB()),
 				aimRepositories.NewLogRepository(db.GormDB()),
 				aimRepositories.NewMetricRepository(db.GormDB()),
@@ -284,6 +385,7 @@ func createApp(
 				aimRepositories.NewSharedTagRepository(db.GormDB()),
 				artifactStorageFactory,
 				aimRepositories.NewArtifactRepository(db.GormDB()),
+				options.searchRunsFilter,
 			),
 			artifactService.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
@@ -314,6 +416,7 @@ func createApp(
 	mlflowAPI.NewRouter(
 		mlflowController.NewController(
 			mlflowRunService.NewService(
+				config,
 				mlflowRepositories.NewTagRepository(db.GormDB()),
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				mlflowRepositories.NewParamRepository(db.GormDB()),
@@ -321,11 +424,18 @@ func createApp(
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 				mlflowRepositories.NewLogRepository(db.GormDB(), config.RunLogOutputMax),
 				mlflowRepositories.NewArtifactRepository(db.GormDB()),
+				namespaceCachedRepository,
+				options.searchRunsFilter,
+			),
+			mlflowModelService.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewModelRepository(db.GormDB()),
 			),
-			mlflowModelService.NewService(),
 			mlflowMetricService.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				mlflowRepositories.NewMetricRepository(db.GormDB()),
+				metricHistoryCache,
+				config.MetricHistoryMaxPoints,
 			),
 			artifactService.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
@@ -346,6 +456,17 @@ func createApp(
 		mlflowRepositories.NewLogRepository(db.GormDB(), config.RunLogOutputMax),
 	).Run()
 
+	// run a purger of deleted experiments and runs background job.
+	if config.IsPurgeDeletedEnabled() {
+		log.Infof("Purge - enabling purge of experiments and runs deleted more than %s ago", config.PurgeDeletedTTL)
+		mlflowRunService.NewDeletedPurger(
+			ctx,
+			config,
+			mlflowRepositories.NewRunRepository(db.GormDB()),
+			mlflowRepositories.NewExperimentRepository(db.GormDB()),
+		).Run()
+	}
+
 	mlflowUI.AddRoutes(app)
 	aimUI.AddRoutes(app)
 
@@ -357,6 +478,14 @@ func createApp(
 				namespaceCachedRepository,
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 			),
+			adminUIAuditService.NewService(
+				mlflowRepositories.NewAuditLogRepository(db.GormDB()),
+			),
+			adminUIMetricService.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewMetricRepository(db.GormDB()),
+				metricHistoryCache,
+			),
 		),
 	).Init(app); err != nil {
 		return nil, eris.Wrap(err, "error initializing admin routes")
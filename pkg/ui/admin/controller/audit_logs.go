@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+)
+
+// GetAuditLogs returns the audit log entries of deleted and restored entities.
+func (c Controller) GetAuditLogs(ctx *fiber.Ctx) error {
+	var req request.AuditLogs
+	if err := ctx.QueryParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "unable to parse request query")
+	}
+	auditLogs, err := c.auditService.ListAuditLogs(ctx.Context(), &req)
+	if err != nil {
+		var apiErr *api.ErrorResponse
+		if errors.As(err, &apiErr) {
+			return fiber.NewError(apiErr.StatusCode, apiErr.Message)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "unable to list audit logs")
+	}
+	return ctx.JSON(response.NewAuditLogsResponse(auditLogs))
+}
@@ -1,15 +1,25 @@
 package controller
 
-import "github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
+import (
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/audit"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/metric"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/service/namespace"
+)
 
 // Controller contains all the request handler functions for the admin ui.
 type Controller struct {
 	namespaceService *namespace.Service
+	auditService     *audit.Service
+	metricService    *metric.Service
 }
 
 // NewController creates new Controller instance.
-func NewController(namespaceService *namespace.Service) *Controller {
+func NewController(
+	namespaceService *namespace.Service, auditService *audit.Service, metricService *metric.Service,
+) *Controller {
 	return &Controller{
 		namespaceService: namespaceService,
+		auditService:     auditService,
+		metricService:    metricService,
 	}
 }
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// RenameMetric renames a metric key of a Run.
+func (c Controller) RenameMetric(ctx *fiber.Ctx) error {
+	var req request.RenameMetric
+	if err := ctx.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "unable to parse request body")
+	}
+	if err := c.metricService.RenameMetric(ctx.Context(), &req); err != nil {
+		var apiErr *api.ErrorResponse
+		if errors.As(err, &apiErr) {
+			return fiber.NewError(apiErr.StatusCode, apiErr.Message)
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "unable to rename metric")
+	}
+	return ctx.JSON(fiber.Map{})
+}
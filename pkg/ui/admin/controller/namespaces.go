@@ -14,8 +14,22 @@ const (
 )
 
 // GetNamespaces renders the list view with no message.
+// When JSON is requested, the list of namespaces with their usage summary is returned instead.
 func (c Controller) GetNamespaces(ctx *fiber.Ctx) error {
-	return c.renderIndex(ctx, "")
+	ctx.Vary(fiber.HeaderAccept)
+	if ctx.Accepts(fiber.MIMETextHTML, fiber.MIMEApplicationJSON) != fiber.MIMEApplicationJSON {
+		return c.renderIndex(ctx, "")
+	}
+
+	namespaces, err := c.namespaceService.ListNamespaces(ctx.Context())
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "unable to list namespaces")
+	}
+	usage, err := c.namespaceService.GetNamespacesUsage(ctx.Context())
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "unable to get namespaces usage")
+	}
+	return ctx.JSON(response.NewNamespaceSummariesResponse(namespaces, usage))
 }
 
 // GetNamespace renders the update view for a namespace.
@@ -50,7 +64,9 @@ func (c Controller) CreateNamespace(ctx *fiber.Ctx) error {
 	if err := ctx.BodyParser(&namespace); err != nil {
 		return fiber.NewError(400, "unable to parse request body")
 	}
-	_, err := c.namespaceService.CreateNamespace(ctx.Context(), namespace.Code, namespace.Description)
+	_, err := c.namespaceService.CreateNamespace(
+		ctx.Context(), namespace.Code, namespace.Description, namespace.ArtifactRoot,
+	)
 	if err != nil {
 		return ctx.Render("namespaces/create", fiber.Map{
 			"Namespace": namespace,
@@ -72,7 +88,9 @@ func (c Controller) UpdateNamespace(ctx *fiber.Ctx) error {
 		return fiber.NewError(400, "unable to parse request body")
 	}
 
-	_, err = c.namespaceService.UpdateNamespace(ctx.Context(), uint(id), req.Code, req.Description)
+	_, err = c.namespaceService.UpdateNamespace(
+		ctx.Context(), uint(id), req.Code, req.Description, req.ArtifactRoot,
+	)
 	if err != nil {
 		return ctx.JSON(fiber.Map{
 			"status":  StatusError,
@@ -85,13 +103,13 @@ func (c Controller) UpdateNamespace(ctx *fiber.Ctx) error {
 	})
 }
 
-// DeleteNamespace deletes a namespace record.
+// DeleteNamespace deletes a namespace record along with all of its data, when `force` parameter is set.
 func (c Controller) DeleteNamespace(ctx *fiber.Ctx) error {
 	id, err := ctx.ParamsInt("id")
 	if err != nil {
 		return fiber.NewError(fiber.StatusUnprocessableEntity, "unable to parse id")
 	}
-	err = c.namespaceService.DeleteNamespace(ctx.Context(), uint(id))
+	err = c.namespaceService.DeleteNamespace(ctx.Context(), uint(id), ctx.QueryBool("force"))
 	if err != nil {
 		return ctx.JSON(fiber.Map{
 			"status":  StatusError,
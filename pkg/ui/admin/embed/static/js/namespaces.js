@@ -38,12 +38,12 @@ function redirectTo(path) {
 }
 
 function deleteNamespace(id) {
-  if (confirm("Are you sure?") != true ){
+  if (confirm("Are you sure? All experiments, runs, apps and dashboards of the namespace will be deleted.") != true ){
     return
   }
-  // Perform a DELETE request using jQuery's $.ajax
+  // Perform a DELETE request using jQuery's $.ajax, the confirmation above allows forcing the deletion.
   $.ajax({
-    url: `/admin/namespaces/${id}`,
+    url: `/admin/namespaces/${id}?force=true`,
     type: "DELETE",
     contentType: "application/json",
   }).done(handleResponse);
@@ -0,0 +1,10 @@
+package request
+
+// AuditLogs represents the criteria to list audit log entries.
+type AuditLogs struct {
+	NamespaceID uint   `query:"namespace_id"`
+	EntityType  string `query:"entity_type"`
+	EntityID    string `query:"entity_id"`
+	Action      string `query:"action"`
+	Limit       int    `query:"limit"`
+}
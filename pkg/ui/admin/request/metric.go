@@ -0,0 +1,9 @@
+package request
+
+// RenameMetric represents the request to rename a metric key of a Run.
+type RenameMetric struct {
+	NamespaceID uint   `json:"namespace_id"`
+	RunID       string `json:"run_id"`
+	Key         string `json:"key"`
+	NewKey      string `json:"new_key"`
+}
@@ -2,6 +2,7 @@ package request
 
 // Namespace represents the data to create an Namespace.
 type Namespace struct {
-	Code        string `json:"code"`
-	Description string `json:"description"`
+	Code         string `json:"code"`
+	Description  string `json:"description"`
+	ArtifactRoot string `json:"artifact_root" form:"artifact_root"`
 }
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// AuditLog represents an audit log entry.
+type AuditLog struct {
+	ID          uint      `json:"id"`
+	NamespaceID uint      `json:"namespace_id"`
+	Actor       string    `json:"actor"`
+	EntityType  string    `json:"entity_type"`
+	EntityID    string    `json:"entity_id"`
+	Action      string    `json:"action"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewAuditLogsResponse creates new list of AuditLog objects.
+func NewAuditLogsResponse(auditLogs []models.AuditLog) []AuditLog {
+	resp := make([]AuditLog, len(auditLogs))
+	for n, auditLog := range auditLogs {
+		resp[n] = AuditLog{
+			ID:          auditLog.ID,
+			NamespaceID: auditLog.NamespaceID,
+			Actor:       auditLog.Actor,
+			EntityType:  string(auditLog.EntityType),
+			EntityID:    auditLog.EntityID,
+			Action:      string(auditLog.Action),
+			CreatedAt:   auditLog.CreatedAt,
+		}
+	}
+	return resp
+}
@@ -2,13 +2,42 @@ package response
 
 import (
 	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
 // Namespace represents the data for viewing/editing a Namespace.
 type Namespace struct {
-	ID          uint       `json:"id"`
-	Code        string     `json:"code"`
-	Description string     `json:"description"`
-	CreatedAt   time.Time  `json:"created_at"`
-	DeletedAt   *time.Time `json:"deleted_at"`
+	ID           uint       `json:"id"`
+	Code         string     `json:"code"`
+	Description  string     `json:"description"`
+	ArtifactRoot string     `json:"artifact_root"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+}
+
+// NamespaceSummary represents the usage summary of a Namespace.
+type NamespaceSummary struct {
+	ID              uint   `json:"id"`
+	Code            string `json:"code"`
+	Description     string `json:"description"`
+	ExperimentCount int64  `json:"experiment_count"`
+	ActiveRunCount  int64  `json:"active_run_count"`
+}
+
+// NewNamespaceSummariesResponse creates new list of NamespaceSummary objects.
+func NewNamespaceSummariesResponse(
+	namespaces []models.Namespace, usage map[uint]models.NamespaceUsage,
+) []NamespaceSummary {
+	summaries := make([]NamespaceSummary, len(namespaces))
+	for n, namespace := range namespaces {
+		summaries[n] = NamespaceSummary{
+			ID:              namespace.ID,
+			Code:            namespace.Code,
+			Description:     namespace.Description,
+			ExperimentCount: usage[namespace.ID].ExperimentCount,
+			ActiveRunCount:  usage[namespace.ID].ActiveRunCount,
+		}
+	}
+	return summaries
 }
@@ -59,6 +59,18 @@ func (r *Router) Init(router fiber.Router) error {
 	namespaces.Put("/:id<int>/", r.controller.UpdateNamespace)
 	namespaces.Delete("/:id<int>/", r.controller.DeleteNamespace)
 
+	auditLogs := app.Group("audit-logs")
+	for _, globalMiddleware := range r.globalMiddlewares {
+		auditLogs.Use(globalMiddleware)
+	}
+	auditLogs.Get("/", r.controller.GetAuditLogs)
+
+	metrics := app.Group("metrics")
+	for _, globalMiddleware := range r.globalMiddlewares {
+		metrics.Use(globalMiddleware)
+	}
+	metrics.Post("/rename", r.controller.RenameMetric)
+
 	// default route
 	app.Use("/", etag.New(), filesystem.New(filesystem.Config{
 		Root: http.FS(sub),
@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// DefaultAuditLogsLimit is the number of audit log entries returned when no limit is requested.
+const DefaultAuditLogsLimit = 100
+
+// Service provides service layer to work with `audit log` business logic.
+type Service struct {
+	auditLogRepository repositories.AuditLogRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(auditLogRepository repositories.AuditLogRepositoryProvider) *Service {
+	return &Service{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// ListAuditLogs returns the newest audit log entries matching the criteria first.
+func (s Service) ListAuditLogs(ctx context.Context, req *request.AuditLogs) ([]models.AuditLog, error) {
+	if err := ValidateAuditLogsRequest(req); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultAuditLogsLimit
+	}
+	auditLogs, err := s.auditLogRepository.List(ctx, repositories.AuditLogFilter{
+		NamespaceID: req.NamespaceID,
+		EntityType:  models.AuditLogEntityType(req.EntityType),
+		EntityID:    req.EntityID,
+		Action:      models.AuditLogAction(req.Action),
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, eris.Wrap(err, "error listing audit logs")
+	}
+	return auditLogs, nil
+}
@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// MaxAuditLogsLimit is the maximum number of audit log entries returned at once.
+const MaxAuditLogsLimit = 1000
+
+// ValidateAuditLogsRequest validates the criteria to list audit log entries.
+func ValidateAuditLogsRequest(req *request.AuditLogs) error {
+	switch models.AuditLogEntityType(req.EntityType) {
+	case "", models.AuditLogEntityTypeExperiment, models.AuditLogEntityTypeRun, models.AuditLogEntityTypeNamespace:
+	default:
+		return api.NewInvalidParameterValueError("unsupported entity_type '%s'", req.EntityType)
+	}
+	switch models.AuditLogAction(req.Action) {
+	case "", models.AuditLogActionDelete, models.AuditLogActionRestore, models.AuditLogActionPurge:
+	default:
+		return api.NewInvalidParameterValueError("unsupported action '%s'", req.Action)
+	}
+	if req.Limit < 0 || req.Limit > MaxAuditLogsLimit {
+		return api.NewInvalidParameterValueError("limit has to be between 0 and %d", MaxAuditLogsLimit)
+	}
+	return nil
+}
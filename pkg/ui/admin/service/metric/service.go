@@ -0,0 +1,60 @@
+package metric
+
+import (
+	"context"
+	"errors"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	mlflowMetricService "github.com/G-Research/fasttrackml/pkg/api/mlflow/services/metric"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// Service provides service layer to work with `metric` business logic.
+type Service struct {
+	runRepository    repositories.RunRepositoryProvider
+	metricRepository repositories.MetricRepositoryProvider
+	historyCache     *mlflowMetricService.HistoryCache
+}
+
+// NewService creates new Service instance.
+// Optional `historyCache` is the cache of downsampled metric histories, which is invalidated on rename.
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	metricRepository repositories.MetricRepositoryProvider,
+	historyCache *mlflowMetricService.HistoryCache,
+) *Service {
+	return &Service{
+		runRepository:    runRepository,
+		metricRepository: metricRepository,
+		historyCache:     historyCache,
+	}
+}
+
+// RenameMetric renames the metric key of the Run in the namespace, keeping the metric history.
+func (s Service) RenameMetric(ctx context.Context, req *request.RenameMetric) error {
+	if err := ValidateRenameMetricRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunIDWithRelations(ctx, req.NamespaceID, req.RunID, nil)
+	if err != nil {
+		return api.NewInternalError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	if err := s.metricRepository.RenameKey(ctx, run.ID, req.Key, req.NewKey); err != nil {
+		switch {
+		case errors.As(err, &repositories.MetricKeyNotFoundError{}):
+			return api.NewResourceDoesNotExistError("unable to find metric '%s' of run '%s'", req.Key, run.ID)
+		case errors.As(err, &repositories.MetricKeyConflictError{}):
+			return api.NewResourceAlreadyExistsError("metric '%s' already exists for run '%s'", req.NewKey, run.ID)
+		default:
+			return api.NewInternalError("unable to rename metric '%s' of run '%s': %s", req.Key, run.ID, err)
+		}
+	}
+	s.historyCache.RemoveRun(run.ID)
+	return nil
+}
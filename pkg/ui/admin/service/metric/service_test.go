@@ -0,0 +1,120 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+func TestService_RenameMetric_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunIDWithRelations", context.TODO(), uint(1), "run", []string(nil),
+	).Return(&models.Run{ID: "run"}, nil)
+	metricRepository := repositories.MockMetricRepositoryProvider{}
+	metricRepository.On(
+		"RenameKey", context.TODO(), "run", "key", "new_key",
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &metricRepository, nil)
+	err := service.RenameMetric(context.TODO(), &request.RenameMetric{
+		NamespaceID: 1,
+		RunID:       "run",
+		Key:         "key",
+		NewKey:      "new_key",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	metricRepository.AssertExpectations(t)
+}
+
+func TestService_RenameMetric_Error(t *testing.T) {
+	req := request.RenameMetric{
+		NamespaceID: 1,
+		RunID:       "run",
+		Key:         "key",
+		NewKey:      "new_key",
+	}
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		service func() *Service
+	}{
+		{
+			name:  "RunNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find run 'run'"),
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations", context.TODO(), uint(1), "run", []string(nil),
+				).Return(nil, nil)
+				return NewService(&runRepository, &repositories.MockMetricRepositoryProvider{}, nil)
+			},
+		},
+		{
+			name:  "MetricKeyNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find metric 'key' of run 'run'"),
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations", context.TODO(), uint(1), "run", []string(nil),
+				).Return(&models.Run{ID: "run"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"RenameKey", context.TODO(), "run", "key", "new_key",
+				).Return(repositories.MetricKeyNotFoundError{RunID: "run", Key: "key"})
+				return NewService(&runRepository, &metricRepository, nil)
+			},
+		},
+		{
+			name:  "MetricKeyConflict",
+			error: api.NewResourceAlreadyExistsError("metric 'new_key' already exists for run 'run'"),
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations", context.TODO(), uint(1), "run", []string(nil),
+				).Return(&models.Run{ID: "run"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"RenameKey", context.TODO(), "run", "key", "new_key",
+				).Return(repositories.MetricKeyConflictError{RunID: "run", Key: "new_key"})
+				return NewService(&runRepository, &metricRepository, nil)
+			},
+		},
+		{
+			name:  "RenameKeyDatabaseError",
+			error: api.NewInternalError("unable to rename metric 'key' of run 'run': database error"),
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunIDWithRelations", context.TODO(), uint(1), "run", []string(nil),
+				).Return(&models.Run{ID: "run"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"RenameKey", context.TODO(), "run", "key", "new_key",
+				).Return(errors.New("database error"))
+				return NewService(&runRepository, &metricRepository, nil)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			err := tt.service().RenameMetric(context.TODO(), &req)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
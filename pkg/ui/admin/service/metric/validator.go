@@ -0,0 +1,29 @@
+package metric
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+// MaxMetricKeyLength is the maximum length of a metric key.
+const MaxMetricKeyLength = 250
+
+// ValidateRenameMetricRequest validates the request to rename a metric key of a Run.
+func ValidateRenameMetricRequest(req *request.RenameMetric) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	if req.NewKey == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'new_key'")
+	}
+	if len(req.NewKey) > MaxMetricKeyLength {
+		return api.NewInvalidParameterValueError("new_key has to be at most %d characters long", MaxMetricKeyLength)
+	}
+	if req.NewKey == req.Key {
+		return api.NewInvalidParameterValueError("new_key has to be different from key")
+	}
+	return nil
+}
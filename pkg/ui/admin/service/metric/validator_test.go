@@ -0,0 +1,62 @@
+package metric
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+)
+
+func TestValidateRenameMetricRequest_Ok(t *testing.T) {
+	err := ValidateRenameMetricRequest(&request.RenameMetric{
+		RunID:  "run",
+		Key:    "key",
+		NewKey: "new_key",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateRenameMetricRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.RenameMetric
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.RenameMetric{Key: "key", NewKey: "new_key"},
+		},
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.RenameMetric{RunID: "run", NewKey: "new_key"},
+		},
+		{
+			name:    "EmptyNewKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'new_key'"),
+			request: &request.RenameMetric{RunID: "run", Key: "key"},
+		},
+		{
+			name:    "NewKeyTooLong",
+			error:   api.NewInvalidParameterValueError("new_key has to be at most 250 characters long"),
+			request: &request.RenameMetric{RunID: "run", Key: "key", NewKey: strings.Repeat("a", 251)},
+		},
+		{
+			name:    "SameKey",
+			error:   api.NewInvalidParameterValueError("new_key has to be different from key"),
+			request: &request.RenameMetric{RunID: "run", Key: "key", NewKey: "key"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRenameMetricRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
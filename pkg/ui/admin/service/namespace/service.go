@@ -14,6 +14,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware"
 )
 
 // Service provides service layer to work with `namespace` business logic.
@@ -45,6 +46,15 @@ func (s Service) ListNamespaces(ctx context.Context) ([]models.Namespace, error)
 	return namespaces, nil
 }
 
+// GetNamespacesUsage returns the number of experiments and active runs of each namespace.
+func (s Service) GetNamespacesUsage(ctx context.Context) (map[uint]models.NamespaceUsage, error) {
+	usage, err := s.namespaceRepository.GetUsage(ctx)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting namespaces usage")
+	}
+	return usage, nil
+}
+
 // GetNamespace returns one namespace by ID.
 func (s Service) GetNamespace(ctx context.Context, id uint) (*models.Namespace, error) {
 	namespace, err := s.namespaceRepository.GetByID(ctx, id)
@@ -55,14 +65,20 @@ func (s Service) GetNamespace(ctx context.Context, id uint) (*models.Namespace,
 }
 
 // CreateNamespace creates a new namespace and default experiment.
-func (s Service) CreateNamespace(ctx context.Context, code, description string) (*models.Namespace, error) {
+func (s Service) CreateNamespace(
+	ctx context.Context, code, description, artifactRoot string,
+) (*models.Namespace, error) {
 	if err := ValidateNamespace(code); err != nil {
 		return nil, eris.Wrap(err, "error validating namespace")
 	}
+	if err := ValidateArtifactRoot(artifactRoot); err != nil {
+		return nil, eris.Wrap(err, "error validating namespace")
+	}
 
 	namespace := &models.Namespace{
 		Code:                code,
 		Description:         description,
+		ArtifactRoot:        artifactRoot,
 		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
 	}
 	if err := s.namespaceRepository.Create(ctx, namespace); err != nil {
@@ -89,7 +105,9 @@ func (s Service) CreateNamespace(ctx context.Context, code, description string)
 	}
 
 	// setup ArtifactLocation for default experiment.
-	path, err := url.JoinPath(s.config.DefaultArtifactRoot, fmt.Sprintf("%d", *experiment.ID))
+	path, err := url.JoinPath(
+		namespace.GetArtifactRoot(s.config.DefaultArtifactRoot), fmt.Sprintf("%d", *experiment.ID),
+	)
 	if err != nil {
 		return nil, api.NewInternalError(
 			"error creating artifact_location for experiment'%s': %s", experiment.Name, err,
@@ -105,8 +123,11 @@ func (s Service) CreateNamespace(ctx context.Context, code, description string)
 	return namespace, nil
 }
 
-// UpdateNamespace updates the code and description fields.
-func (s Service) UpdateNamespace(ctx context.Context, id uint, code, description string) (*models.Namespace, error) {
+// UpdateNamespace updates the code, description and artifact root fields.
+// The artifact root is only used for the experiments created after the update.
+func (s Service) UpdateNamespace(
+	ctx context.Context, id uint, code, description, artifactRoot string,
+) (*models.Namespace, error) {
 	namespace, err := s.namespaceRepository.GetByID(ctx, id)
 	if err != nil {
 		return nil, eris.Wrapf(err, "error finding namespace by id: %d", id)
@@ -117,8 +138,12 @@ func (s Service) UpdateNamespace(ctx context.Context, id uint, code, description
 	if err := ValidateNamespace(code); err != nil {
 		return nil, eris.Wrap(err, "error validating namespace code")
 	}
+	if err := ValidateArtifactRoot(artifactRoot); err != nil {
+		return nil, eris.Wrap(err, "error validating namespace artifact root")
+	}
 	namespace.Code = code
 	namespace.Description = description
+	namespace.ArtifactRoot = artifactRoot
 
 	if err := s.namespaceRepository.Update(ctx, namespace); err != nil {
 		return nil, eris.Wrap(err, "error updating namespace")
@@ -126,8 +151,9 @@ func (s Service) UpdateNamespace(ctx context.Context, id uint, code, description
 	return namespace, nil
 }
 
-// DeleteNamespace deletes the namespace.
-func (s Service) DeleteNamespace(ctx context.Context, id uint) error {
+// DeleteNamespace deletes the namespace along with all of its data.
+// The deletion can't be undone, so it has to be explicitly forced.
+func (s Service) DeleteNamespace(ctx context.Context, id uint, force bool) error {
 	namespace, err := s.namespaceRepository.GetByID(ctx, id)
 	if err != nil {
 		return eris.Wrapf(err, "error finding namespace by id: %d", id)
@@ -138,7 +164,13 @@ func (s Service) DeleteNamespace(ctx context.Context, id uint) error {
 	if namespace.IsDefault() {
 		return eris.Errorf("unable to delete default namespace")
 	}
-	if err := s.namespaceRepository.Delete(ctx, namespace); err != nil {
+	if !force {
+		return eris.Errorf(
+			"namespace '%s' deletion removes all of its data and has to be forced with 'force' parameter",
+			namespace.Code,
+		)
+	}
+	if err := s.namespaceRepository.Delete(ctx, namespace, middleware.GetActorFromContext(ctx)); err != nil {
 		return eris.Wrap(err, "error deleting namespace")
 	}
 	return nil
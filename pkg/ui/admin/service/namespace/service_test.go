@@ -68,12 +68,54 @@ func TestService_CreateNamespace_Ok(t *testing.T) {
 	service := NewService(&config.Config{
 		DefaultArtifactRoot: "default_artifact_root",
 	}, &namespaceRepository, &experimentRepository)
-	_, err := service.CreateNamespace(context.TODO(), "code", "description")
+	_, err := service.CreateNamespace(context.TODO(), "code", "description", "")
 
 	// compare results.
 	require.Nil(t, err)
 }
 
+func TestService_CreateNamespace_WithArtifactRoot_Ok(t *testing.T) {
+	// init repository mocks.
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"Create",
+		context.TODO(),
+		mock.MatchedBy(func(ns *models.Namespace) bool {
+			assert.Equal(t, "s3://bucket/code", ns.ArtifactRoot)
+			return true
+		}),
+	).Return(nil)
+	namespaceRepository.On("Update", context.TODO(), mock.Anything).Return(nil)
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"Create",
+		context.TODO(),
+		mock.MatchedBy(func(experiment *models.Experiment) bool {
+			experiment.ID = common.GetPointer(int32(1))
+			return true
+		}),
+	).Return(nil)
+	experimentRepository.On(
+		"Update",
+		context.TODO(),
+		mock.MatchedBy(func(experiment *models.Experiment) bool {
+			assert.Equal(t, "s3://bucket/code/1", experiment.ArtifactLocation)
+			return true
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&config.Config{
+		DefaultArtifactRoot: "default_artifact_root",
+	}, &namespaceRepository, &experimentRepository)
+	_, err := service.CreateNamespace(context.TODO(), "code", "description", "s3://bucket/code")
+
+	// compare results.
+	require.Nil(t, err)
+	experimentRepository.AssertExpectations(t)
+}
+
 func TestService_CreateNamespace_Error(t *testing.T) {
 	err := errors.New("repository error")
 
@@ -95,7 +137,7 @@ func TestService_CreateNamespace_Error(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	_, err = service.CreateNamespace(context.TODO(), "code", "description")
+	_, err = service.CreateNamespace(context.TODO(), "code", "description", "")
 
 	// compare results.
 	assert.NotNil(t, err)
@@ -189,6 +231,45 @@ func TestService_ListNamespaces_Error(t *testing.T) {
 	assert.Nil(t, namespaces)
 }
 
+func TestService_GetNamespacesUsage_Ok(t *testing.T) {
+	// init repository mocks.
+	usage := map[uint]models.NamespaceUsage{
+		1: {NamespaceID: 1, ExperimentCount: 2, ActiveRunCount: 3},
+	}
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"GetUsage", context.TODO(),
+	).Return(usage, nil)
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+
+	// call service under testing.
+	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
+	actualUsage, err := service.GetNamespacesUsage(context.TODO())
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, usage, actualUsage)
+}
+
+func TestService_GetNamespacesUsage_Error(t *testing.T) {
+	// init repository mocks.
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"GetUsage", context.TODO(),
+	).Return(nil, errors.New("repository error"))
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+
+	// call service under testing.
+	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
+	usage, err := service.GetNamespacesUsage(context.TODO())
+
+	// compare results.
+	assert.Nil(t, usage)
+	assert.Equal(t, "error getting namespaces usage: repository error", err.Error())
+}
+
 func TestService_DeleteNamespace_Ok(t *testing.T) {
 	// init repository mocks.
 	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
@@ -197,7 +278,7 @@ func TestService_DeleteNamespace_Ok(t *testing.T) {
 		Code: "code",
 	}
 	namespaceRepository.On(
-		"Delete", context.TODO(), &ns,
+		"Delete", context.TODO(), &ns, "",
 	).Return(nil).On(
 		"GetByID", context.TODO(), uint(0),
 	).Return(&ns, nil)
@@ -206,7 +287,7 @@ func TestService_DeleteNamespace_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	err := service.DeleteNamespace(context.TODO(), uint(0))
+	err := service.DeleteNamespace(context.TODO(), uint(0), true)
 
 	// compare results.
 	require.Nil(t, err)
@@ -223,7 +304,7 @@ func TestService_DeleteNamespace_Error(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	err := service.DeleteNamespace(context.TODO(), uint(0))
+	err := service.DeleteNamespace(context.TODO(), uint(0), true)
 
 	// compare results.
 	assert.NotNil(t, err)
@@ -244,13 +325,39 @@ func TestService_DeleteDefaultNamespace_Error(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	err := service.DeleteNamespace(context.TODO(), uint(0))
+	err := service.DeleteNamespace(context.TODO(), uint(0), true)
 
 	// compare results.
 	assert.NotNil(t, err)
 	assert.Equal(t, "unable to delete default namespace", err.Error())
 }
 
+func TestService_DeleteNamespaceWithoutForce_Error(t *testing.T) {
+	// init repository mocks.
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"GetByID", context.TODO(), uint(2),
+	).Return(&models.Namespace{
+		ID:   2,
+		Code: "code",
+	}, nil)
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+
+	// call service under testing.
+	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
+	err := service.DeleteNamespace(context.TODO(), uint(2), false)
+
+	// compare results.
+	assert.NotNil(t, err)
+	assert.Equal(
+		t,
+		"namespace 'code' deletion removes all of its data and has to be forced with 'force' parameter",
+		err.Error(),
+	)
+	namespaceRepository.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestService_UpdateNamespace_Ok(t *testing.T) {
 	// init repository mocks.
 	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
@@ -264,6 +371,7 @@ func TestService_UpdateNamespace_Ok(t *testing.T) {
 			assert.Equal(t, uint(1), ns.ID)
 			assert.Equal(t, "code", ns.Code)
 			assert.Equal(t, "description", ns.Description)
+			assert.Equal(t, "s3://bucket/code", ns.ArtifactRoot)
 			return true
 		}),
 	).Return(nil).On(
@@ -274,7 +382,7 @@ func TestService_UpdateNamespace_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	_, err := service.UpdateNamespace(context.TODO(), uint(1), "code", "description")
+	_, err := service.UpdateNamespace(context.TODO(), uint(1), "code", "description", "s3://bucket/code")
 
 	// compare results.
 	require.Nil(t, err)
@@ -291,7 +399,7 @@ func TestService_UpdateNamespace_Error(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&config.Config{}, &namespaceRepository, &experimentRepository)
-	_, err := service.UpdateNamespace(context.TODO(), uint(1), "code", "description")
+	_, err := service.UpdateNamespace(context.TODO(), uint(1), "code", "description", "")
 
 	// compare results.
 	assert.NotNil(t, err)
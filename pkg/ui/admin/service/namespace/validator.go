@@ -1,12 +1,17 @@
 package namespace
 
 import (
+	"net/url"
 	"regexp"
+	"slices"
 
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 )
 
-const namespaceValidationMessage = "namespace code is invalid -- must be 2-12 letters, numbers, dash, or underscore"
+const (
+	namespaceValidationMessage    = "namespace code is invalid -- must be 2-12 letters, numbers, dash, or underscore"
+	artifactRootValidationMessage = "namespace artifact root is invalid -- must be a path or a file, s3 or gs URI"
+)
 
 // validation rule for namespace code
 var validNamespaceCode = regexp.MustCompile(`^[\w\d-_]{2,12}$`)
@@ -18,3 +23,22 @@ func ValidateNamespace(code string) error {
 	}
 	return nil
 }
+
+// ValidateArtifactRoot validates namespace artifact root. Empty root is valid,
+// in which case the default artifact root is used for the namespace.
+func ValidateArtifactRoot(artifactRoot string) error {
+	if artifactRoot == "" {
+		return nil
+	}
+	parsed, err := url.Parse(artifactRoot)
+	if err != nil {
+		return api.NewInvalidParameterValueError(artifactRootValidationMessage)
+	}
+	if parsed.User != nil || parsed.RawQuery != "" || parsed.RawFragment != "" {
+		return api.NewInvalidParameterValueError(artifactRootValidationMessage)
+	}
+	if !slices.Contains([]string{"", "file", "s3", "gs"}, parsed.Scheme) {
+		return api.NewInvalidParameterValueError(artifactRootValidationMessage)
+	}
+	return nil
+}
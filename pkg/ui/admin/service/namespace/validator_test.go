@@ -49,3 +49,40 @@ func TestValidateUpdateRunRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateArtifactRoot_Ok(t *testing.T) {
+	for _, artifactRoot := range []string{"", "/artifacts", "file:///artifacts", "s3://bucket/path", "gs://bucket"} {
+		require.Nil(t, ValidateArtifactRoot(artifactRoot), artifactRoot)
+	}
+}
+
+func TestValidateArtifactRoot_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		request string
+	}{
+		{
+			name:    "UnsupportedScheme",
+			request: "ftp://host/path",
+		},
+		{
+			name:    "WithCredentials",
+			request: "s3://user:password@bucket",
+		},
+		{
+			name:    "WithQuery",
+			request: "s3://bucket?region=eu",
+		},
+		{
+			name:    "Unparsable",
+			request: "s3://bucket/%zz",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArtifactRoot(tt.request)
+			assert.Equal(t, api.NewInvalidParameterValueError(artifactRootValidationMessage), err)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	mlflowRequest "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListAuditLogsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestListAuditLogsTestSuite(t *testing.T) {
+	testSuite := new(ListAuditLogsTestSuite)
+	testSuite.Config = config.Config{
+		Auth: auth.Config{
+			AuthUsername: "auditor",
+			AuthPassword: "password",
+		},
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *ListAuditLogsTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), experiment)
+	s.Require().Nil(err)
+
+	// delete the run and the experiment and restore the run back.
+	s.doMlflowRequest(mlflow.RunsRoutePrefix+mlflow.RunsDeleteRoute, mlflowRequest.DeleteRunRequest{
+		RunID: run.ID,
+	})
+	s.doMlflowRequest(mlflow.ExperimentsRoutePrefix+mlflow.ExperimentsDeleteRoute, mlflowRequest.DeleteExperimentRequest{
+		ID: fmt.Sprintf("%d", *experiment.ID),
+	})
+	s.doMlflowRequest(mlflow.RunsRoutePrefix+mlflow.RunsRestoreRoute, mlflowRequest.RestoreRunRequest{
+		RunID: run.ID,
+	})
+
+	tests := []struct {
+		name     string
+		request  request.AuditLogs
+		expected []response.AuditLog
+	}{
+		{
+			name:    "ListAll",
+			request: request.AuditLogs{},
+			expected: []response.AuditLog{
+				{
+					NamespaceID: s.DefaultNamespace.ID,
+					Actor:       "auditor",
+					EntityType:  string(models.AuditLogEntityTypeRun),
+					EntityID:    run.ID,
+					Action:      string(models.AuditLogActionRestore),
+				},
+				{
+					NamespaceID: s.DefaultNamespace.ID,
+					Actor:       "auditor",
+					EntityType:  string(models.AuditLogEntityTypeExperiment),
+					EntityID:    fmt.Sprintf("%d", *experiment.ID),
+					Action:      string(models.AuditLogActionDelete),
+				},
+				{
+					NamespaceID: s.DefaultNamespace.ID,
+					Actor:       "auditor",
+					EntityType:  string(models.AuditLogEntityTypeRun),
+					EntityID:    run.ID,
+					Action:      string(models.AuditLogActionDelete),
+				},
+			},
+		},
+		{
+			name: "ListRunDeletions",
+			request: request.AuditLogs{
+				EntityType: string(models.AuditLogEntityTypeRun),
+				Action:     string(models.AuditLogActionDelete),
+			},
+			expected: []response.AuditLog{
+				{
+					NamespaceID: s.DefaultNamespace.ID,
+					Actor:       "auditor",
+					EntityType:  string(models.AuditLogEntityTypeRun),
+					EntityID:    run.ID,
+					Action:      string(models.AuditLogActionDelete),
+				},
+			},
+		},
+		{
+			name: "ListWithLimit",
+			request: request.AuditLogs{
+				Limit: 1,
+			},
+			expected: []response.AuditLog{
+				{
+					NamespaceID: s.DefaultNamespace.ID,
+					Actor:       "auditor",
+					EntityType:  string(models.AuditLogEntityTypeRun),
+					EntityID:    run.ID,
+					Action:      string(models.AuditLogActionRestore),
+				},
+			},
+		},
+		{
+			name: "ListOtherNamespace",
+			request: request.AuditLogs{
+				NamespaceID: s.DefaultNamespace.ID + 1,
+			},
+			expected: []response.AuditLog{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp []response.AuditLog
+			client := s.AdminClient()
+			s.Require().Nil(
+				client.WithHeaders(
+					s.authHeaders(),
+				).WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/audit-logs"),
+			)
+			s.Equal(http.StatusOK, client.GetStatusCode())
+			s.Require().Len(resp, len(tt.expected))
+			for i := range resp {
+				s.NotZero(resp[i].ID)
+				s.NotZero(resp[i].CreatedAt)
+				resp[i].ID, resp[i].CreatedAt = 0, tt.expected[i].CreatedAt
+			}
+			s.Equal(tt.expected, resp)
+		})
+	}
+}
+
+func (s *ListAuditLogsTestSuite) Test_AimActions_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), experiment)
+	s.Require().Nil(err)
+
+	// archive and restore the run, then delete it for good together with the experiment.
+	s.doAimRequest(http.MethodPost, "/runs/archive-batch?archive=true", []string{run.ID})
+	s.doAimRequest(http.MethodPost, "/runs/archive-batch?archive=false", []string{run.ID})
+	s.doAimRequest(http.MethodPost, "/runs/delete-batch", []string{run.ID})
+	s.doAimRequest(http.MethodDelete, fmt.Sprintf("/experiments/%d", *experiment.ID), nil)
+
+	var resp []response.AuditLog
+	s.Require().Nil(
+		s.AdminClient().WithHeaders(
+			s.authHeaders(),
+		).WithResponse(
+			&resp,
+		).DoRequest("/audit-logs"),
+	)
+	for i := range resp {
+		resp[i].ID, resp[i].CreatedAt = 0, time.Time{}
+	}
+	s.Equal([]response.AuditLog{
+		{
+			NamespaceID: s.DefaultNamespace.ID,
+			Actor:       "auditor",
+			EntityType:  string(models.AuditLogEntityTypeExperiment),
+			EntityID:    fmt.Sprintf("%d", *experiment.ID),
+			Action:      string(models.AuditLogActionPurge),
+		},
+		{
+			NamespaceID: s.DefaultNamespace.ID,
+			Actor:       "auditor",
+			EntityType:  string(models.AuditLogEntityTypeRun),
+			EntityID:    run.ID,
+			Action:      string(models.AuditLogActionPurge),
+		},
+		{
+			NamespaceID: s.DefaultNamespace.ID,
+			Actor:       "auditor",
+			EntityType:  string(models.AuditLogEntityTypeRun),
+			EntityID:    run.ID,
+			Action:      string(models.AuditLogActionRestore),
+		},
+		{
+			NamespaceID: s.DefaultNamespace.ID,
+			Actor:       "auditor",
+			EntityType:  string(models.AuditLogEntityTypeRun),
+			EntityID:    run.ID,
+			Action:      string(models.AuditLogActionDelete),
+		},
+	}, resp)
+}
+
+func (s *ListAuditLogsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request request.AuditLogs
+	}{
+		{
+			name: "UnsupportedEntityType",
+			request: request.AuditLogs{
+				EntityType: "model",
+			},
+		},
+		{
+			name: "UnsupportedAction",
+			request: request.AuditLogs{
+				Action: "update",
+			},
+		},
+		{
+			name: "LimitTooBig",
+			request: request.AuditLogs{
+				Limit: 1001,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client := s.AdminClient()
+			s.Require().Nil(
+				client.WithHeaders(
+					s.authHeaders(),
+				).WithQuery(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).DoRequest("/audit-logs"),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+		})
+	}
+}
+
+// authHeaders returns the headers to authenticate requests as the test user.
+func (s *ListAuditLogsTestSuite) authHeaders() map[string]string {
+	return map[string]string{
+		fiber.HeaderAccept:        fiber.MIMEApplicationJSON,
+		fiber.HeaderContentType:   fiber.MIMEApplicationJSON,
+		fiber.HeaderAuthorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("auditor:password")),
+	}
+}
+
+// doMlflowRequest makes the request to the mlflow endpoint as the test user.
+func (s *ListAuditLogsTestSuite) doMlflowRequest(path string, req any) {
+	client := s.MlflowClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithHeaders(
+			s.authHeaders(),
+		).WithRequest(
+			req,
+		).WithResponse(
+			&fiber.Map{},
+		).DoRequest(path),
+	)
+	s.Require().Equal(http.StatusOK, client.GetStatusCode())
+}
+
+// doAimRequest makes the request to the aim endpoint as the test user.
+func (s *ListAuditLogsTestSuite) doAimRequest(method, path string, req any) {
+	client := s.AIMClient()
+	s.Require().Nil(
+		client.WithMethod(
+			method,
+		).WithHeaders(
+			s.authHeaders(),
+		).WithRequest(
+			req,
+		).WithResponse(
+			&fiber.Map{},
+		).DoRequest(path),
+	)
+	s.Require().Equal(http.StatusOK, client.GetStatusCode())
+}
@@ -0,0 +1,184 @@
+package metric
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RenameMetricTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRenameMetricTestSuite(t *testing.T) {
+	suite.Run(t, new(RenameMetricTestSuite))
+}
+
+func (s *RenameMetricTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.RunFixtures.CreateMetrics(context.Background(), run, 2))
+	history, err := s.metricHistory(run.ID, "key1")
+	s.Require().Nil(err)
+	s.Require().Len(history, 2)
+
+	// 2. rename the metric key.
+	client := s.AdminClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithHeaders(
+			map[string]string{fiber.HeaderContentType: fiber.MIMEApplicationJSON},
+		).WithRequest(
+			request.RenameMetric{
+				NamespaceID: s.DefaultNamespace.ID,
+				RunID:       run.ID,
+				Key:         "key1",
+				NewKey:      "renamed",
+			},
+		).WithResponse(
+			&fiber.Map{},
+		).DoRequest("/metrics/rename"),
+	)
+	s.Equal(http.StatusOK, client.GetStatusCode())
+
+	// 3. check that the old key is gone and the history is preserved under the new key.
+	oldHistory, err := s.metricHistory(run.ID, "key1")
+	s.Require().Nil(err)
+	s.Empty(oldHistory)
+	newHistory, err := s.metricHistory(run.ID, "renamed")
+	s.Require().Nil(err)
+	s.Require().Len(newHistory, len(history))
+	for i := range history {
+		history[i].Key = "renamed"
+	}
+	s.ElementsMatch(history, newHistory)
+
+	latestMetrics, err := s.MetricFixtures.GetLatestMetricsByKey(context.Background(), "key1")
+	s.Require().Nil(err)
+	s.Empty(latestMetrics)
+	latestMetrics, err = s.MetricFixtures.GetLatestMetricsByKey(context.Background(), "renamed")
+	s.Require().Nil(err)
+	s.Require().Len(latestMetrics, 1)
+	s.Equal(run.ID, latestMetrics[0].RunID)
+
+	// 4. other metrics of the run are left untouched.
+	otherHistory, err := s.metricHistory(run.ID, "key2")
+	s.Require().Nil(err)
+	s.Len(otherHistory, 2)
+}
+
+func (s *RenameMetricTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.RunFixtures.CreateMetrics(context.Background(), run, 2))
+
+	tests := []struct {
+		name       string
+		request    request.RenameMetric
+		statusCode int
+	}{
+		{
+			name: "EmptyNewKey",
+			request: request.RenameMetric{
+				NamespaceID: s.DefaultNamespace.ID,
+				RunID:       run.ID,
+				Key:         "key1",
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "TargetKeyAlreadyExists",
+			request: request.RenameMetric{
+				NamespaceID: s.DefaultNamespace.ID,
+				RunID:       run.ID,
+				Key:         "key1",
+				NewKey:      "key2",
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "NotExistingKey",
+			request: request.RenameMetric{
+				NamespaceID: s.DefaultNamespace.ID,
+				RunID:       run.ID,
+				Key:         "not-existing",
+				NewKey:      "renamed",
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "RunInAnotherNamespace",
+			request: request.RenameMetric{
+				NamespaceID: s.DefaultNamespace.ID + 1,
+				RunID:       run.ID,
+				Key:         "key1",
+				NewKey:      "renamed",
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client := s.AdminClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithHeaders(
+					map[string]string{fiber.HeaderContentType: fiber.MIMEApplicationJSON},
+				).WithRequest(
+					tt.request,
+				).WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).DoRequest("/metrics/rename"),
+			)
+			s.Equal(tt.statusCode, client.GetStatusCode())
+		})
+	}
+
+	// metrics of the run are left untouched.
+	for _, key := range []string{"key1", "key2"} {
+		history, err := s.metricHistory(run.ID, key)
+		s.Require().Nil(err)
+		s.Len(history, 2)
+	}
+}
+
+// metricHistory returns the metric history of the run by the key.
+func (s *RenameMetricTestSuite) metricHistory(runID, key string) ([]models.Metric, error) {
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), runID)
+	if err != nil {
+		return nil, err
+	}
+	var history []models.Metric
+	for _, metric := range metrics {
+		if metric.Key == key {
+			history = append(history, *metric)
+		}
+	}
+	return history, nil
+}
@@ -2,6 +2,7 @@ package namespace
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -51,7 +53,7 @@ func (s *DeleteNamespaceTestSuite) Test_Ok() {
 				s.AdminClient().WithMethod(
 					http.MethodDelete,
 				).DoRequest(
-					"/namespaces/%d", ns2.ID,
+					"/namespaces/%d?force=true", ns2.ID,
 				),
 			)
 			namespaces, err := s.NamespaceFixtures.GetNamespaces(context.Background())
@@ -61,6 +63,136 @@ func (s *DeleteNamespaceTestSuite) Test_Ok() {
 	}
 }
 
+func (s *DeleteNamespaceTestSuite) Test_Cascade_Ok() {
+	tables := []string{
+		"experiments", "experiment_tags", "runs", "metrics", "latest_metrics", "params", "tags", "logs",
+		"shared_tags", "run_shared_tags", "apps", "dashboards",
+	}
+	countRows := func() map[string]int64 {
+		counts := make(map[string]int64, len(tables))
+		for _, table := range tables {
+			count, err := s.NamespaceFixtures.CountRows(context.Background(), table)
+			s.Require().Nil(err)
+			counts[table] = count
+		}
+		return counts
+	}
+
+	// data of the default namespace has to survive deletion of another namespace.
+	experiment := s.seedNamespaceData(s.DefaultNamespace, "default-run")
+	expectedCounts := countRows()
+
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "test2",
+		Description:         "test namespace 2 description",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	s.seedNamespaceData(namespace, "test2-run")
+	s.NotEqual(expectedCounts, countRows())
+
+	// run created after the runs of the deleted namespace has to be renumbered.
+	_, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "default-run-2",
+		Name:           "default-run-2",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+	expectedCounts["runs"]++
+
+	var resp map[string]any
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/namespaces/%d?force=true", namespace.ID,
+		),
+	)
+	s.Equal(map[string]any{"status": "success", "message": "Successfully deleted namespace."}, resp)
+	s.Equal(expectedCounts, countRows())
+
+	// namespace itself is removed for good rather than soft deleted.
+	namespaces, err := s.NamespaceFixtures.CountRows(context.Background(), "namespaces")
+	s.Require().Nil(err)
+	s.Equal(int64(1), namespaces)
+
+	run, err := s.RunFixtures.GetRun(context.Background(), "default-run-2")
+	s.Require().Nil(err)
+	s.Equal(models.RowNum(1), run.RowNum)
+
+	// deletion is recorded in the audit log, which outlives the namespace.
+	auditLogs, err := repositories.NewAuditLogRepository(s.GetDB()).List(
+		context.Background(), repositories.AuditLogFilter{NamespaceID: namespace.ID},
+	)
+	s.Require().Nil(err)
+	s.Require().Len(auditLogs, 1)
+	s.Equal(models.AuditLogEntityTypeNamespace, auditLogs[0].EntityType)
+	s.Equal(fmt.Sprintf("%d", namespace.ID), auditLogs[0].EntityID)
+	s.Equal(models.AuditLogActionPurge, auditLogs[0].Action)
+}
+
+// seedNamespaceData creates an experiment with a run holding metrics, params, tags, logs and shared tags,
+// along with an app and a dashboard in the namespace. The created experiment is returned.
+func (s *DeleteNamespaceTestSuite) seedNamespaceData(
+	namespace *models.Namespace, runID string,
+) *models.Experiment {
+	ctx := context.Background()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(ctx, &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+		Tags:           []models.ExperimentTag{{Key: "key", Value: "value"}},
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(ctx, &models.Run{
+		ID:             runID,
+		Name:           runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.MetricFixtures.CreateMetric(ctx, &models.Metric{
+		Key:   "metric",
+		Value: 1.1,
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.MetricFixtures.CreateLatestMetric(ctx, &models.LatestMetric{
+		Key:   "metric",
+		Value: 1.1,
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.ParamFixtures.CreateParam(ctx, &models.Param{
+		Key:      "param",
+		ValueStr: common.GetPointer("value"),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(ctx, &models.Tag{Key: "tag", Value: "value", RunID: run.ID})
+	s.Require().Nil(err)
+	_, err = s.LogFixtures.CreateLog(ctx, &models.Log{Value: "log", RunID: run.ID, Timestamp: 1})
+	s.Require().Nil(err)
+
+	sharedTag, err := s.SharedTagFixtures.CreateTag(ctx, "shared-tag", namespace.ID)
+	s.Require().Nil(err)
+	s.Require().Nil(s.SharedTagFixtures.Associate(ctx, sharedTag.ID.String(), run.ID))
+
+	_, err = s.DashboardFixtures.CreateDashboards(ctx, namespace, 1)
+	s.Require().Nil(err)
+	return experiment
+}
+
 func (s *DeleteNamespaceTestSuite) Test_Error() {
 	_, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
 		ID:                  2,
@@ -85,6 +217,16 @@ func (s *DeleteNamespaceTestSuite) Test_Error() {
 				"status":  "error",
 			},
 		},
+		{
+			name:                    "DeleteNamespaceWithoutForce",
+			ID:                      "2",
+			expectedNamespacesCount: 2,
+			response: map[string]any{
+				"message": "An unexpected error was encountered: " +
+					"namespace 'test2' deletion removes all of its data and has to be forced with 'force' parameter",
+				"status": "error",
+			},
+		},
 		{
 			name:                    "DeleteDefaultNamespace",
 			ID:                      "1",
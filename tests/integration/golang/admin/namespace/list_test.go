@@ -0,0 +1,94 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/ui/admin/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListNamespacesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestListNamespacesTestSuite(t *testing.T) {
+	suite.Run(t, new(ListNamespacesTestSuite))
+}
+
+func (s *ListNamespacesTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "test2",
+		Description:         "test namespace 2 description",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	// default namespace has the default experiment with one active run.
+	s.createRun(*s.DefaultExperiment.ID, "default-active", models.LifecycleStageActive)
+
+	// `test2` namespace has two experiments with three active runs and one deleted run.
+	for i := 0; i < 2; i++ {
+		experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+			Name:           fmt.Sprintf("Test Experiment %d", i),
+			NamespaceID:    namespace.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		s.createRun(*experiment.ID, fmt.Sprintf("test2-active-%d", i), models.LifecycleStageActive)
+		if i == 0 {
+			s.createRun(*experiment.ID, "test2-active-extra", models.LifecycleStageActive)
+			s.createRun(*experiment.ID, "test2-deleted", models.LifecycleStageDeleted)
+		}
+	}
+
+	var resp []response.NamespaceSummary
+	client := s.AdminClient()
+	s.Require().Nil(
+		client.WithHeaders(
+			map[string]string{
+				fiber.HeaderAccept: fiber.MIMEApplicationJSON,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/namespaces"),
+	)
+	s.Equal(http.StatusOK, client.GetStatusCode())
+	s.ElementsMatch([]response.NamespaceSummary{
+		{
+			ID:              s.DefaultNamespace.ID,
+			Code:            s.DefaultNamespace.Code,
+			Description:     s.DefaultNamespace.Description,
+			ExperimentCount: 1,
+			ActiveRunCount:  1,
+		},
+		{
+			ID:              namespace.ID,
+			Code:            "test2",
+			Description:     "test namespace 2 description",
+			ExperimentCount: 2,
+			ActiveRunCount:  3,
+		},
+	}, resp)
+}
+
+func (s *ListNamespacesTestSuite) createRun(experimentID int32, id string, lifecycleStage models.LifecycleStage) {
+	_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             id,
+		Name:           id,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   experimentID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: lifecycleStage,
+	})
+	s.Require().Nil(err)
+}
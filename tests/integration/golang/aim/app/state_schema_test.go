@@ -0,0 +1,177 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/services/app"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/pkg/server"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+const stateSchemaAppType = "state-schema-test"
+
+const stateSchema = `{
+	"type": "object",
+	"required": ["chart"],
+	"properties": {
+		"chart": {
+			"type": "object",
+			"additionalProperties": false,
+			"properties": {
+				"smoothing": {"type": "number", "minimum": 0, "maximum": 1},
+				"scale": {"enum": ["linear", "log"]}
+			}
+		},
+		"metrics": {
+			"type": "array",
+			"items": {"type": "string"}
+		}
+	}
+}`
+
+type AppStateSchemaTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestAppStateSchemaTestSuite(t *testing.T) {
+	stateSchemas := app.NewStateSchemaRegistry()
+	if err := stateSchemas.Register(stateSchemaAppType, []byte(stateSchema)); err != nil {
+		t.Fatal(err)
+	}
+	suite.Run(t, &AppStateSchemaTestSuite{
+		helpers.BaseTestSuite{
+			ServerOptions: []func(options *server.Options){
+				server.WithStateSchemaRegistry(stateSchemas),
+			},
+		},
+	})
+}
+
+func (s *AppStateSchemaTestSuite) Test_Ok() {
+	var resp response.App
+	client := s.AIMClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateAppRequest{
+				Type: stateSchemaAppType,
+				State: request.AppState{
+					"chart":   map[string]any{"smoothing": 0.5, "scale": "log"},
+					"metrics": []any{"loss", "accuracy"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/apps"),
+	)
+	s.Equal(http.StatusCreated, client.GetStatusCode())
+	s.Equal(stateSchemaAppType, resp.Type)
+}
+
+func (s *AppStateSchemaTestSuite) Test_Error() {
+	tests := []struct {
+		name  string
+		state request.AppState
+		error string
+	}{
+		{
+			name: "InvalidType",
+			state: request.AppState{
+				"chart": map[string]any{"smoothing": "high"},
+			},
+			error: "invalid state for app type 'state-schema-test': $.chart.smoothing: expected number, got string",
+		},
+		{
+			name: "InvalidArrayItem",
+			state: request.AppState{
+				"chart":   map[string]any{},
+				"metrics": []any{"loss", 1},
+			},
+			error: "invalid state for app type 'state-schema-test': $.metrics[1]: expected string, got number",
+		},
+		{
+			name: "SeveralErrors",
+			state: request.AppState{
+				"chart": map[string]any{"smoothing": 2, "scale": "exp", "unknown": true},
+			},
+			error: "invalid state for app type 'state-schema-test': " +
+				"$.chart.scale: value exp is not one of [linear log]; " +
+				"$.chart.smoothing: value 2 is greater than maximum 1; " +
+				"$.chart.unknown: additional property is not allowed",
+		},
+		{
+			name:  "MissingRequiredProperty",
+			state: request.AppState{},
+			error: "invalid state for app type 'state-schema-test': $.chart: missing required property",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			client := s.AIMClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.CreateAppRequest{
+						Type:  stateSchemaAppType,
+						State: tt.state,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest("/apps"),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+
+	// the state is validated on update as well.
+	existingApp, err := s.AppFixtures.CreateApp(context.Background(), &database.App{
+		Type:        "mpi",
+		State:       database.AppState{},
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	var resp api.ErrorResponse
+	client := s.AIMClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			request.UpdateAppRequest{
+				Type: stateSchemaAppType,
+				State: request.AppState{
+					"chart": map[string]any{"smoothing": -1},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/apps/%s", existingApp.ID),
+	)
+	s.Equal(http.StatusBadRequest, client.GetStatusCode())
+	s.Equal(
+		"invalid state for app type 'state-schema-test': $.chart.smoothing: value -1 is less than minimum 0",
+		resp.Message,
+	)
+}
+
+func (s *AppStateSchemaTestSuite) Test_UnsupportedKeyword() {
+	err := app.NewStateSchemaRegistry().Register(stateSchemaAppType, []byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "pattern": "^[a-z]+$"}
+		}
+	}`))
+	s.ErrorContains(err, `error parsing state schema for app type 'state-schema-test': json: unknown field "pattern"`)
+}
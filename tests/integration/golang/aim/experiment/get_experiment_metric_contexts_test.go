@@ -0,0 +1,169 @@
+package experiment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetExperimentMetricContextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetExperimentMetricContextsTestSuite(t *testing.T) {
+	suite.Run(t, &GetExperimentMetricContextsTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *GetExperimentMetricContextsTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	otherExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run := s.createRun(experiment)
+	s.createLatestMetric(run, "loss", `{"subset":"train"}`)
+	s.createLatestMetric(run, "loss", `{"subset":"test"}`)
+	s.createLatestMetric(run, "accuracy", `{"subset":"validation"}`)
+	s.createLatestMetric(s.createRun(experiment), "loss", `{"subset":"train"}`)
+	s.createLatestMetric(s.createRun(otherExperiment), "loss", `{"subset":"other"}`)
+
+	tests := []struct {
+		name     string
+		key      string
+		expected []map[string]any
+	}{
+		{
+			name:     "GetContextsOfMetricWithTwoContexts",
+			key:      "loss",
+			expected: []map[string]any{{"subset": "train"}, {"subset": "test"}},
+		},
+		{
+			name:     "GetContextsOfMetricWithSingleContext",
+			key:      "accuracy",
+			expected: []map[string]any{{"subset": "validation"}},
+		},
+		{
+			name:     "GetContextsOfUnknownMetric",
+			key:      "unknown",
+			expected: []map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.ExperimentMetricContexts
+			s.Require().Nil(
+				s.AIMClient().WithQuery(map[any]any{"key": tt.key}).WithResponse(&resp).DoRequest(
+					"/experiments/%d/metric-contexts", *experiment.ID,
+				),
+			)
+			contexts := make([]map[string]any, len(resp.Contexts))
+			for i, c := range resp.Contexts {
+				s.Require().Nil(json.Unmarshal(c, &contexts[i]))
+			}
+			s.ElementsMatch(tt.expected, contexts)
+		})
+	}
+}
+
+func (s *GetExperimentMetricContextsTestSuite) Test_Error() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		ID    any
+		name  string
+		query map[any]any
+		error *api.ErrorResponse
+	}{
+		{
+			ID:   *experiment.ID,
+			name: "GetWithoutMetricKey",
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'key'",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			ID:    "123",
+			name:  "GetInvalidExperimentID",
+			query: map[any]any{"key": "loss"},
+			error: &api.ErrorResponse{
+				Message:    "experiment '123' not found",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			ID:    "incorrect_experiment_id",
+			name:  "GetIncorrectExperimentID",
+			query: map[any]any{"key": "loss"},
+			error: &api.ErrorResponse{
+				Message:    `failed to decode: schema: error converting value for "id"`,
+				StatusCode: http.StatusUnprocessableEntity,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(s.AIMClient().WithQuery(tt.query).WithResponse(&resp).DoRequest(
+				"/experiments/%v/metric-contexts", tt.ID,
+			))
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func (s *GetExperimentMetricContextsTestSuite) createRun(experiment *models.Experiment) *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	return run
+}
+
+func (s *GetExperimentMetricContextsTestSuite) createLatestMetric(run *models.Run, key, metricContext string) {
+	_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:   key,
+		Value: 1.1,
+		RunID: run.ID,
+		Context: models.Context{
+			Json: types.JSONB(metricContext),
+		},
+	})
+	s.Require().Nil(err)
+}
@@ -0,0 +1,176 @@
+package experiment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetExperimentMetricSummaryTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetExperimentMetricSummaryTestSuite(t *testing.T) {
+	suite.Run(t, &GetExperimentMetricSummaryTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *GetExperimentMetricSummaryTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	otherExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	emptyExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run1, run2 := s.createRun(experiment), s.createRun(experiment)
+	s.createLatestMetric(run1, "loss", `{"subset":"train"}`, 0.5, false)
+	s.createLatestMetric(run2, "loss", `{"subset":"train"}`, 1.5, false)
+	s.createLatestMetric(run2, "loss", `{"subset":"val"}`, 2.0, false)
+	// NaN values are skipped.
+	s.createLatestMetric(s.createRun(experiment), "loss", `{"subset":"train"}`, 0, true)
+	s.createLatestMetric(s.createRun(otherExperiment), "loss", `{"subset":"train"}`, 10.0, false)
+
+	tests := []struct {
+		name         string
+		experimentID int32
+		expected     []response.ExperimentMetricSummaryPartial
+	}{
+		{
+			name:         "GetSummaryOfExperimentWithMetrics",
+			experimentID: *experiment.ID,
+			expected: []response.ExperimentMetricSummaryPartial{
+				{
+					Name:    "loss",
+					Context: json.RawMessage(`{"subset":"train"}`),
+					Count:   2,
+					Min:     0.5,
+					Max:     1.5,
+					Mean:    1.0,
+				},
+				{
+					Name:    "loss",
+					Context: json.RawMessage(`{"subset":"val"}`),
+					Count:   1,
+					Min:     2.0,
+					Max:     2.0,
+					Mean:    2.0,
+				},
+			},
+		},
+		{
+			name:         "GetSummaryOfExperimentWithoutMetrics",
+			experimentID: *emptyExperiment.ID,
+			expected:     []response.ExperimentMetricSummaryPartial{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.ExperimentMetricSummary
+			s.Require().Nil(
+				s.AIMClient().WithResponse(&resp).DoRequest("/experiments/%d/metric-summary", tt.experimentID),
+			)
+			s.Require().Len(resp.Metrics, len(tt.expected))
+			for i, expected := range tt.expected {
+				s.Equal(expected.Name, resp.Metrics[i].Name)
+				s.JSONEq(string(expected.Context), string(resp.Metrics[i].Context))
+				s.Equal(expected.Count, resp.Metrics[i].Count)
+				s.Equal(expected.Min, resp.Metrics[i].Min)
+				s.Equal(expected.Max, resp.Metrics[i].Max)
+				s.Equal(expected.Mean, resp.Metrics[i].Mean)
+			}
+		})
+	}
+}
+
+func (s *GetExperimentMetricSummaryTestSuite) Test_Error() {
+	tests := []struct {
+		ID    any
+		name  string
+		error *api.ErrorResponse
+	}{
+		{
+			ID:   "123",
+			name: "GetInvalidExperimentID",
+			error: &api.ErrorResponse{
+				Message:    "experiment '123' not found",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			ID:   "incorrect_experiment_id",
+			name: "GetIncorrectExperimentID",
+			error: &api.ErrorResponse{
+				Message:    `failed to decode: schema: error converting value for "id"`,
+				StatusCode: http.StatusUnprocessableEntity,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest(
+				"/experiments/%v/metric-summary", tt.ID,
+			))
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func (s *GetExperimentMetricSummaryTestSuite) createRun(experiment *models.Experiment) *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	return run
+}
+
+func (s *GetExperimentMetricSummaryTestSuite) createLatestMetric(
+	run *models.Run, key, metricContext string, value float64, isNan bool,
+) {
+	_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:   key,
+		Value: value,
+		IsNan: isNan,
+		RunID: run.ID,
+		Context: models.Context{
+			Json: types.JSONB(metricContext),
+		},
+	})
+	s.Require().Nil(err)
+}
@@ -0,0 +1,143 @@
+package experiment
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetExperimentTagFacetsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetExperimentTagFacetsTestSuite(t *testing.T) {
+	suite.Run(t, &GetExperimentTagFacetsTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *GetExperimentTagFacetsTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	otherExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	for _, tags := range []map[string]string{
+		{"model": "resnet", "optimizer": "adam"},
+		{"model": "vit", "optimizer": "adam"},
+		{"model": "bert", "optimizer": "sgd", "dataset": "imagenet"},
+	} {
+		s.createRunWithTags(experiment, tags)
+	}
+	s.createRunWithTags(otherExperiment, map[string]string{"model": "gpt", "owner": "other"})
+
+	tests := []struct {
+		name     string
+		query    map[any]any
+		expected map[string][]string
+	}{
+		{
+			name: "GetAllValues",
+			expected: map[string][]string{
+				"dataset":   {"imagenet"},
+				"model":     {"bert", "resnet", "vit"},
+				"optimizer": {"adam", "sgd"},
+			},
+		},
+		{
+			name:  "GetCappedValues",
+			query: map[any]any{"limit": 1},
+			expected: map[string][]string{
+				"dataset":   {"imagenet"},
+				"model":     {"bert"},
+				"optimizer": {"adam"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.ExperimentTagFacets
+			s.Require().Nil(
+				s.AIMClient().WithQuery(tt.query).WithResponse(&resp).DoRequest(
+					"/experiments/%d/tag-facets", *experiment.ID,
+				),
+			)
+			s.Equal(tt.expected, resp.Tags)
+		})
+	}
+}
+
+func (s *GetExperimentTagFacetsTestSuite) Test_Error() {
+	tests := []struct {
+		ID    string
+		name  string
+		error *api.ErrorResponse
+	}{
+		{
+			ID:   "123",
+			name: "GetInvalidExperimentID",
+			error: &api.ErrorResponse{
+				Message:    "experiment '123' not found",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+		{
+			ID:   "incorrect_experiment_id",
+			name: "GetIncorrectExperimentID",
+			error: &api.ErrorResponse{
+				Message:    `failed to decode: schema: error converting value for "id"`,
+				StatusCode: http.StatusUnprocessableEntity,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest(
+				"/experiments/%s/tag-facets", tt.ID,
+			))
+			s.Equal(tt.error.Message, resp.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func (s *GetExperimentTagFacetsTestSuite) createRunWithTags(experiment *models.Experiment, tags map[string]string) {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	for key, value := range tags {
+		s.Require().Nil(s.RunFixtures.CreateTag(context.Background(), models.Tag{
+			Key:   key,
+			Value: value,
+			RunID: run.ID,
+		}))
+	}
+}
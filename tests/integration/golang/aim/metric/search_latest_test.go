@@ -0,0 +1,196 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchLatestMetricsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchLatestMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchLatestMetricsTestSuite))
+}
+
+func (s *SearchLatestMetricsTestSuite) Test_Ok() {
+	// 5 runs, only run0, run2 and run4 have the `loss` metric without context.
+	runIDs := make([]string, 5)
+	for i := range runIDs {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("run%d", i),
+			Name:           fmt.Sprintf("run%d", i),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		runIDs[i] = run.ID
+	}
+	s.createLatestMetric(runIDs[0], "loss", 0.5, 10, nil)
+	s.createLatestMetric(runIDs[2], "loss", 0.3, 20, nil)
+	s.createLatestMetric(runIDs[4], "loss", 0.1, 30, nil)
+	s.createLatestMetric(runIDs[2], "loss", 0.7, 5, types.JSONB(`{"subset":"val"}`))
+	s.createLatestMetric(runIDs[1], "accuracy", 0.9, 10, nil)
+
+	tests := []struct {
+		name     string
+		request  request.SearchLatestMetricsRequest
+		response []response.LatestMetricResponse
+	}{
+		{
+			name: "WithoutContext",
+			request: request.SearchLatestMetricsRequest{
+				RunIDs: runIDs,
+				Name:   "loss",
+			},
+			response: []response.LatestMetricResponse{
+				{
+					RunID:     runIDs[0],
+					Value:     common.GetPointer(0.5),
+					Step:      common.GetPointer[int64](10),
+					Timestamp: common.GetPointer[int64](123456789),
+				},
+				{RunID: runIDs[1]},
+				{
+					RunID:     runIDs[2],
+					Value:     common.GetPointer(0.3),
+					Step:      common.GetPointer[int64](20),
+					Timestamp: common.GetPointer[int64](123456789),
+				},
+				{RunID: runIDs[3]},
+				{
+					RunID:     runIDs[4],
+					Value:     common.GetPointer(0.1),
+					Step:      common.GetPointer[int64](30),
+					Timestamp: common.GetPointer[int64](123456789),
+				},
+			},
+		},
+		{
+			name: "WithContext",
+			request: request.SearchLatestMetricsRequest{
+				RunIDs:  runIDs,
+				Name:    "loss",
+				Context: fiber.Map{"subset": "val"},
+			},
+			response: []response.LatestMetricResponse{
+				{RunID: runIDs[0]},
+				{RunID: runIDs[1]},
+				{
+					RunID:     runIDs[2],
+					Value:     common.GetPointer(0.7),
+					Step:      common.GetPointer[int64](5),
+					Timestamp: common.GetPointer[int64](123456789),
+				},
+				{RunID: runIDs[3]},
+				{RunID: runIDs[4]},
+			},
+		},
+		{
+			name: "RequestedOrderIsKeptAndNotExistingRunsAreSkipped",
+			request: request.SearchLatestMetricsRequest{
+				RunIDs: []string{runIDs[4], "not-existing", runIDs[3]},
+				Name:   "loss",
+			},
+			response: []response.LatestMetricResponse{
+				{
+					RunID:     runIDs[4],
+					Value:     common.GetPointer(0.1),
+					Step:      common.GetPointer[int64](30),
+					Timestamp: common.GetPointer[int64](123456789),
+				},
+				{RunID: runIDs[3]},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp []response.LatestMetricResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/latest/",
+				),
+			)
+			s.Equal(tt.response, resp)
+		})
+	}
+}
+
+func (s *SearchLatestMetricsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request request.SearchLatestMetricsRequest
+		error   string
+	}{
+		{
+			name: "MissingRunIDs",
+			request: request.SearchLatestMetricsRequest{
+				Name: "loss",
+			},
+			error: "Missing value for required parameter 'run_ids'",
+		},
+		{
+			name: "MissingName",
+			request: request.SearchLatestMetricsRequest{
+				RunIDs: []string{"id"},
+			},
+			error: "Missing value for required parameter 'name'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/latest/",
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+			s.Equal(http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func (s *SearchLatestMetricsTestSuite) createLatestMetric(
+	runID, key string, value float64, step int64, metricContext types.JSONB,
+) {
+	_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:       key,
+		Value:     value,
+		Timestamp: 123456789,
+		Step:      step,
+		RunID:     runID,
+		LastIter:  step,
+		Context: models.Context{
+			Json: metricContext,
+		},
+	})
+	s.Require().Nil(err)
+}
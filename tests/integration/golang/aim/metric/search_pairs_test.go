@@ -0,0 +1,198 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchMetricPairsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchMetricPairsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchMetricPairsTestSuite))
+}
+
+func (s *SearchMetricPairsTestSuite) Test_Ok() {
+	run1 := s.createRun("run1")
+	run2 := s.createRun("run2")
+
+	// run1 logs `loss` and `accuracy` over the shared steps 1-3, `loss` also has an extra step 4.
+	s.createMetric(run1.ID, "loss", 1, 0.9)
+	s.createMetric(run1.ID, "loss", 2, 0.5)
+	s.createMetric(run1.ID, "loss", 3, 0.3)
+	s.createMetric(run1.ID, "loss", 4, 0.2)
+	s.createMetric(run1.ID, "accuracy", 1, 0.1)
+	s.createMetric(run1.ID, "accuracy", 2, 0.6)
+	s.createMetric(run1.ID, "accuracy", 3, 0.8)
+	// run2 has `accuracy` at step 0 only, which `loss` doesn't have.
+	s.createMetric(run2.ID, "loss", 1, 0.7)
+	s.createMetric(run2.ID, "loss", 2, 0.4)
+	s.createMetric(run2.ID, "accuracy", 0, 0.0)
+	s.createMetric(run2.ID, "accuracy", 2, 0.7)
+
+	tests := []struct {
+		name     string
+		request  request.SearchMetricPairsRequest
+		response []response.MetricPairsResponse
+	}{
+		{
+			name: "SharedSteps",
+			request: request.SearchMetricPairsRequest{
+				RunIDs:  []string{run1.ID, run2.ID},
+				XMetric: "loss",
+				YMetric: "accuracy",
+			},
+			response: []response.MetricPairsResponse{
+				{
+					RunID:   run1.ID,
+					Context: json.RawMessage(`{}`),
+					Steps:   []int64{1, 2, 3},
+					X:       []*float64{common.GetPointer(0.9), common.GetPointer(0.5), common.GetPointer(0.3)},
+					Y:       []*float64{common.GetPointer(0.1), common.GetPointer(0.6), common.GetPointer(0.8)},
+				},
+				{
+					RunID:   run2.ID,
+					Context: json.RawMessage(`{}`),
+					Steps:   []int64{2},
+					X:       []*float64{common.GetPointer(0.4)},
+					Y:       []*float64{common.GetPointer(0.7)},
+				},
+			},
+		},
+		{
+			name: "SingleRun",
+			request: request.SearchMetricPairsRequest{
+				RunIDs:  []string{run2.ID},
+				XMetric: "accuracy",
+				YMetric: "loss",
+			},
+			response: []response.MetricPairsResponse{
+				{
+					RunID:   run2.ID,
+					Context: json.RawMessage(`{}`),
+					Steps:   []int64{2},
+					X:       []*float64{common.GetPointer(0.7)},
+					Y:       []*float64{common.GetPointer(0.4)},
+				},
+			},
+		},
+		{
+			name: "NotExistingMetric",
+			request: request.SearchMetricPairsRequest{
+				RunIDs:  []string{run1.ID},
+				XMetric: "loss",
+				YMetric: "not-existing",
+			},
+			response: []response.MetricPairsResponse{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp []response.MetricPairsResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/pairs/",
+				),
+			)
+			s.Equal(tt.response, resp)
+		})
+	}
+}
+
+func (s *SearchMetricPairsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		request request.SearchMetricPairsRequest
+		error   string
+	}{
+		{
+			name: "MissingRunIDs",
+			request: request.SearchMetricPairsRequest{
+				XMetric: "loss",
+				YMetric: "accuracy",
+			},
+			error: "Missing value for required parameter 'run_ids'",
+		},
+		{
+			name: "MissingXMetric",
+			request: request.SearchMetricPairsRequest{
+				RunIDs:  []string{"id"},
+				YMetric: "accuracy",
+			},
+			error: "Missing value for required parameter 'x_metric'",
+		},
+		{
+			name: "MissingYMetric",
+			request: request.SearchMetricPairsRequest{
+				RunIDs:  []string{"id"},
+				XMetric: "loss",
+			},
+			error: "Missing value for required parameter 'y_metric'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/search/metric/pairs/",
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+			s.Equal(http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
+func (s *SearchMetricPairsTestSuite) createRun(id string) *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             id,
+		Name:           id,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	return run
+}
+
+func (s *SearchMetricPairsTestSuite) createMetric(runID, key string, step int64, value float64) {
+	_, err := s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+		Key:       key,
+		Value:     value,
+		Timestamp: 123456789,
+		Step:      step,
+		RunID:     runID,
+		Iter:      step,
+		Context: models.Context{
+			Json: types.JSONB(`{}`),
+		},
+	})
+	s.Require().Nil(err)
+}
@@ -2064,6 +2064,8 @@ func (s *SearchMetricsTestSuite) Test_Ok() {
 						LastIter:  int64(decodedData[itersKey].([]float64)[0]),
 						ContextID: decodedContext.ID,
 						Context:   *decodedContext,
+						// the response doesn't contain the kind, all the fixtures are user metrics.
+						Kind: models.MetricKindUser,
 					}
 					decodedMetrics = append(decodedMetrics, &m)
 					metricCount++
@@ -0,0 +1,137 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/server"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchFilterTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchFilterTestSuite(t *testing.T) {
+	suite.Run(t, &SearchFilterTestSuite{
+		helpers.BaseTestSuite{
+			ServerOptions: []func(options *server.Options){
+				server.WithSearchRunsFilter(repositories.SearchRunsFilterFunc(
+					func(ctx context.Context, tx *gorm.DB) *gorm.DB {
+						return tx.Where(
+							"runs.run_uuid NOT IN (SELECT run_uuid FROM tags WHERE key = ? AND value = ?)",
+							"visibility", "private",
+						)
+					},
+				)),
+			},
+		},
+	})
+}
+
+func (s *SearchFilterTestSuite) Test_Ok() {
+	// 1. create test runs, only the run tagged as private has to be hidden by the filter.
+	for i, visibility := range []string{"public", "private", ""} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("id%d", i),
+			Name:           fmt.Sprintf("TestRun%d", i),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			StartTime:      sql.NullInt64{Int64: 123456789, Valid: true},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if visibility != "" {
+			s.Require().Nil(s.RunFixtures.CreateTag(context.Background(), models.Tag{
+				Key:   "visibility",
+				Value: visibility,
+				RunID: run.ID,
+			}))
+		}
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "loss",
+			Value:     0.1,
+			Timestamp: 123456789,
+			Step:      1,
+			RunID:     run.ID,
+			Iter:      1,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "loss",
+			Value:     0.1,
+			Timestamp: 123456789,
+			Step:      1,
+			RunID:     run.ID,
+			LastIter:  1,
+		})
+		s.Require().Nil(err)
+	}
+
+	// 2. check that the runs search respects the filter.
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchRunsRequest{
+				SkipSystem:      true,
+				ExperimentNames: []string{s.DefaultExperiment.Name},
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/run"),
+	)
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	s.Equal([]string{"id0", "id2"}, s.foundRunIDs(decodedData, "%s.props.name"))
+
+	// 3. check that the metrics search respects the filter.
+	resp = new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SearchMetricsRequest{
+				Metrics: []request.MetricTuple{
+					{
+						Key:     "loss",
+						Context: fiber.Map{},
+					},
+				},
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/metric"),
+	)
+	decodedData, err = encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	s.Equal([]string{"id0", "id2"}, s.foundRunIDs(decodedData, "%s.traces.0.name"))
+}
+
+// foundRunIDs returns the ids of the test runs presented in the decoded search response.
+func (s *SearchFilterTestSuite) foundRunIDs(decodedData map[string]any, keyFormat string) []string {
+	var ids []string
+	for _, id := range []string{"id0", "id1", "id2"} {
+		if decodedData[fmt.Sprintf(keyFormat, id)] != nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
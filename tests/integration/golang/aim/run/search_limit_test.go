@@ -0,0 +1,101 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/slices"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchLimitTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchLimitTestSuite(t *testing.T) {
+	testSuite := new(SearchLimitTestSuite)
+	testSuite.Config = config.Config{
+		SearchMaxResultsLimit: 3,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *SearchLimitTestSuite) Test_Ok() {
+	for i := 1; i <= 5; i++ {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         fmt.Sprintf("id%d", i),
+			Name:       fmt.Sprintf("TestRun%d", i),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			RowNum:     models.RowNum(i),
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name        string
+		limit       int
+		expectedIDs []string
+	}{
+		{
+			name:        "NoLimitIsClamped",
+			expectedIDs: []string{"id5", "id4", "id3"},
+		},
+		{
+			name:        "LimitBelowCap",
+			limit:       2,
+			expectedIDs: []string{"id5", "id4"},
+		},
+		{
+			name:        "LimitAboveCapIsClamped",
+			limit:       10,
+			expectedIDs: []string{"id5", "id4", "id3"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithQuery(
+					request.SearchRunsRequest{
+						Limit:           tt.limit,
+						ExperimentNames: []string{s.DefaultExperiment.Name},
+					},
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/run"),
+			)
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for i := 1; i <= 5; i++ {
+				id := fmt.Sprintf("id%d", i)
+				if slices.Contains(tt.expectedIDs, id) {
+					s.Equal(fmt.Sprintf("TestRun%d", i), decodedData[fmt.Sprintf("%s.props.name", id)])
+				} else {
+					s.Nil(decodedData[fmt.Sprintf("%s.props.name", id)])
+				}
+			}
+		})
+	}
+}
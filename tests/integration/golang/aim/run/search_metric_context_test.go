@@ -0,0 +1,126 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchMetricContextTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchMetricContextTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchMetricContextTestSuite))
+}
+
+func (s *SearchMetricContextTestSuite) Test_Ok() {
+	// the same key is logged under several contexts, run3 doesn't log it in the default context at all.
+	metrics := map[string]map[string]float64{
+		"run1": {`{}`: 0.1, `{"subset":"train"}`: 0.9, `{"subset":"val"}`: 0.9},
+		"run2": {`{}`: 0.9, `{"subset":"train"}`: 0.1, `{"subset":"val"}`: 0.5},
+		"run3": {`{"subset":"train"}`: 0.1},
+	}
+	for _, id := range []string{"run1", "run2", "run3"} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             id,
+			Name:           id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			StartTime:      sql.NullInt64{Int64: 123456789, Valid: true},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for metricContext, value := range metrics[id] {
+			_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+				Key:       "loss",
+				Value:     value,
+				Timestamp: 123456789,
+				Step:      1,
+				RunID:     run.ID,
+				LastIter:  1,
+				Context: models.Context{
+					Json: types.JSONB(metricContext),
+				},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "WithoutContextMatchesAnyContext",
+			query:       `run.metrics['loss'].last < 0.5`,
+			expectedIDs: []string{"run1", "run2", "run3"},
+		},
+		{
+			name:        "WithEmptyContextResolvesDefaultContext",
+			query:       `run.metrics['loss', {}].last < 0.5`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "WithContextResolvesThatContext",
+			query:       `run.metrics['loss', {"subset": "train"}].last < 0.5`,
+			expectedIDs: []string{"run2", "run3"},
+		},
+		{
+			name:        "DefaultAndOtherContext",
+			query:       `run.metrics['loss', {}].last < 0.5 and run.metrics['loss', {"subset": "train"}].last > 0.5`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "TwoOtherContexts",
+			query:       `run.metrics['loss', {"subset": "train"}].last < run.metrics['loss', {"subset": "val"}].last`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "MetricNotLoggedInDefaultContext",
+			query:       `run.metrics['loss', {}] is None`,
+			expectedIDs: []string{"run3"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(
+				s.AIMClient().WithResponseType(
+					helpers.ResponseTypeBuffer,
+				).WithQuery(
+					request.SearchRunsRequest{
+						Query:           tt.query,
+						SkipSystem:      true,
+						ExperimentNames: []string{s.DefaultExperiment.Name},
+					},
+				).WithResponse(
+					resp,
+				).DoRequest("/runs/search/run"),
+			)
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			var ids []string
+			for _, id := range []string{"run1", "run2", "run3"} {
+				if decodedData[fmt.Sprintf("%s.props.name", id)] != nil {
+					ids = append(ids, id)
+				}
+			}
+			s.Equal(tt.expectedIDs, ids)
+		})
+	}
+}
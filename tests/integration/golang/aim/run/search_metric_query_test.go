@@ -0,0 +1,542 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchMetricQueryTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchMetricQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchMetricQueryTestSuite))
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricContextNone() {
+	contexts := []fiber.Map{{"subset": nil}, {}, {"subset": "train"}}
+	s.createRunsWithContexts(contexts)
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "KeyEqualNoneMatchesNullValueAndAbsentKey",
+			query:       `metric.context.subset == None`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "KeyIsNoneMatchesNullValueAndAbsentKey",
+			query:       `metric.context.subset is None`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "KeyNotEqualNoneMatchesOnlyPresentValue",
+			query:       `metric.context.subset != None`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "KeyIsNotNoneMatchesOnlyPresentValue",
+			query:       `metric.context.subset is not None`,
+			expectedIDs: []string{"run2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchMetricsRunIDs(tt.query, contexts))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricContextNested() {
+	contexts := []fiber.Map{
+		{},
+		{"parent": "train"},
+		{"parent": fiber.Map{"nested": "train"}},
+		{"parent": fiber.Map{"nested": fiber.Map{"deep": "train"}}},
+	}
+	s.createRunsWithContexts(contexts)
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "TwoLevelKeyMatchesValue",
+			query:       `metric.context.parent.nested == "train"`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "TwoLevelKeyIsNoneMatchesAbsentKey",
+			query:       `metric.context.parent.nested is None`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "ThreeLevelKeyMatchesValue",
+			query:       `metric.context.parent.nested.deep == "train"`,
+			expectedIDs: []string{"run3"},
+		},
+		{
+			name:        "ThreeLevelKeyStartsWith",
+			query:       `metric.context.parent.nested.deep.startswith("tr")`,
+			expectedIDs: []string{"run3"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchMetricsRunIDs(tt.query, contexts))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricContextObject() {
+	contexts := []fiber.Map{{"a": 1, "b": 2}, {"a": 1}, {}}
+	s.createRunsWithContexts(contexts)
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "EqualMatchesWholeObjectRegardlessOfKeyOrder",
+			query:       `metric.context == {"b": 2, "a": 1}`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "EqualDoesNotMatchSuperset",
+			query:       `metric.context == {"a": 1}`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "EqualEmptyObject",
+			query:       `metric.context == {}`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "NotEqual",
+			query:       `metric.context != {"a": 1}`,
+			expectedIDs: []string{"run0", "run2"},
+		},
+		{
+			name:        "ContainsMatchesSuperset",
+			query:       `{"a": 1} in metric.context`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "NotContains",
+			query:       `{"b": 2} not in metric.context`,
+			expectedIDs: []string{"run1", "run2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchMetricsRunIDs(tt.query, contexts))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricStep() {
+	s.createRuns("run0", "run1", "run2")
+	s.createMetric(&models.Metric{RunID: "run0", Key: "loss", Step: 500, Iter: 501, Value: 0.1})
+	s.createMetric(&models.Metric{RunID: "run0", Key: "loss", Step: 600, Iter: 601, Value: 0.9})
+	s.createMetric(&models.Metric{RunID: "run1", Key: "loss", Step: 500, Iter: 501, Value: 0.9})
+	s.createMetric(&models.Metric{RunID: "run1", Key: "loss", Step: 600, Iter: 601, Value: 0.1})
+	s.createMetric(&models.Metric{RunID: "run2", Key: "loss", Step: 600, Iter: 601, Value: 0.1})
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "ValueAtStep",
+			query:       `run.metrics['loss', step=500].last < 0.5`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "ValueAtAnotherStep",
+			query:       `run.metrics['loss', step=600].last < 0.5`,
+			expectedIDs: []string{"run1", "run2"},
+		},
+		{
+			name:  "ValueAtNotLoggedStep",
+			query: `run.metrics['loss', step=700].last < 0.5`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricCount() {
+	s.createRuns("run0", "run1", "run2")
+	validation := types.JSONB(`{"subset":"val"}`)
+	s.createMetric(&models.Metric{RunID: "run0", Key: "loss", Step: 1, Value: 0.1})
+	s.createMetric(&models.Metric{RunID: "run0", Key: "loss", Step: 2, Value: 0.1})
+	s.createMetric(&models.Metric{RunID: "run0", Key: "loss", Step: 3, Value: 0.1})
+	s.createMetric(&models.Metric{RunID: "run0", Key: "gpu", Step: 1, Value: 50, Kind: models.MetricKindSystem})
+	s.createMetric(&models.Metric{RunID: "run1", Key: "loss", Step: 1, Value: 0.1})
+	s.createMetric(&models.Metric{
+		RunID: "run1", Key: "loss", Step: 1, Value: 0.1, Context: models.Context{Json: validation},
+	})
+	s.createMetric(&models.Metric{
+		RunID: "run1", Key: "loss", Step: 2, Value: 0.1, Context: models.Context{Json: validation},
+	})
+	s.createMetric(&models.Metric{RunID: "run2", Key: "acc", Step: 1, Value: 0.1})
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "GreaterThan",
+			query:       `len(run.metrics['loss']) > 2`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "WithEmptyContextCountsDefaultContextOnly",
+			query:       `len(run.metrics['loss', {}]) == 1`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "Equal",
+			query:       `len(run.metrics['loss']) == 0`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "Reversed",
+			query:       `3 > len(run.metrics['loss'])`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "WithContext",
+			query:       `len(run.metrics['loss', {"subset": "val"}]) >= 2`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "SystemMetric",
+			query:       `len(run.system_metrics['gpu']) == 1`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "Negated",
+			query:       `not len(run.metrics['loss']) > 2`,
+			expectedIDs: []string{"run2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricContextOperators() {
+	s.createRuns("run0", "run1", "run2", "run3", "run4")
+	for i, metricContext := range []string{`{"lr":0.001}`, `{"lr":0.05}`, `{"lr":1}`, `{"lr":"0.05"}`, `{}`} {
+		s.createLatestMetric(&models.LatestMetric{
+			RunID:   fmt.Sprintf("run%d", i),
+			Key:     "loss",
+			Value:   0.1,
+			Context: models.Context{Json: types.JSONB(metricContext)},
+		})
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "GreaterThan",
+			query:       `run.metrics['loss', {"lr": {">": 0.01}}].last < 0.5`,
+			expectedIDs: []string{"run1", "run2"},
+		},
+		{
+			name:        "Range",
+			query:       `run.metrics['loss', {"lr": {">": 0.01, "<=": 0.1}}].last < 0.5`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "EqualInteger",
+			query:       `run.metrics['loss', {"lr": {"==": 1}}].last < 0.5`,
+			expectedIDs: []string{"run2"},
+		},
+		{
+			name:        "NotEqualSkipsNonNumericValues",
+			query:       `run.metrics['loss', {"lr": {"!=": 1}}].last < 0.5`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_SystemMetrics() {
+	s.createRuns("run0", "run1")
+	s.createLatestMetric(&models.LatestMetric{RunID: "run0", Key: "gpu", Value: 90, Kind: models.MetricKindSystem})
+	s.createLatestMetric(&models.LatestMetric{RunID: "run1", Key: "gpu", Value: 90, Kind: models.MetricKindUser})
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "SystemMetricsSelectSystemKindOnly",
+			query:       `run.system_metrics['gpu'].last > 50`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "MetricsSelectAnyKind",
+			query:       `run.metrics['gpu'].last > 50`,
+			expectedIDs: []string{"run0", "run1"},
+		},
+		{
+			name:        "SystemMetricsAndMetricsWithTheSameKey",
+			query:       `run.system_metrics['gpu'].last > 50 and run.metrics['gpu'].last > 50`,
+			expectedIDs: []string{"run0"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_CrossMetricComparison() {
+	s.createRuns("run0", "run1", "run2")
+	s.createLatestMetric(&models.LatestMetric{RunID: "run0", Key: "val_acc", Value: 0.9, Step: 10, LastIter: 10})
+	s.createLatestMetric(&models.LatestMetric{RunID: "run0", Key: "train_acc", Value: 0.8, Step: 20, LastIter: 20})
+	s.createLatestMetric(&models.LatestMetric{RunID: "run1", Key: "val_acc", Value: 0.7, Step: 10, LastIter: 10})
+	s.createLatestMetric(&models.LatestMetric{RunID: "run1", Key: "train_acc", Value: 0.95, Step: 10, LastIter: 10})
+	s.createLatestMetric(&models.LatestMetric{RunID: "run2", Key: "train_acc", Value: 0.5, Step: 10, LastIter: 10})
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "GreaterThanOtherMetric",
+			query:       `run.metrics['val_acc'].last > run.metrics['train_acc'].last`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "LessThanOtherMetric",
+			query:       `run.metrics['val_acc'].last < run.metrics['train_acc'].last`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "EqualToOtherMetricAttribute",
+			query:       `run.metrics['val_acc'].last_step == run.metrics['train_acc'].last_step`,
+			expectedIDs: []string{"run1"},
+		},
+		{
+			name:        "NegatedComparisonWithOtherMetric",
+			query:       `not run.metrics['val_acc'].last > run.metrics['train_acc'].last`,
+			expectedIDs: []string{"run1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+func (s *SearchMetricQueryTestSuite) Test_MetricArithmetic() {
+	s.createRuns("run0", "run1", "run2")
+	for _, metric := range []*models.LatestMetric{
+		{RunID: "run0", Key: "step", Value: 200},
+		{RunID: "run0", Key: "loss", Value: 0.5},
+		{RunID: "run0", Key: "acc", Value: 0.25},
+		{RunID: "run1", Key: "step", Value: 150},
+		{RunID: "run1", Key: "loss", Value: 1.5},
+		{RunID: "run1", Key: "acc", Value: 0},
+		{RunID: "run2", Key: "step", Value: -300},
+		{RunID: "run2", Key: "loss", Value: 2.5},
+	} {
+		s.createLatestMetric(metric)
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "Modulo",
+			query:       `run.metrics['step'].last % 100 == 0`,
+			expectedIDs: []string{"run0", "run2"},
+		},
+		{
+			name:        "ModuloOfFloat",
+			query:       `run.metrics['loss'].last % 1 == 0.5`,
+			expectedIDs: []string{"run0", "run1", "run2"},
+		},
+		{
+			name:        "Addition",
+			query:       `run.metrics['loss'].last + 1 > 2`,
+			expectedIDs: []string{"run1", "run2"},
+		},
+		{
+			name:        "Precedence",
+			query:       `run.metrics['loss'].last + 1 * 2 < 3`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "DivisionByOtherMetric",
+			query:       `run.metrics['loss'].last / run.metrics['acc'].last == 2`,
+			expectedIDs: []string{"run0"},
+		},
+		{
+			name:        "NegatedArithmeticComparison",
+			query:       `not run.metrics['step'].last - 100 > 0`,
+			expectedIDs: []string{"run2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.Equal(tt.expectedIDs, s.searchRunIDs(tt.query))
+		})
+	}
+}
+
+// createRuns creates test runs with provided ids.
+func (s *SearchMetricQueryTestSuite) createRuns(ids ...string) {
+	for _, id := range ids {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             id,
+			Name:           id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			StartTime:      sql.NullInt64{Int64: 123456789, Valid: true},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+}
+
+// createRunsWithContexts creates a test run per provided context, each of them logs `loss` metric in that context.
+func (s *SearchMetricQueryTestSuite) createRunsWithContexts(contexts []fiber.Map) {
+	for i, metricContext := range contexts {
+		id := fmt.Sprintf("run%d", i)
+		s.createRuns(id)
+		data, err := json.Marshal(metricContext)
+		s.Require().Nil(err)
+		s.createMetric(&models.Metric{
+			RunID:   id,
+			Key:     "loss",
+			Value:   0.1,
+			Context: models.Context{Json: data},
+		})
+		s.createLatestMetric(&models.LatestMetric{
+			RunID:   id,
+			Key:     "loss",
+			Value:   0.1,
+			Context: models.Context{Json: data},
+		})
+	}
+}
+
+// createMetric creates test metric.
+func (s *SearchMetricQueryTestSuite) createMetric(metric *models.Metric) {
+	_, err := s.MetricFixtures.CreateMetric(context.Background(), metric)
+	s.Require().Nil(err)
+}
+
+// createLatestMetric creates test latest metric.
+func (s *SearchMetricQueryTestSuite) createLatestMetric(metric *models.LatestMetric) {
+	_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), metric)
+	s.Require().Nil(err)
+}
+
+// searchRunIDs returns the ids of the test runs found by the runs search with provided query.
+func (s *SearchMetricQueryTestSuite) searchRunIDs(query string) []string {
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchRunsRequest{
+				Query:           query,
+				SkipSystem:      true,
+				ExperimentNames: []string{s.DefaultExperiment.Name},
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/run"),
+	)
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	return s.foundRunIDs(decodedData, "%s.props.name")
+}
+
+// searchMetricsRunIDs returns the ids of the test runs, which have `loss` traces found by the metrics search
+// with provided query in any of provided contexts.
+func (s *SearchMetricQueryTestSuite) searchMetricsRunIDs(query string, contexts []fiber.Map) []string {
+	metrics := make([]request.MetricTuple, len(contexts))
+	for i, metricContext := range contexts {
+		metrics[i] = request.MetricTuple{
+			Key:     "loss",
+			Context: metricContext,
+		}
+	}
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SearchMetricsRequest{
+				Query:   query,
+				Metrics: metrics,
+			},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/metric"),
+	)
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+	return s.foundRunIDs(decodedData, "%s.traces.0.name")
+}
+
+// foundRunIDs returns the ids of the test runs presented in the decoded search response.
+func (s *SearchMetricQueryTestSuite) foundRunIDs(decodedData map[string]any, keyFormat string) []string {
+	var ids []string
+	for i := 0; i < 5; i++ {
+		if id := fmt.Sprintf("run%d", i); decodedData[fmt.Sprintf(keyFormat, id)] != nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,130 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchPaginationTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchPaginationTestSuite(t *testing.T) {
+	testSuite := new(SearchPaginationTestSuite)
+	testSuite.Config = config.Config{
+		SearchMaxResults: 2,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *SearchPaginationTestSuite) Test_Ok() {
+	for i := 1; i <= 5; i++ {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         fmt.Sprintf("id%d", i),
+			Name:       fmt.Sprintf("TestRun%d", i),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			RowNum:     models.RowNum(i),
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+
+		// the same metric is logged in two contexts, so the metric join repeats every run.
+		for _, metricContext := range []string{`{"subset":"train"}`, `{"subset":"val"}`} {
+			_, err := s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+				Key:       "loss",
+				Value:     float64(i),
+				Timestamp: 123456789,
+				Step:      1,
+				RunID:     run.ID,
+				LastIter:  1,
+				Context: models.Context{
+					Json: types.JSONB(metricContext),
+				},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{
+			name: "WithoutQuery",
+		},
+		{
+			name:  "WithJoinedQuery",
+			query: `run.metrics['loss'].last >= 0`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var pages [][]string
+			offset := ""
+			for {
+				resp := new(bytes.Buffer)
+				client := s.AIMClient()
+				s.Require().Nil(
+					client.WithResponseType(
+						helpers.ResponseTypeBuffer,
+					).WithQuery(
+						request.SearchRunsRequest{
+							Query:           tt.query,
+							Offset:          offset,
+							SkipSystem:      true,
+							ExperimentNames: []string{s.DefaultExperiment.Name},
+						},
+					).WithResponse(
+						resp,
+					).DoRequest("/runs/search/run"),
+				)
+
+				decodedData, err := encoding.NewDecoder(resp).Decode()
+				s.Require().Nil(err)
+
+				var page []string
+				for i := 5; i >= 1; i-- {
+					id := fmt.Sprintf("id%d", i)
+					if decodedData[fmt.Sprintf("%s.props.name", id)] != nil {
+						page = append(page, id)
+					}
+				}
+				for key := range decodedData {
+					if !strings.HasPrefix(key, "progress_") {
+						s.Regexp(`^id\d\.`, key)
+					}
+				}
+				pages = append(pages, page)
+
+				offset = client.GetResponseHeader(response.NextPageTokenHeader)
+				if offset == "" {
+					break
+				}
+				s.Require().Less(len(pages), 5, "pagination doesn't stop")
+			}
+			s.Equal([][]string{{"id5", "id4"}, {"id3", "id2"}, {"id1"}}, pages)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	mlflowRequest "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTagsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTagsTestSuite))
+}
+
+func (s *SearchTagsTestSuite) Test_Ok() {
+	s.True(s.TagFixtures.HasIndex("idx_tags_run_key_value"))
+
+	// seed a large tag set, every run has a `team` and a `stage` tag and a few unrelated ones.
+	const runsCount, extraTagsCount = 1000, 18
+	runs, err := s.RunFixtures.CreateRunsBatch(context.Background(), *s.DefaultExperiment.ID, runsCount)
+	s.Require().Nil(err)
+
+	tags := make([]models.Tag, 0, runsCount*(extraTagsCount+2))
+	for i, run := range runs {
+		stage := "dev"
+		if i%2 == 0 {
+			stage = "prod"
+		}
+		tags = append(
+			tags,
+			models.Tag{RunID: run.ID, Key: "team", Value: fmt.Sprintf("team%d", i%5)},
+			models.Tag{RunID: run.ID, Key: "stage", Value: stage},
+		)
+		for j := 0; j < extraTagsCount; j++ {
+			tags = append(tags, models.Tag{RunID: run.ID, Key: fmt.Sprintf("key%d", j), Value: fmt.Sprintf("value%d", i)})
+		}
+	}
+	s.Require().Nil(s.TagFixtures.CreateTagsBatch(context.Background(), tags))
+
+	resp := new(bytes.Buffer)
+	startedAt := time.Now()
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchRunsRequest{
+				Query:           `run.tags["team"] == "team3" and run.tags["stage"] == "prod"`,
+				Limit:           runsCount,
+				ExperimentNames: []string{s.DefaultExperiment.Name},
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/run"),
+	)
+	s.Less(time.Since(startedAt), 5*time.Second)
+
+	// the tag joins are served by an index instead of the tags table scan.
+	qp := query.QueryParser{
+		Tables:    map[string]string{"runs": "runs"},
+		Dialector: s.GetDB().Dialector.Name(),
+	}
+	pq, err := qp.Parse(`run.tags["team"] == "team3" and run.tags["stage"] == "prod"`)
+	s.Require().Nil(err)
+	plan, err := s.TagFixtures.GetQueryPlan(context.Background(), s.GetDB().ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return pq.Filter(tx.Table("runs")).Find(&[]models.Run{})
+	}))
+	s.Require().Nil(err)
+	s.NotRegexp(`SCAN tags_\d|Seq Scan on tags`, plan)
+	s.Regexp(`SEARCH tags_0 USING|Index.* on tags tags_0|Bitmap Heap Scan on tags tags_0`, plan)
+
+	decodedData, err := encoding.NewDecoder(resp).Decode()
+	s.Require().Nil(err)
+
+	var found int
+	for i, run := range runs {
+		name, ok := decodedData[fmt.Sprintf("%s.props.name", run.ID)]
+		if i%5 == 3 && i%2 == 0 {
+			s.Equal(run.Name, name)
+			found++
+		} else {
+			s.False(ok)
+		}
+	}
+	s.Equal(runsCount/10, found)
+}
+
+func (s *SearchTagsTestSuite) Test_LongValue() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	// tag values, like `mlflow.log-model.history`, are longer than the btree index row could be.
+	value := strings.Repeat("v", 4000)
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			mlflowRequest.SetRunTagRequest{
+				RunID: run.ID,
+				Key:   "mlflow.log-model.history",
+				Value: value,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSetTagRoute,
+		),
+	)
+	s.Empty(resp)
+
+	searchResp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithQuery(
+			request.SearchRunsRequest{
+				Query:           fmt.Sprintf(`run.tags["mlflow.log-model.history"] == "%s"`, value),
+				ExperimentNames: []string{s.DefaultExperiment.Name},
+			},
+		).WithResponse(
+			searchResp,
+		).DoRequest("/runs/search/run"),
+	)
+	decodedData, err := encoding.NewDecoder(searchResp).Decode()
+	s.Require().Nil(err)
+	s.Equal(run.Name, decodedData[fmt.Sprintf("%s.props.name", run.ID)])
+}
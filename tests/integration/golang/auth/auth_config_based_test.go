@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
@@ -19,6 +20,8 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/pkg/common/config/auth"
+	adminRequest "github.com/G-Research/fasttrackml/pkg/ui/admin/request"
+	adminResponse "github.com/G-Research/fasttrackml/pkg/ui/admin/response"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -430,3 +433,47 @@ func (s *ConfigAuthTestSuite) TestMlflowAuth_Ok() {
 		})
 	}
 }
+
+func (s *ConfigAuthTestSuite) TestAdminAuditActor_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		Description:         "Test namespace 1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	// delete the namespace on behalf of user3(admin).
+	headers := map[string]string{
+		"Authorization": fmt.Sprintf(
+			"Basic %s", base64.StdEncoding.EncodeToString([]byte("user3:user3password")),
+		),
+	}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithHeaders(
+			headers,
+		).DoRequest(
+			"/namespaces/%d?force=true", namespace.ID,
+		),
+	)
+
+	// check that the namespace deletion is recorded in the audit log together with its actor.
+	var resp []adminResponse.AuditLog
+	s.Require().Nil(
+		s.AdminClient().WithHeaders(
+			headers,
+		).WithQuery(
+			adminRequest.AuditLogs{
+				EntityType: string(models.AuditLogEntityTypeNamespace),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/audit-logs"),
+	)
+	s.Require().Len(resp, 1)
+	s.Equal("user3", resp[0].Actor)
+	s.Equal(fmt.Sprintf("%d", namespace.ID), resp[0].EntityID)
+	s.Equal(string(models.AuditLogActionPurge), resp[0].Action)
+}
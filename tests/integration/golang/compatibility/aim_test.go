@@ -39,6 +39,8 @@ func (s *AimTestSuite) SetupSuite() {
 		helpers.GetPostgresUri(),
 		1*time.Second,
 		20,
+		nil,
+		nil,
 	)
 	s.Nil(err)
 
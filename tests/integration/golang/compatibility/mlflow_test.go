@@ -41,6 +41,8 @@ func (s *MLflowTestSuite) SetupSuite() {
 		helpers.GetPostgresUri(),
 		1*time.Second,
 		20,
+		nil,
+		nil,
 	)
 	s.Nil(err)
 
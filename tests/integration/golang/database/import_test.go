@@ -54,6 +54,8 @@ func (s *ImportTestSuite) SetupSubTest() {
 		dsn,
 		1*time.Second,
 		20,
+		nil,
+		nil,
 	)
 	s.Require().Nil(err)
 	s.Require().Nil(database.CheckAndMigrateDB(true, db.GormDB()))
@@ -73,6 +75,8 @@ func (s *ImportTestSuite) SetupSubTest() {
 		dsn,
 		1*time.Second,
 		20,
+		nil,
+		nil,
 	)
 	s.Require().Nil(err)
 	s.Require().Nil(database.CheckAndMigrateDB(true, db.GormDB()))
@@ -55,6 +55,8 @@ func (s *MigrateTestSuite) TestMigrate() {
 				fmt.Sprintf("sqlite://%s", mlflowDBPath),
 				1*time.Second,
 				20,
+				nil,
+				nil,
 			)
 			s.Require().Nil(err)
 
@@ -46,6 +46,8 @@ func (s *MigrationsTestSuite) TestMigrations() {
 					dsn,
 					1*time.Second,
 					20,
+					nil,
+					nil,
 				)
 				s.Require().Nil(err)
 
@@ -108,6 +110,8 @@ func (s *MigrationsTestSuite) TestMigrations() {
 					dsn,
 					1*time.Second,
 					20,
+					nil,
+					nil,
 				)
 				s.Require().Nil(err)
 
@@ -1,6 +1,8 @@
 package fixtures
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 
@@ -21,9 +23,12 @@ func (f baseFixtures) TruncateTables() error {
 		return errors.Wrap(err, "error deleting from many2many table")
 	}
 	for _, table := range []interface{}{
+		mlflowModels.AuditLog{},
 		aimModels.Dashboard{},
 		aimModels.App{},
 		aimModels.SharedTag{},
+		mlflowModels.ModelVersion{},
+		mlflowModels.RegisteredModel{},
 		mlflowModels.Artifact{},
 		mlflowModels.Tag{},
 		mlflowModels.Param{},
@@ -31,6 +36,7 @@ func (f baseFixtures) TruncateTables() error {
 		mlflowModels.Metric{},
 		mlflowModels.Context{},
 		mlflowModels.Log{},
+		mlflowModels.RunIdempotencyKey{},
 		mlflowModels.Run{},
 		mlflowModels.ExperimentTag{},
 		mlflowModels.Experiment{},
@@ -48,3 +54,12 @@ func (f baseFixtures) TruncateTables() error {
 	}
 	return nil
 }
+
+// CountRows returns the number of rows of the table.
+func (f baseFixtures) CountRows(ctx context.Context, table string) (int64, error) {
+	var count int64
+	if err := f.db.WithContext(ctx).Table(table).Count(&count).Error; err != nil {
+		return 0, errors.Wrapf(err, "error counting rows of table: %s", table)
+	}
+	return count, nil
+}
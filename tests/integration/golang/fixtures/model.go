@@ -0,0 +1,55 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelFixtures represents data fixtures object.
+type ModelFixtures struct {
+	baseFixtures
+}
+
+// NewModelFixtures creates new instance of ModelFixtures.
+func NewModelFixtures(db *gorm.DB) (*ModelFixtures, error) {
+	return &ModelFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateRegisteredModel creates new test RegisteredModel.
+func (f ModelFixtures) CreateRegisteredModel(
+	ctx context.Context, registeredModel *models.RegisteredModel,
+) (*models.RegisteredModel, error) {
+	if err := f.db.WithContext(ctx).Omit("Namespace").Create(registeredModel).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test registered model")
+	}
+	return registeredModel, nil
+}
+
+// CreateModelVersion creates new test ModelVersion.
+func (f ModelFixtures) CreateModelVersion(
+	ctx context.Context, modelVersion *models.ModelVersion,
+) (*models.ModelVersion, error) {
+	if err := f.db.WithContext(ctx).Create(modelVersion).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test model version")
+	}
+	return modelVersion, nil
+}
+
+// GetModelVersion returns ModelVersion by Registered Model ID and version number.
+func (f ModelFixtures) GetModelVersion(
+	ctx context.Context, registeredModelID uint, version int64,
+) (*models.ModelVersion, error) {
+	var modelVersion models.ModelVersion
+	if err := f.db.WithContext(ctx).Where(
+		models.ModelVersion{RegisteredModelID: registeredModelID, Version: version},
+	).First(&modelVersion).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting model version: %d", version)
+	}
+	return &modelVersion, nil
+}
@@ -2,6 +2,7 @@ package fixtures
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -14,6 +15,33 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/common"
 )
 
+// RunOption customizes the n-th run created by RunFixtures.CreateRunsBatch.
+type RunOption func(n int, run *models.Run)
+
+// WithRunNamePrefix names the runs as `<prefix><n>`.
+func WithRunNamePrefix(prefix string) RunOption {
+	return func(n int, run *models.Run) {
+		run.Name = fmt.Sprintf("%s%d", prefix, n)
+	}
+}
+
+// WithRunStatus sets the same status for all the runs.
+func WithRunStatus(status models.Status) RunOption {
+	return func(_ int, run *models.Run) {
+		run.Status = status
+	}
+}
+
+// WithRunStartTime sets `start + n * step` as the start time of the runs.
+func WithRunStartTime(start, step int64) RunOption {
+	return func(n int, run *models.Run) {
+		run.StartTime = sql.NullInt64{
+			Int64: start + int64(n)*step,
+			Valid: true,
+		}
+	}
+}
+
 // RunFixtures represents data fixtures object.
 type RunFixtures struct {
 	baseFixtures
@@ -42,6 +70,51 @@ func (f RunFixtures) CreateRun(
 	return run, nil
 }
 
+// CreateRunsBatch creates `n` test Runs belonging to the experiment using bulk inserts.
+func (f RunFixtures) CreateRunsBatch(
+	ctx context.Context, experimentID int32, n int, opts ...RunOption,
+) ([]*models.Run, error) {
+	runs := make([]*models.Run, n)
+	for i := range runs {
+		runs[i] = &models.Run{
+			ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:           fmt.Sprintf("TestRun_%d", i),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   experimentID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		}
+		for _, opt := range opts {
+			opt(i, runs[i])
+		}
+	}
+	if n == 0 {
+		return runs, nil
+	}
+
+	// default row_num is calculated by a sub query, which gives the same value for all rows of a bulk insert,
+	// so row_num has to be assigned explicitly.
+	if err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("LOCK TABLE runs").Error; err != nil {
+				return err
+			}
+		}
+		var rowNum int64
+		if err := tx.Model(&models.Run{}).Select("COALESCE(MAX(row_num), -1)").Scan(&rowNum).Error; err != nil {
+			return err
+		}
+		for i, run := range runs {
+			run.RowNum = models.RowNum(rowNum + int64(i) + 1)
+		}
+		return tx.CreateInBatches(runs, 100).Error
+	}); err != nil {
+		return nil, eris.Wrap(err, "error creating test runs batch")
+	}
+	return runs, nil
+}
+
 // ArchiveRun archive existing runs by their ids.
 func (f RunFixtures) ArchiveRun(ctx context.Context, namespaceID uint, ids []string) error {
 	return f.runRepository.ArchiveBatch(ctx, namespaceID, ids)
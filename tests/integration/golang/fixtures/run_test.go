@@ -0,0 +1,54 @@
+package fixtures_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/fixtures"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateRunsBatchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateRunsBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateRunsBatchTestSuite))
+}
+
+func (s *CreateRunsBatchTestSuite) Test_Ok() {
+	runs, err := s.RunFixtures.CreateRunsBatch(
+		context.Background(),
+		*s.DefaultExperiment.ID,
+		250,
+		fixtures.WithRunNamePrefix("BatchRun"),
+		fixtures.WithRunStatus(models.StatusFinished),
+		fixtures.WithRunStartTime(1000, 10),
+	)
+	s.Require().Nil(err)
+	s.Require().Len(runs, 250)
+
+	storedRuns, err := s.RunFixtures.GetRuns(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(storedRuns, 250)
+
+	ids, rowNums := map[string]struct{}{}, map[models.RowNum]struct{}{}
+	for _, run := range storedRuns {
+		ids[run.ID] = struct{}{}
+		rowNums[run.RowNum] = struct{}{}
+	}
+	s.Len(ids, 250)
+	s.Len(rowNums, 250)
+
+	for n, run := range runs {
+		s.Contains(ids, run.ID)
+		s.Equal(*s.DefaultExperiment.ID, run.ExperimentID)
+		s.Equal(models.StatusFinished, run.Status)
+		s.Equal(fmt.Sprintf("BatchRun%d", n), run.Name)
+		s.Equal(int64(1000+n*10), run.StartTime.Int64)
+	}
+}
@@ -2,8 +2,11 @@ package fixtures
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/rotisserie/eris"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
@@ -29,6 +32,14 @@ func (f TagFixtures) CreateTag(ctx context.Context, tag *models.Tag) (*models.Ta
 	return tag, nil
 }
 
+// CreateTagsBatch creates test Tags using bulk inserts.
+func (f TagFixtures) CreateTagsBatch(ctx context.Context, tags []models.Tag) error {
+	if err := f.baseFixtures.db.WithContext(ctx).CreateInBatches(tags, 500).Error; err != nil {
+		return eris.Wrap(err, "error creating test tags batch")
+	}
+	return nil
+}
+
 // GetByRunID returns tag list by requested Run ID.
 func (f TagFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Tag, error) {
 	var tags []models.Tag
@@ -39,3 +50,48 @@ func (f TagFixtures) GetByRunID(ctx context.Context, runID string) ([]models.Tag
 	}
 	return tags, nil
 }
+
+// HasIndex checks that the tags table has the index with the provided name.
+func (f TagFixtures) HasIndex(name string) bool {
+	return f.db.Migrator().HasIndex(&models.Tag{}, name)
+}
+
+// GetQueryPlan returns the plan, which the database chooses for the query. Sequential scans are disabled
+// on Postgres, so the plan shows whether the query could use an index regardless of the table size.
+func (f TagFixtures) GetQueryPlan(ctx context.Context, query string) (string, error) {
+	var plan []string
+	if err := f.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		explain := "EXPLAIN QUERY PLAN "
+		if (tx.Dialector.Name() == postgres.Dialector{}.Name()) {
+			if err := tx.Exec("SET LOCAL enable_seqscan = off").Error; err != nil {
+				return eris.Wrap(err, "error disabling sequential scans")
+			}
+			explain = "EXPLAIN "
+		}
+		rows, err := tx.Raw(explain + query).Rows()
+		if err != nil {
+			return eris.Wrap(err, "error explaining query")
+		}
+		//nolint:errcheck
+		defer rows.Close()
+		columns, err := rows.Columns()
+		if err != nil {
+			return eris.Wrap(err, "error getting query plan columns")
+		}
+		for rows.Next() {
+			values := make([]any, len(columns))
+			for i := range values {
+				values[i] = new(any)
+			}
+			if err := rows.Scan(values...); err != nil {
+				return eris.Wrap(err, "error scanning query plan")
+			}
+			// the step description is the last column of both, Postgres and SQLite plans.
+			plan = append(plan, fmt.Sprint(*values[len(values)-1].(*any)))
+		}
+		return rows.Err()
+	}); err != nil {
+		return "", err
+	}
+	return strings.Join(plan, "\n"), nil
+}
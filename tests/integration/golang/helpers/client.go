@@ -31,17 +31,18 @@ const (
 
 // HttpClient represents HTTP client.
 type HttpClient struct {
-	server       server.Server
-	basePath     string
-	namespace    string
-	method       string
-	params       any
-	headers      map[string]string
-	cookies      map[string]string
-	request      any
-	response     any
-	responseType ResponseType
-	statusCode   int
+	server          server.Server
+	basePath        string
+	namespace       string
+	method          string
+	params          any
+	headers         map[string]string
+	cookies         map[string]string
+	request         any
+	response        any
+	responseType    ResponseType
+	statusCode      int
+	responseHeaders http.Header
 }
 
 // NewClient creates a new preconfigured HTTP client.
@@ -58,6 +59,11 @@ func NewClient(server server.Server, basePath string) *HttpClient {
 	}
 }
 
+// NewServerClient creates a new HTTP client for the server level endpoints
+func NewServerClient(server server.Server) *HttpClient {
+	return NewClient(server, "")
+}
+
 // NewMlflowApiClient creates a new HTTP client for the mlflow api
 func NewMlflowApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/api/2.0/mlflow")
@@ -131,13 +137,23 @@ func (c *HttpClient) GetStatusCode() int {
 	return c.statusCode
 }
 
+// GetResponseHeader returns HTTP header value of the last response, if available.
+func (c *HttpClient) GetResponseHeader(key string) string {
+	return c.responseHeaders.Get(key)
+}
+
 // DoRequest do actual HTTP request based on provided parameters.
 // nolint:gocyclo
 func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	// 1. check if request object were provided. if provided then marshal it.
+	// raw bytes are sent as is, which allows to send malformed request bodies.
 	var requestBody io.Reader
-	if c.request != nil {
-		data, err := json.Marshal(c.request)
+	switch request := c.request.(type) {
+	case nil:
+	case []byte:
+		requestBody = bytes.NewBuffer(request)
+	default:
+		data, err := json.Marshal(request)
 		if err != nil {
 			return eris.Wrap(err, "error marshaling request object")
 		}
@@ -216,6 +232,7 @@ func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	defer resp.Body.Close()
 
 	c.statusCode = resp.StatusCode
+	c.responseHeaders = resp.Header
 
 	// 9. read and check response data.
 	if c.response != nil {
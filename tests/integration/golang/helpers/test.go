@@ -7,6 +7,7 @@ import (
 	"dario.cat/mergo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
@@ -22,6 +23,7 @@ type BaseTestSuite struct {
 	suite.Suite
 	db                          database.DBProvider
 	Config                      config.Config
+	ServerOptions               []func(options *server.Options)
 	server                      server.Server
 	setupHooks                  []func()
 	tearDownHooks               []func()
@@ -29,9 +31,11 @@ type BaseTestSuite struct {
 	MlflowClient                func() *HttpClient
 	AdminClient                 func() *HttpClient
 	ChooserClient               func() *HttpClient
+	ServerClient                func() *HttpClient
 	AppFixtures                 *fixtures.AppFixtures
 	RunFixtures                 *fixtures.RunFixtures
 	LogFixtures                 *fixtures.LogFixtures
+	ModelFixtures               *fixtures.ModelFixtures
 	TagFixtures                 *fixtures.TagFixtures
 	ArtifactFixtures            *fixtures.ArtifactFixtures
 	SharedTagFixtures           *fixtures.SharedTagFixtures
@@ -77,6 +81,8 @@ func (s *BaseTestSuite) initDB() {
 		dsn,
 		1*time.Second,
 		20,
+		nil,
+		nil,
 	)
 	s.Require().Nil(err)
 }
@@ -139,6 +145,10 @@ func (s *BaseTestSuite) initFixtures() {
 	logFixtures, err := fixtures.NewLogFixtures(db)
 	s.Require().Nil(err)
 	s.LogFixtures = logFixtures
+
+	modelFixtures, err := fixtures.NewModelFixtures(db)
+	s.Require().Nil(err)
+	s.ModelFixtures = modelFixtures
 }
 
 func (s *BaseTestSuite) closeDB() {
@@ -158,7 +168,7 @@ func (s *BaseTestSuite) startServer() {
 	}
 	s.Require().Nil(mergo.Merge(&cfg, s.Config))
 
-	srv, err := server.NewServer(context.Background(), &cfg)
+	srv, err := server.NewServer(context.Background(), &cfg, s.ServerOptions...)
 	s.Require().Nil(err)
 	s.server = srv
 
@@ -174,6 +184,9 @@ func (s *BaseTestSuite) startServer() {
 	s.ChooserClient = func() *HttpClient {
 		return NewChooserApiClient(s.server)
 	}
+	s.ServerClient = func() *HttpClient {
+		return NewServerClient(s.server)
+	}
 }
 
 func (s *BaseTestSuite) stopServer() {
@@ -219,6 +232,10 @@ func (s *BaseTestSuite) resetDatabase() {
 	s.Require().Nil(s.NamespaceFixtures.TruncateTables())
 }
 
+func (s *BaseTestSuite) GetDB() *gorm.DB {
+	return s.db.GormDB()
+}
+
 func (s *BaseTestSuite) AddSetupHook(hook func()) {
 	s.setupHooks = append(s.setupHooks, hook)
 }
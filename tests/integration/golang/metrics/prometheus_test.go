@@ -0,0 +1,112 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PrometheusMetricsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestPrometheusMetricsTestSuite(t *testing.T) {
+	testSuite := new(PrometheusMetricsTestSuite)
+	testSuite.Config = config.Config{
+		MetricsEnabled: true,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *PrometheusMetricsTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		Description:         "Test namespace 1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	// make a few successful requests in the default namespace and one in namespace1.
+	for i := 0; i < 3; i++ {
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.SearchExperimentsRequest{},
+			).WithResponse(
+				&response.SearchExperimentsResponse{},
+			).DoRequest(
+				"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+			),
+		)
+	}
+	s.Require().Nil(
+		s.MlflowClient().WithNamespace(
+			namespace.Code,
+		).WithQuery(
+			request.SearchExperimentsRequest{},
+		).WithResponse(
+			&response.SearchExperimentsResponse{},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+		),
+	)
+
+	// and one failing request.
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{},
+		).WithResponse(
+			&api.ErrorResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+
+	client := s.ServerClient()
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		client.WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"/metrics",
+		),
+	)
+	s.Equal(http.StatusOK, client.GetStatusCode())
+
+	body := resp.String()
+	s.Contains(
+		body,
+		`fasttrackml_http_requests_total{method="GET",namespace="default",`+
+			`route="/api/2.0/mlflow/experiments/search",status="200"} 3`,
+	)
+	s.Contains(
+		body,
+		`fasttrackml_http_requests_total{method="GET",namespace="namespace1",`+
+			`route="/api/2.0/mlflow/experiments/search",status="200"} 1`,
+	)
+	s.Contains(
+		body,
+		`fasttrackml_http_requests_total{method="GET",namespace="default",`+
+			`route="/api/2.0/mlflow/runs/get",status="400"} 1`,
+	)
+	s.Contains(
+		body,
+		`fasttrackml_http_request_duration_seconds_count{method="GET",namespace="default",`+
+			`route="/api/2.0/mlflow/experiments/search",status="200"} 3`,
+	)
+	s.Contains(body, "go_sql_max_open_connections")
+}
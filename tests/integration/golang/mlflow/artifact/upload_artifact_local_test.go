@@ -0,0 +1,149 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UploadArtifactLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUploadArtifactLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(UploadArtifactLocalTestSuite))
+}
+
+func (s *UploadArtifactLocalTestSuite) Test_Ok() {
+	// 1. create test experiment and run.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    fmt.Sprintf("file://%s", runArtifactDir),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. upload the artifact into the subdirectory, which doesn't exist yet.
+	resp := response.UploadArtifactResponse{}
+	s.Require().Nil(s.MlflowClient().WithMethod(
+		http.MethodPost,
+	).WithQuery(
+		request.UploadArtifactRequest{
+			RunID: run.ID,
+			Path:  "model/weights.bin",
+		},
+	).WithRequest(
+		[]byte("content"),
+	).WithResponse(
+		&resp,
+	).DoRequest(
+		"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsUploadRoute,
+	))
+	s.Equal(response.UploadArtifactResponse{
+		File: response.FilePartialResponse{
+			Path:     "model/weights.bin",
+			FileSize: 7,
+		},
+	}, resp)
+
+	content, err := os.ReadFile(filepath.Join(runArtifactDir, "model", "weights.bin"))
+	s.Require().Nil(err)
+	s.Equal("content", string(content))
+
+	// 3. delete the artifact.
+	s.Require().Nil(s.MlflowClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		request.DeleteArtifactRequest{
+			RunID: run.ID,
+			Path:  "model/weights.bin",
+		},
+	).DoRequest(
+		"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDeleteRoute,
+	))
+	_, err = os.Stat(filepath.Join(runArtifactDir, "model", "weights.bin"))
+	s.ErrorIs(err, os.ErrNotExist)
+}
+
+func (s *UploadArtifactLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.UploadArtifactRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.UploadArtifactRequest{},
+		},
+		{
+			name:  "EmptyPath",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: request.UploadArtifactRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name:  "PathOutsideOfArtifactRoot",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.UploadArtifactRequest{
+				RunID: "run_id",
+				Path:  "../../other/artifacts/weights.bin",
+			},
+		},
+		{
+			name:  "NotFoundRun",
+			error: api.NewResourceDoesNotExistError("unable to find run 'run_id'"),
+			request: request.UploadArtifactRequest{
+				RunID: "run_id",
+				Path:  "weights.bin",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithQuery(
+				tt.request,
+			).WithRequest(
+				[]byte("content"),
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsUploadRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
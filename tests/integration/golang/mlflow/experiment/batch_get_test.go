@@ -0,0 +1,143 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetExperimentsBatchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetExperimentsBatchTestSuite(t *testing.T) {
+	suite.Run(t, &GetExperimentsBatchTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *GetExperimentsBatchTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	experiment1, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name: "Test Experiment 1",
+		Tags: []models.ExperimentTag{
+			{
+				Key:   "key1",
+				Value: "value1",
+			},
+		},
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	experiment2, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment 2",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	// experiment in another namespace is treated as missing.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+	experiment3, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment 3",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. request a mix of existing and missing experiments.
+	resp := response.GetExperimentsBatchResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.GetExperimentsBatchRequest{
+				IDs: []string{
+					fmt.Sprintf("%d", *experiment2.ID),
+					"1000000",
+					fmt.Sprintf("%d", *experiment3.ID),
+					fmt.Sprintf("%d", *experiment1.ID),
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsBatchGetRoute,
+		),
+	)
+
+	// 3. check that only the existing experiments of the namespace are returned in the requested order.
+	s.Require().Len(resp.Experiments, 2)
+	s.Equal(fmt.Sprintf("%d", *experiment2.ID), resp.Experiments[0].ID)
+	s.Equal(experiment2.Name, resp.Experiments[0].Name)
+	s.Equal(string(models.LifecycleStageDeleted), resp.Experiments[0].LifecycleStage)
+	s.Empty(resp.Experiments[0].Tags)
+	s.Equal(fmt.Sprintf("%d", *experiment1.ID), resp.Experiments[1].ID)
+	s.Equal(experiment1.Name, resp.Experiments[1].Name)
+	s.Equal(string(models.LifecycleStageActive), resp.Experiments[1].LifecycleStage)
+	s.Equal([]response.ExperimentTagPartialResponse{
+		{
+			Key:   "key1",
+			Value: "value1",
+		},
+	}, resp.Experiments[1].Tags)
+}
+
+func (s *GetExperimentsBatchTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetExperimentsBatchRequest
+	}{
+		{
+			name:    "EmptyIDsProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.GetExperimentsBatchRequest{},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect_id': strconv.ParseInt: parsing "incorrect_id": invalid syntax`,
+			),
+			request: &request.GetExperimentsBatchRequest{
+				IDs: []string{"incorrect_id"},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsBatchGetRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package experiment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RestoreExperimentsBatchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRestoreExperimentsBatchTestSuite(t *testing.T) {
+	suite.Run(t, &RestoreExperimentsBatchTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *RestoreExperimentsBatchTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	deletedExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Deleted Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	cascadeDeletedRun, err := s.RunFixtures.CreateExampleRun(context.Background(), deletedExperiment)
+	s.Require().Nil(err)
+	previouslyDeletedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "previously_deleted_run",
+		Name:           "previously_deleted_run",
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		ExperimentID:   *deletedExperiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageDeleted,
+		DeletedTime: sql.NullInt64{
+			Int64: 123456789,
+			Valid: true,
+		},
+	})
+	s.Require().Nil(err)
+
+	// delete the experiment through the API, so its runs are deleted together with it.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteExperimentRequest{
+				ID: fmt.Sprintf("%d", *deletedExperiment.ID),
+			},
+		).WithResponse(
+			&fiber.Map{},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsDeleteRoute,
+		),
+	)
+	run, err := s.RunFixtures.GetRun(context.Background(), cascadeDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(models.LifecycleStageDeleted, run.LifecycleStage)
+
+	anotherDeletedExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Another Deleted Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	activeExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Active Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. make actual API call.
+	req := request.RestoreExperimentsBatchRequest{
+		IDs: []string{
+			fmt.Sprintf("%d", *deletedExperiment.ID),
+			fmt.Sprintf("%d", *activeExperiment.ID),
+			"123",
+			"invalid_id",
+			fmt.Sprintf("%d", *anotherDeletedExperiment.ID),
+		},
+	}
+	resp := response.RestoreExperimentsBatchResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsBatchRestoreRoute,
+		),
+	)
+
+	// 3. check actual API response.
+	s.Equal([]response.RestoreExperimentResultPartialResponse{
+		{
+			ID:       fmt.Sprintf("%d", *deletedExperiment.ID),
+			Restored: true,
+		},
+		{
+			ID:    fmt.Sprintf("%d", *activeExperiment.ID),
+			Error: fmt.Sprintf("experiment '%d' is not deleted", *activeExperiment.ID),
+		},
+		{
+			ID:    "123",
+			Error: "unable to find experiment '123'",
+		},
+		{
+			ID:    "invalid_id",
+			Error: "unable to parse experiment id 'invalid_id'",
+		},
+		{
+			ID:       fmt.Sprintf("%d", *anotherDeletedExperiment.ID),
+			Restored: true,
+		},
+	}, resp.Results)
+
+	for _, experimentID := range []int32{*deletedExperiment.ID, *anotherDeletedExperiment.ID, *activeExperiment.ID} {
+		experiment, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+			context.Background(), s.DefaultNamespace.ID, experimentID,
+		)
+		s.Require().Nil(err)
+		s.Equal(models.LifecycleStageActive, experiment.LifecycleStage)
+	}
+
+	// runs deleted together with the experiment are restored, while runs deleted before stay deleted.
+	run, err = s.RunFixtures.GetRun(context.Background(), cascadeDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageActive, run.LifecycleStage)
+	s.False(run.DeletedTime.Valid)
+
+	run, err = s.RunFixtures.GetRun(context.Background(), previouslyDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageDeleted, run.LifecycleStage)
+	s.Equal(int64(123456789), run.DeletedTime.Int64)
+}
+
+func (s *RestoreExperimentsBatchTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.RestoreExperimentsBatchRequest
+	}{
+		{
+			name:    "EmptyIDsProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.RestoreExperimentsBatchRequest{},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsBatchRestoreRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CloneExperimentTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCloneExperimentTestSuite(t *testing.T) {
+	suite.Run(t, &CloneExperimentTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *CloneExperimentTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	source, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Source Experiment",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "/artifact/location",
+		Tags: []models.ExperimentTag{
+			{
+				Key:   "key1",
+				Value: "value1",
+			},
+			{
+				Key:   "key2",
+				Value: "value2",
+			},
+		},
+	})
+	s.Require().Nil(err)
+
+	// 2. make actual API call.
+	resp := response.CreateExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CloneExperimentRequest{
+				SourceID: fmt.Sprintf("%d", *source.ID),
+				Name:     "Cloned Experiment",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCloneRoute,
+		),
+	)
+	s.NotEmpty(resp.ID)
+	s.NotEqual(fmt.Sprintf("%d", *source.ID), resp.ID)
+
+	// 3. check that tags were copied into the new experiment.
+	clonedID, err := strconv.ParseInt(resp.ID, 10, 32)
+	s.Require().Nil(err)
+	cloned, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, int32(clonedID),
+	)
+	s.Require().Nil(err)
+	s.Equal("Cloned Experiment", cloned.Name)
+	s.Equal(models.LifecycleStageActive, cloned.LifecycleStage)
+	s.NotEqual(source.ArtifactLocation, cloned.ArtifactLocation)
+	s.Require().Len(cloned.Tags, 2)
+	s.True(helpers.CheckTagExists(cloned.Tags, "key1", "value1"))
+	s.True(helpers.CheckTagExists(cloned.Tags, "key2", "value2"))
+
+	// 4. check that the source experiment is untouched.
+	actualSource, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *source.ID,
+	)
+	s.Require().Nil(err)
+	s.Equal("Source Experiment", actualSource.Name)
+	s.Equal("/artifact/location", actualSource.ArtifactLocation)
+	s.Require().Len(actualSource.Tags, 2)
+	s.True(helpers.CheckTagExists(actualSource.Tags, "key1", "value1"))
+	s.True(helpers.CheckTagExists(actualSource.Tags, "key2", "value2"))
+}
+
+func (s *CloneExperimentTestSuite) Test_Error() {
+	source, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Source Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CloneExperimentRequest
+	}{
+		{
+			name:    "EmptySourceIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'source_experiment_id'"),
+			request: &request.CloneExperimentRequest{},
+		},
+		{
+			name:  "EmptyNameProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CloneExperimentRequest{
+				SourceID: fmt.Sprintf("%d", *source.ID),
+			},
+		},
+		{
+			name: "NotFoundSourceExperiment",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment '123': error getting experiment by id: 123: record not found",
+			),
+			request: &request.CloneExperimentRequest{
+				SourceID: "123",
+				Name:     "Cloned Experiment",
+			},
+		},
+		{
+			name:  "DuplicateName",
+			error: api.NewResourceAlreadyExistsError("experiment(name=Source Experiment) already exists"),
+			request: &request.CloneExperimentRequest{
+				SourceID: fmt.Sprintf("%d", *source.ID),
+				Name:     "Source Experiment",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCloneRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
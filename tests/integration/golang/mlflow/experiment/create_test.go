@@ -1,7 +1,9 @@
 package experiment
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -9,6 +11,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/controller"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -55,6 +58,101 @@ func (s *CreateExperimentTestSuite) Test_Ok() {
 	s.NotEmpty(resp.ID)
 }
 
+func (s *CreateExperimentTestSuite) Test_DuplicateName_Error() {
+	req := request.CreateExperimentRequest{
+		Name: "DuplicateExperimentName",
+	}
+	resp := response.CreateExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute,
+		),
+	)
+	s.NotEmpty(resp.ID)
+
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute,
+		),
+	)
+	s.Equal(api.ErrorCodeResourceAlreadyExists, string(errResp.ErrorCode))
+	s.Equal(
+		api.NewResourceAlreadyExistsError("experiment(name=DuplicateExperimentName) already exists").Error(),
+		errResp.Error(),
+	)
+}
+
+func (s *CreateExperimentTestSuite) Test_MalformedBody_Error() {
+	testData := []struct {
+		name  string
+		error *api.ErrorResponse
+		body  []byte
+	}{
+		{
+			name: "TruncatedJSON",
+			error: api.NewInvalidParameterValueError(
+				"Malformed JSON in request body at offset 17: unexpected end of JSON input",
+			),
+			body: []byte(`{"name": "name", `),
+		},
+		{
+			name: "InvalidJSON",
+			error: api.NewInvalidParameterValueError(
+				"Malformed JSON in request body at offset 10: invalid character 'x' looking for beginning of value",
+			),
+			body: []byte(`{"name": x}`),
+		},
+		{
+			name: "IncorrectPropertyType",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'name' supplied. Hint: Value was of type 'number'. " +
+					"See the API docs for more information about request parameters.",
+			),
+			body: []byte(`{"name": 1}`),
+		},
+		{
+			name: "TooLargeBody",
+			error: api.NewInvalidParameterValueError(
+				"Request body is too large: got %d bytes, but no more than %d bytes are allowed",
+				controller.MaxRequestBodySize+1, controller.MaxRequestBodySize,
+			),
+			body: []byte(fmt.Sprintf(`{"name": "%s"}`, strings.Repeat("a", controller.MaxRequestBodySize-11))),
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.body,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+			s.Equal(http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
+
 func (s *CreateExperimentTestSuite) Test_Error() {
 	testData := []struct {
 		name    string
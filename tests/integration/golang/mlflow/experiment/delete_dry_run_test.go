@@ -0,0 +1,96 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteExperimentDryRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteExperimentDryRunTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteExperimentDryRunTestSuite))
+}
+
+func (s *DeleteExperimentDryRunTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// two active runs with 4 metrics each and one already deleted run, which is not affected.
+	for _, lifecycleStage := range []models.LifecycleStage{
+		models.LifecycleStageActive, models.LifecycleStageActive, models.LifecycleStageDeleted,
+	} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:           "TestRun",
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *experiment.ID,
+			LifecycleStage: lifecycleStage,
+		})
+		s.Require().Nil(err)
+		s.Require().Nil(s.RunFixtures.CreateMetrics(context.Background(), run, 2))
+	}
+
+	// 2. make actual API call.
+	resp := response.DeleteExperimentDryRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithQuery(
+			map[any]any{"dry_run": true},
+		).WithRequest(
+			request.DeleteExperimentRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsDeleteRoute,
+		),
+	)
+
+	// 3. check actual API response.
+	s.Equal(response.DeleteExperimentDryRunResponse{
+		DryRun:      true,
+		Experiments: 1,
+		Runs:        2,
+		Metrics:     8,
+	}, resp)
+
+	// 4. check that neither the experiment nor its runs were deleted.
+	experiment, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageActive, experiment.LifecycleStage)
+
+	runs, err := s.RunFixtures.GetRuns(context.Background(), *experiment.ID)
+	s.Require().Nil(err)
+	s.Equal(3, len(runs))
+	activeRuns := 0
+	for _, run := range runs {
+		if run.LifecycleStage == models.LifecycleStageActive {
+			activeRuns++
+		}
+	}
+	s.Equal(2, activeRuns)
+}
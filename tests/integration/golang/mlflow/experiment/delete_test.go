@@ -59,6 +59,7 @@ func (s *DeleteExperimentTestSuite) Test_Ok() {
 	)
 	s.Require().Nil(err)
 	s.Equal(models.LifecycleStageDeleted, exp.LifecycleStage)
+	s.True(exp.DeletedTime.Valid)
 }
 
 func (s *DeleteExperimentTestSuite) Test_Error() {
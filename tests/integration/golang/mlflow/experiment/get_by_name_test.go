@@ -81,6 +81,64 @@ func (s *GetExperimentByNameTestSuite) Test_Ok() {
 	}
 }
 
+func (s *GetExperimentByNameTestSuite) Test_CaseInsensitive_Ok() {
+	// 1. prepare database with two experiments which names differ only by case.
+	oldestExperiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:        "Case Experiment",
+		NamespaceID: s.DefaultNamespace.ID,
+		CreationTime: sql.NullInt64{
+			Int64: time.Now().UTC().Add(-time.Hour).UnixMilli(),
+			Valid: true,
+		},
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "/artifact/location",
+	})
+	s.Require().Nil(err)
+	_, err = s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:        "case experiment",
+		NamespaceID: s.DefaultNamespace.ID,
+		CreationTime: sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		},
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "/artifact/location",
+	})
+	s.Require().Nil(err)
+
+	// 2. make actual API call, the oldest of case variants has to be returned.
+	resp := response.GetExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetExperimentRequest{
+				Name:            "CASE EXPERIMENT",
+				CaseInsensitive: true,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsGetByNameRoute,
+		),
+	)
+	s.Equal(fmt.Sprintf("%d", *oldestExperiment.ID), resp.Experiment.ID)
+	s.Equal(oldestExperiment.Name, resp.Experiment.Name)
+
+	// 3. without the option, the name has to match exactly.
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetExperimentRequest{
+				Name: "CASE EXPERIMENT",
+			},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsGetByNameRoute,
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError(`unable to find experiment 'CASE EXPERIMENT'`).Error(), errResp.Error())
+}
+
 func (s *GetExperimentByNameTestSuite) Test_Error() {
 	testData := []struct {
 		name    string
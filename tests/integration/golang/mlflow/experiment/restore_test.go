@@ -2,6 +2,7 @@ package experiment
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"testing"
@@ -33,10 +34,44 @@ func (s *RestoreExperimentTestSuite) Test_Ok() {
 	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
 		Name:           "Test Experiment",
 		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	cascadeDeletedRun, err := s.RunFixtures.CreateExampleRun(context.Background(), experiment)
+	s.Require().Nil(err)
+	previouslyDeletedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "previously_deleted_run",
+		Name:           "previously_deleted_run",
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    "artifact_uri",
 		LifecycleStage: models.LifecycleStageDeleted,
+		DeletedTime: sql.NullInt64{
+			Int64: 123456789,
+			Valid: true,
+		},
 	})
 	s.Require().Nil(err)
-	s.Equal(models.LifecycleStageDeleted, experiment.LifecycleStage)
+
+	// delete the experiment through the API, so its active runs are deleted together with it.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteExperimentRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+			},
+		).WithResponse(
+			&fiber.Map{},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsDeleteRoute,
+		),
+	)
+	run, err := s.RunFixtures.GetRun(context.Background(), cascadeDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Require().Equal(models.LifecycleStageDeleted, run.LifecycleStage)
+	s.Require().True(run.DeletedWithExperiment)
 
 	// 2. make actual API call.
 	req := request.RestoreExperimentRequest{
@@ -61,6 +96,19 @@ func (s *RestoreExperimentTestSuite) Test_Ok() {
 	)
 	s.Require().Nil(err)
 	s.Equal(models.LifecycleStageActive, exp.LifecycleStage)
+	s.False(exp.DeletedTime.Valid)
+
+	// only the run deleted together with the experiment is restored.
+	run, err = s.RunFixtures.GetRun(context.Background(), cascadeDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageActive, run.LifecycleStage)
+	s.False(run.DeletedTime.Valid)
+	s.False(run.DeletedWithExperiment)
+
+	run, err = s.RunFixtures.GetRun(context.Background(), previouslyDeletedRun.ID)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageDeleted, run.LifecycleStage)
+	s.Equal(int64(123456789), run.DeletedTime.Int64)
 }
 
 func (s *RestoreExperimentTestSuite) Test_Error() {
@@ -0,0 +1,132 @@
+package experiment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchExperimentsLastActivityTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchExperimentsLastActivityTestSuite(t *testing.T) {
+	suite.Run(t, &SearchExperimentsLastActivityTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *SearchExperimentsLastActivityTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	experiment1, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment 1",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	experiment2, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment 2",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	finishedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id1",
+		Name:           "TestRun1",
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		StartTime:      sql.NullInt64{Int64: 100, Valid: true},
+		EndTime:        sql.NullInt64{Int64: 500, Valid: true},
+		LastUpdateTime: sql.NullInt64{Int64: 500, Valid: true},
+		ExperimentID:   *experiment1.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id2",
+		Name:           "TestRun2",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		StartTime:      sql.NullInt64{Int64: 800, Valid: true},
+		LastUpdateTime: sql.NullInt64{Int64: 900, Valid: true},
+		ExperimentID:   *experiment1.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id3",
+		Name:           "TestRun3",
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		StartTime:      sql.NullInt64{Int64: 800, Valid: true},
+		LastUpdateTime: sql.NullInt64{Int64: 2000, Valid: true},
+		DeletedTime:    sql.NullInt64{Int64: 2000, Valid: true},
+		ExperimentID:   *experiment1.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	// 2. the newest update of the active runs is the last activity, experiments without runs have none.
+	s.Equal(map[string]int64{
+		fmt.Sprintf("%d", *experiment1.ID): 900,
+		fmt.Sprintf("%d", *experiment2.ID): 0,
+	}, s.searchLastActivity())
+
+	// 3. updating a run moves the last activity to the time of the update.
+	updateTime := time.Now().UTC().UnixMilli()
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateRunRequest{
+				RunID:   finishedRun.ID,
+				Status:  string(models.StatusFinished),
+				EndTime: 1000,
+			},
+		).WithResponse(
+			&response.UpdateRunResponse{},
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsUpdateRoute,
+		),
+	)
+	lastActivity := s.searchLastActivity()
+	s.GreaterOrEqual(lastActivity[fmt.Sprintf("%d", *experiment1.ID)], updateTime)
+	s.Equal(int64(0), lastActivity[fmt.Sprintf("%d", *experiment2.ID)])
+}
+
+// searchLastActivity searches the experiments and returns their last activity by experiment id.
+func (s *SearchExperimentsLastActivityTestSuite) searchLastActivity() map[string]int64 {
+	resp := response.SearchExperimentsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.SearchExperimentsRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+		),
+	)
+
+	lastActivity := make(map[string]int64, len(resp.Experiments))
+	for _, experiment := range resp.Experiments {
+		lastActivity[experiment.ID] = experiment.LastActivity
+	}
+	return lastActivity
+}
@@ -0,0 +1,94 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchExperimentsLimitTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchExperimentsLimitTestSuite(t *testing.T) {
+	suite.Run(t, &SearchExperimentsLimitTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+			Config: config.Config{
+				SearchMaxResults:      2,
+				SearchMaxResultsLimit: 3,
+			},
+		},
+	})
+}
+
+func (s *SearchExperimentsLimitTestSuite) Test_Ok() {
+	for i := 1; i <= 5; i++ {
+		_, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+			Name:           fmt.Sprintf("Test Experiment %d", i),
+			NamespaceID:    s.DefaultNamespace.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		request  request.SearchExperimentsRequest
+		expected []string
+	}{
+		{
+			name: "NoMaxResultsUsesConfiguredDefault",
+			request: request.SearchExperimentsRequest{
+				OrderBy: []string{"name ASC"},
+			},
+			expected: []string{"Test Experiment 1", "Test Experiment 2"},
+		},
+		{
+			name: "MaxResultsBelowLimit",
+			request: request.SearchExperimentsRequest{
+				OrderBy:    []string{"name ASC"},
+				MaxResults: 1,
+			},
+			expected: []string{"Test Experiment 1"},
+		},
+		{
+			name: "MaxResultsAboveLimitIsClamped",
+			request: request.SearchExperimentsRequest{
+				OrderBy:    []string{"name ASC"},
+				MaxResults: 10,
+			},
+			expected: []string{"Test Experiment 1", "Test Experiment 2", "Test Experiment 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchExperimentsResponse{}
+			client := s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute))
+			s.Equal(http.StatusOK, client.GetStatusCode())
+			names := make([]string, len(resp.Experiments))
+			for i, experiment := range resp.Experiments {
+				names[i] = experiment.Name
+			}
+			s.Equal(tt.expected, names)
+			// there are more experiments than a single page can hold, so the client can continue paging.
+			s.NotEmpty(resp.NextPageToken)
+		})
+	}
+}
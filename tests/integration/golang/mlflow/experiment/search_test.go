@@ -2,6 +2,8 @@ package experiment
 
 import (
 	"context"
+	"database/sql"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -10,6 +12,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
@@ -133,6 +136,208 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 	}
 }
 
+func (s *SearchExperimentsTestSuite) TestOrderBy_Ok() {
+	// 1. prepare database with test data, every creation time is shared by two experiments.
+	for _, ex := range []struct {
+		name         string
+		creationTime int64
+		tags         []models.ExperimentTag
+	}{
+		{name: "Test Experiment A", creationTime: 100, tags: []models.ExperimentTag{{Key: "team", Value: "nlp"}}},
+		{name: "Test Experiment B", creationTime: 200, tags: []models.ExperimentTag{{Key: "team", Value: "nlp"}}},
+		{name: "Test Experiment C", creationTime: 200},
+		{name: "Test Experiment D", creationTime: 100, tags: []models.ExperimentTag{{Key: "team", Value: "nlp"}}},
+	} {
+		_, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+			Name:           ex.name,
+			Tags:           ex.tags,
+			NamespaceID:    s.DefaultNamespace.ID,
+			CreationTime:   sql.NullInt64{Int64: ex.creationTime, Valid: true},
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		request  request.SearchExperimentsRequest
+		expected []string
+	}{
+		{
+			name: "SeveralClauses",
+			request: request.SearchExperimentsRequest{
+				OrderBy: []string{"creation_time DESC", "name ASC"},
+			},
+			expected: []string{"Test Experiment B", "Test Experiment C", "Test Experiment A", "Test Experiment D"},
+		},
+		{
+			name: "CommaSeparatedClause",
+			request: request.SearchExperimentsRequest{
+				OrderBy: []string{"attribute.creation_time ASC, attribute.name DESC"},
+			},
+			expected: []string{"Test Experiment D", "Test Experiment A", "Test Experiment C", "Test Experiment B"},
+		},
+		{
+			name: "WithTagFilter",
+			request: request.SearchExperimentsRequest{
+				Filter:  "tags.team = 'nlp'",
+				OrderBy: []string{"experiment_id DESC"},
+			},
+			expected: []string{"Test Experiment D", "Test Experiment B", "Test Experiment A"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchExperimentsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+				),
+			)
+
+			names := make([]string, len(resp.Experiments))
+			for i, exp := range resp.Experiments {
+				names[i] = exp.Name
+			}
+
+			s.Equal(tt.expected, names)
+		})
+	}
+}
+
+func (s *SearchExperimentsTestSuite) TestTagFilter_Ok() {
+	// 1. prepare database with test data.
+	experiments := []models.Experiment{
+		{
+			Name: "Test Experiment 1",
+			Tags: []models.ExperimentTag{
+				{Key: "team", Value: "nlp"},
+				{Key: "owner", Value: "alice"},
+			},
+		},
+		{
+			Name: "Test Experiment 2",
+			Tags: []models.ExperimentTag{
+				{Key: "team", Value: "vision"},
+			},
+		},
+		{
+			Name: "Test Experiment 3",
+			Tags: []models.ExperimentTag{
+				{Key: "team", Value: "nlp-research"},
+			},
+		},
+		{
+			Name: "Test Experiment 4",
+		},
+	}
+	for _, ex := range experiments {
+		_, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+			Name:           ex.Name,
+			Tags:           ex.Tags,
+			NamespaceID:    s.DefaultNamespace.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	// experiment with the same tags in another namespace should never be returned.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+	_, err = s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name: "Test Experiment 5",
+		Tags: []models.ExperimentTag{
+			{Key: "team", Value: "nlp"},
+		},
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name     string
+		request  request.SearchExperimentsRequest
+		expected []string
+	}{
+		{
+			name: "TestTagEqual",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.team = 'nlp'",
+			},
+			expected: []string{"Test Experiment 1"},
+		},
+		{
+			name: "TestTagNotEqual",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.team != 'nlp'",
+			},
+			expected: []string{"Test Experiment 2", "Test Experiment 3"},
+		},
+		{
+			name: "TestTagLike",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.team LIKE 'nlp%'",
+			},
+			expected: []string{"Test Experiment 1", "Test Experiment 3"},
+		},
+		{
+			name: "TestTagWithQuotedKey",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.`owner` = 'alice'",
+			},
+			expected: []string{"Test Experiment 1"},
+		},
+		{
+			name: "TestMultipleTagsAndAttribute",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.team LIKE 'nlp%' AND tags.owner = 'alice' AND name != 'Test Experiment 3'",
+			},
+			expected: []string{"Test Experiment 1"},
+		},
+		{
+			name: "TestNotExistingTag",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.not_existing = 'nlp'",
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchExperimentsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+				),
+			)
+
+			names := make([]string, len(resp.Experiments))
+			for i, exp := range resp.Experiments {
+				names[i] = exp.Name
+			}
+
+			s.ElementsMatch(tt.expected, names)
+		})
+	}
+}
+
 func (s *SearchExperimentsTestSuite) Test_Error() {
 	testData := []struct {
 		name    string
@@ -207,6 +412,13 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 				OrderBy: []string{"invalid_attribute"},
 			},
 		},
+		{
+			name:  "DuplicateOrderByAttribute",
+			error: api.NewInvalidParameterValueError("duplicate order_by attribute 'name'"),
+			request: request.SearchExperimentsRequest{
+				OrderBy: []string{"name ASC, name DESC"},
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -0,0 +1,122 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SetExperimentTagLengthTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSetExperimentTagLengthTestSuite(t *testing.T) {
+	testSuite := new(SetExperimentTagLengthTestSuite)
+	testSuite.Config = config.Config{
+		TagKeyMaxLength:   10,
+		TagValueMaxLength: 20,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *SetExperimentTagLengthTestSuite) Test_Ok() {
+	experiment := s.createExperiment()
+
+	key, value := strings.Repeat("k", 10), strings.Repeat("v", 20)
+	client := s.MlflowClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetExperimentTagRequest{
+				ID:    fmt.Sprintf("%d", *experiment.ID),
+				Key:   key,
+				Value: value,
+			},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSetExperimentTag,
+		),
+	)
+	s.Equal(http.StatusOK, client.GetStatusCode())
+
+	experiment, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.True(helpers.CheckTagExists(experiment.Tags, key, value))
+}
+
+func (s *SetExperimentTagLengthTestSuite) Test_Error() {
+	experiment := s.createExperiment()
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetExperimentTagRequest
+	}{
+		{
+			name:  "KeyIsTooLong",
+			error: api.NewInvalidParameterValueError("'key' parameter can't be longer than 10 characters"),
+			request: request.SetExperimentTagRequest{
+				ID:    fmt.Sprintf("%d", *experiment.ID),
+				Key:   strings.Repeat("k", 11),
+				Value: strings.Repeat("v", 20),
+			},
+		},
+		{
+			name:  "ValueIsTooLong",
+			error: api.NewInvalidParameterValueError("'value' parameter can't be longer than 20 characters"),
+			request: request.SetExperimentTagRequest{
+				ID:    fmt.Sprintf("%d", *experiment.ID),
+				Key:   strings.Repeat("k", 10),
+				Value: strings.Repeat("v", 21),
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			client := s.MlflowClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSetExperimentTag,
+				),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	experiment, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.Empty(experiment.Tags)
+}
+
+func (s *SetExperimentTagLengthTestSuite) createExperiment() *models.Experiment {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	return experiment
+}
@@ -0,0 +1,177 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SetExperimentTagsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSetExperimentTagsTestSuite(t *testing.T) {
+	suite.Run(t, &SetExperimentTagsTestSuite{
+		helpers.BaseTestSuite{
+			SkipCreateDefaultExperiment: true,
+		},
+	})
+}
+
+func (s *SetExperimentTagsTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name: "Test Experiment",
+		Tags: []models.ExperimentTag{
+			{
+				Key:   "key1",
+				Value: "value1",
+			},
+			{
+				Key:   "key2",
+				Value: "value2",
+			},
+		},
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. set several tags at once, updating the existing one and adding the new ones.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetExperimentTagsRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key1", Value: "updated1"},
+					{Key: "key3", Value: "value3"},
+					{Key: "key4", Value: "value4"},
+				},
+			},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSetExperimentTags,
+		),
+	)
+
+	// 3. check the tags via `experiments/get` endpoint.
+	resp := response.GetExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetExperimentRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsGetRoute,
+		),
+	)
+	tags := make(map[string]string, len(resp.Experiment.Tags))
+	for _, tag := range resp.Experiment.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	s.Equal(map[string]string{
+		"key1": "updated1",
+		"key2": "value2",
+		"key3": "value3",
+		"key4": "value4",
+	}, tags)
+}
+
+func (s *SetExperimentTagsTestSuite) Test_Error() {
+	// experiment in another namespace can't be tagged from the default one.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetExperimentTagsRequest
+	}{
+		{
+			name:  "EmptyIDProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.SetExperimentTagsRequest{
+				ID: "",
+			},
+		},
+		{
+			name:  "EmptyTagsProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tags'"),
+			request: &request.SetExperimentTagsRequest{
+				ID: "1",
+			},
+		},
+		{
+			name:  "EmptyKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.SetExperimentTagsRequest{
+				ID: "1",
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key1", Value: "value1"},
+					{Value: "value2"},
+				},
+			},
+		},
+		{
+			name: "ExperimentInAnotherNamespace",
+			error: api.NewResourceDoesNotExistError(
+				`unable to find experiment '%d': error getting experiment by id: %d: record not found`,
+				*experiment.ID, *experiment.ID,
+			),
+			request: &request.SetExperimentTagsRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+				Tags: []request.ExperimentTagPartialRequest{
+					{Key: "key1", Value: "value1"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSetExperimentTags,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	// the experiment in another namespace is left untouched.
+	experiment, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), namespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.Empty(experiment.Tags)
+}
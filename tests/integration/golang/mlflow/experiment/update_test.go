@@ -2,6 +2,7 @@ package experiment
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"testing"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
@@ -33,6 +35,14 @@ func (s *UpdateExperimentTestSuite) Test_Ok() {
 		Name:           "Test Experiment",
 		NamespaceID:    s.DefaultNamespace.ID,
 		LifecycleStage: models.LifecycleStageActive,
+		CreationTime: sql.NullInt64{
+			Int64: 1234567890,
+			Valid: true,
+		},
+		LastUpdateTime: sql.NullInt64{
+			Int64: 1234567890,
+			Valid: true,
+		},
 	})
 	s.Require().Nil(err)
 
@@ -57,6 +67,88 @@ func (s *UpdateExperimentTestSuite) Test_Ok() {
 	)
 	s.Require().Nil(err)
 	s.Equal("Test Updated Experiment", exp.Name)
+
+	// 2. make sure that creation time is kept and last update time advances.
+	resp := response.GetExperimentResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetExperimentRequest{
+				ID: fmt.Sprintf("%d", *experiment.ID),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsGetRoute,
+		),
+	)
+	s.Equal(int64(1234567890), resp.Experiment.CreationTime)
+	s.Greater(resp.Experiment.LastUpdateTime, int64(1234567890))
+}
+
+func (s *UpdateExperimentTestSuite) Test_ArtifactLocation() {
+	// 1. prepare database with test data.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "s3://bucket/experiment",
+	})
+	s.Require().Nil(err)
+
+	// 2. make sure that artifact location can't be changed.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateExperimentRequest{
+				ID:               fmt.Sprintf("%d", *experiment.ID),
+				Name:             "Test Updated Experiment",
+				ArtifactLocation: "s3://bucket/another-experiment",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsUpdateRoute,
+		),
+	)
+	s.Equal(
+		api.NewInvalidParameterValueError(
+			"artifact location of experiment '%d' cannot be changed", *experiment.ID,
+		).Error(),
+		resp.Error(),
+	)
+
+	exp, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.Equal("Test Experiment", exp.Name)
+	s.Equal("s3://bucket/experiment", exp.ArtifactLocation)
+
+	// 3. make sure that name still can be changed when the same artifact location is provided.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateExperimentRequest{
+				ID:               fmt.Sprintf("%d", *experiment.ID),
+				Name:             "Test Updated Experiment",
+				ArtifactLocation: "s3://bucket/experiment",
+			},
+		).WithResponse(
+			&struct{}{},
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsUpdateRoute,
+		),
+	)
+
+	exp, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *experiment.ID,
+	)
+	s.Require().Nil(err)
+	s.Equal("Test Updated Experiment", exp.Name)
+	s.Equal("s3://bucket/experiment", exp.ArtifactLocation)
 }
 
 func (s *UpdateExperimentTestSuite) Test_Error() {
@@ -0,0 +1,133 @@
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetHistoryMaxPointsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetHistoryMaxPointsTestSuite(t *testing.T) {
+	testSuite := new(GetHistoryMaxPointsTestSuite)
+	testSuite.Config = config.Config{
+		MetricHistoryMaxPoints: 3,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *GetHistoryMaxPointsTestSuite) Test_Ok() {
+	run := s.createRunWithMetricHistory(2)
+
+	resp := response.GetMetricHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "history",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+	s.Len(resp.Metrics, 2)
+}
+
+func (s *GetHistoryMaxPointsTestSuite) Test_Error() {
+	// the metric history has more points than the server allows to return.
+	run := s.createRunWithMetricHistory(4)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetMetricHistoryRequest
+	}{
+		{
+			name: "WithoutDownsampling",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "history",
+			},
+			error: api.NewInvalidParameterValueError(
+				"metric history for metric 'history' of run '%s' has more than 3 points, "+
+					"use 'max_points' parameter to downsample it",
+				run.ID,
+			),
+		},
+		{
+			name: "WithMaxPointsAboveLimit",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "history",
+				MaxPoints: 4,
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_points': has to be at most 3",
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	// downsampled metric history is still available.
+	resp := response.GetMetricHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "history",
+				MaxPoints: 2,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+	s.Len(resp.Metrics, 2)
+}
+
+// createRunWithMetricHistory creates the run with the amount of points logged for `history` metric.
+func (s *GetHistoryMaxPointsTestSuite) createRunWithMetricHistory(points int) *models.Run {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	for i := 1; i <= points; i++ {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "history",
+			Value:     float64(i),
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+			Step:      int64(i),
+			Iter:      int64(i),
+			Context:   models.DefaultContext,
+		})
+		s.Require().Nil(err)
+	}
+	return run
+}
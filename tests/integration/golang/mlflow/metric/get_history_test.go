@@ -1,6 +1,7 @@
 package metric
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -9,7 +10,10 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	mlflowCommon "github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/controller"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
 	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
@@ -86,6 +90,602 @@ func (s *GetHistoryTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoryTestSuite) Test_GroupByContext_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// log the same metric under two contexts with interleaved steps.
+	for _, metric := range []struct {
+		step    int64
+		value   float64
+		context string
+	}{
+		{step: 1, value: 1.1, context: `{"subset": "train"}`},
+		{step: 1, value: 2.1, context: `{"subset": "test"}`},
+		{step: 2, value: 1.2, context: `{"subset": "train"}`},
+		{step: 2, value: 2.2, context: `{"subset": "test"}`},
+	} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     metric.value,
+			Timestamp: 1234567890 + metric.step,
+			RunID:     run.ID,
+			Step:      metric.step,
+			Iter:      metric.step,
+			Context: models.Context{
+				Json: types.JSONB(metric.context),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := response.GetMetricHistoryByContextResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryRequest{
+				RunID:          run.ID,
+				MetricKey:      "key1",
+				GroupByContext: true,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+
+	s.Require().Len(resp.Series, 2)
+	series := map[string][]response.MetricPartialResponse{}
+	for _, group := range resp.Series {
+		subset, ok := group.Context["subset"].(string)
+		s.Require().True(ok)
+		series[subset] = group.Metrics
+	}
+	train, test := map[string]any{"subset": "train"}, map[string]any{"subset": "test"}
+	s.Equal(map[string][]response.MetricPartialResponse{
+		"train": {
+			{Key: "key1", Step: 1, Value: 1.1, Timestamp: 1234567891, Context: train},
+			{Key: "key1", Step: 2, Value: 1.2, Timestamp: 1234567892, Context: train},
+		},
+		"test": {
+			{Key: "key1", Step: 1, Value: 2.1, Timestamp: 1234567891, Context: test},
+			{Key: "key1", Step: 2, Value: 2.2, Timestamp: 1234567892, Context: test},
+		},
+	}, series)
+}
+
+func (s *GetHistoryTestSuite) Test_XAxis_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// log the same metric under two contexts, so values share steps, but not timestamps.
+	for _, metric := range []struct {
+		step      int64
+		timestamp int64
+		value     float64
+		context   string
+	}{
+		{step: 2, timestamp: 40, value: 2.2, context: `{"subset": "test"}`},
+		{step: 1, timestamp: 10, value: 1.1, context: `{"subset": "train"}`},
+		{step: 1, timestamp: 30, value: 2.1, context: `{"subset": "test"}`},
+		{step: 2, timestamp: 20, value: 1.2, context: `{"subset": "train"}`},
+	} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     metric.value,
+			Timestamp: metric.timestamp,
+			RunID:     run.ID,
+			Step:      metric.step,
+			Iter:      metric.step,
+			Context: models.Context{
+				Json: types.JSONB(metric.context),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	train, test := map[string]any{"subset": "train"}, map[string]any{"subset": "test"}
+	tests := []struct {
+		name     string
+		xAxis    request.MetricHistoryXAxis
+		expected []response.MetricPartialResponse
+	}{
+		{
+			name: "Default",
+			expected: []response.MetricPartialResponse{
+				{Key: "key1", Step: 1, Value: 1.1, Timestamp: 10, Context: train},
+				{Key: "key1", Step: 1, Value: 2.1, Timestamp: 30, Context: test},
+				{Key: "key1", Step: 2, Value: 1.2, Timestamp: 20, Context: train},
+				{Key: "key1", Step: 2, Value: 2.2, Timestamp: 40, Context: test},
+			},
+		},
+		{
+			name:  "Step",
+			xAxis: request.MetricHistoryXAxisStep,
+			expected: []response.MetricPartialResponse{
+				{Key: "key1", Step: 1, Value: 1.1, Timestamp: 10, Context: train},
+				{Key: "key1", Step: 1, Value: 2.1, Timestamp: 30, Context: test},
+				{Key: "key1", Step: 2, Value: 1.2, Timestamp: 20, Context: train},
+				{Key: "key1", Step: 2, Value: 2.2, Timestamp: 40, Context: test},
+			},
+		},
+		{
+			name:  "Time",
+			xAxis: request.MetricHistoryXAxisTime,
+			expected: []response.MetricPartialResponse{
+				{Key: "key1", Step: 1, Value: 1.1, Timestamp: 10, Context: train},
+				{Key: "key1", Step: 2, Value: 1.2, Timestamp: 20, Context: train},
+				{Key: "key1", Step: 1, Value: 2.1, Timestamp: 30, Context: test},
+				{Key: "key1", Step: 2, Value: 2.2, Timestamp: 40, Context: test},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					request.GetMetricHistoryRequest{
+						RunID:     run.ID,
+						MetricKey: "key1",
+						XAxis:     tt.xAxis,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			s.Equal(tt.expected, resp.Metrics)
+
+			// every point has a timestamp, which grows together with the step of its context.
+			last := map[string]response.MetricPartialResponse{}
+			for _, metric := range resp.Metrics {
+				s.NotZero(metric.Timestamp)
+				subset, ok := metric.Context["subset"].(string)
+				s.Require().True(ok)
+				if previous, ok := last[subset]; ok && previous.Step < metric.Step {
+					s.LessOrEqual(previous.Timestamp, metric.Timestamp)
+				}
+				last[subset] = metric
+			}
+		})
+	}
+}
+
+func (s *GetHistoryTestSuite) Test_Arrow_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step, value := range []float64{1.1, 2.2, 0, 4.4} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     value,
+			Timestamp: 1234567890 + int64(step),
+			RunID:     run.ID,
+			Step:      int64(step),
+			IsNan:     step == 2,
+			Iter:      int64(step + 1),
+			Context: models.Context{
+				Json: types.JSONB(`{"key": "key", "value": "value"}`),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	req := request.GetMetricHistoryRequest{
+		RunID:     run.ID,
+		MetricKey: "key1",
+	}
+
+	jsonResp := response.GetMetricHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			req,
+		).WithResponse(
+			&jsonResp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+
+	arrowResp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			req,
+		).WithHeaders(
+			map[string]string{"Accept": controller.ArrowStreamContentType},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			arrowResp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+	metrics, err := helpers.DecodeArrowMetrics(arrowResp)
+	s.Require().Nil(err)
+
+	s.Require().Len(metrics, len(jsonResp.Metrics))
+	for n, metric := range metrics {
+		s.Equal(run.ID, metric.RunID)
+		s.Equal(jsonResp.Metrics[n].Key, metric.Key)
+		s.Equal(jsonResp.Metrics[n].Step, metric.Step)
+		s.Equal(jsonResp.Metrics[n].Timestamp, metric.Timestamp)
+		if metric.IsNan {
+			s.Equal(mlflowCommon.NANValue, jsonResp.Metrics[n].Value)
+		} else {
+			s.Equal(jsonResp.Metrics[n].Value, metric.Value)
+		}
+	}
+}
+
+func (s *GetHistoryTestSuite) Test_MaxPoints_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step := 0; step < 5; step++ {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     float64(step),
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+			Step:      int64(step),
+			Iter:      int64(step + 1),
+			Context: models.Context{
+				Json: types.JSONB(`{}`),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := response.GetMetricHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				MaxPoints: 3,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+		),
+	)
+	steps := make([]int64, len(resp.Metrics))
+	for n, metric := range resp.Metrics {
+		steps[n] = metric.Step
+	}
+	s.Equal([]int64{0, 2, 4}, steps)
+}
+
+func (s *GetHistoryTestSuite) Test_Precision_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step, value := range []float64{0.123456789, 12345.6789, -9.87654321} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     value,
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+			Step:      int64(step),
+			Iter:      int64(step + 1),
+			Context: models.Context{
+				Json: types.JSONB(`{}`),
+			},
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		query    any
+		expected []float64
+	}{
+		{
+			name: "GetHistoryWithPrecision",
+			path: mlflow.MetricsGetHistoryRoute,
+			query: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				Precision: 4,
+			},
+			expected: []float64{0.1235, 12350, -9.877},
+		},
+		{
+			name: "GetHistoryWithFullPrecision",
+			path: mlflow.MetricsGetHistoryRoute,
+			query: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+			},
+			expected: []float64{0.123456789, 12345.6789, -9.87654321},
+		},
+		{
+			name: "GetHistoryBulkWithPrecision",
+			path: mlflow.MetricsGetHistoryBulkRoute,
+			query: request.GetMetricHistoryBulkRequest{
+				RunIDs:    []string{run.ID},
+				MetricKey: "key1",
+				Precision: 4,
+			},
+			expected: []float64{0.1235, 12350, -9.877},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := struct {
+				Metrics []struct {
+					Value float64 `json:"value"`
+				} `json:"metrics"`
+			}{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.query,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, tt.path,
+				),
+			)
+			values := make([]float64, len(resp.Metrics))
+			for n, metric := range resp.Metrics {
+				values[n] = metric.Value
+			}
+			s.Equal(tt.expected, values)
+		})
+	}
+}
+
+func (s *GetHistoryTestSuite) Test_StepRange_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for step := int64(0); step < 1000; step++ {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     float64(step),
+			Timestamp: 1234567890 + step,
+			RunID:     run.ID,
+			Step:      step,
+			Iter:      step + 1,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name          string
+		request       request.GetMetricHistoryRequest
+		expectedSteps []int64
+	}{
+		{
+			name: "StepRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](200),
+				EndStep:   common.GetPointer[int64](300),
+			},
+			expectedSteps: []int64{200, 300},
+		},
+		{
+			name: "StartStepOnly",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](990),
+			},
+			expectedSteps: []int64{990, 999},
+		},
+		{
+			name: "StepAndTimeRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](200),
+				EndStep:   common.GetPointer[int64](300),
+				EndTime:   common.GetPointer[int64](1234567890 + 250),
+			},
+			expectedSteps: []int64{200, 250},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			from, to := tt.expectedSteps[0], tt.expectedSteps[1]
+			s.Require().Len(resp.Metrics, int(to-from+1))
+			for _, metric := range resp.Metrics {
+				s.GreaterOrEqual(metric.Step, from)
+				s.LessOrEqual(metric.Step, to)
+			}
+		})
+	}
+}
+
+func (s *GetHistoryTestSuite) Test_ForwardFill_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusScheduled,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *experiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// sparse series, which is logged at steps 1, 4 and 6 only.
+	for iter, step := range []int64{1, 4, 6} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "key1",
+			Value:     float64(step * 10),
+			Timestamp: 1234567890 + step,
+			RunID:     run.ID,
+			Step:      step,
+			Iter:      int64(iter + 1),
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		request  request.GetMetricHistoryRequest
+		expected map[int64]float64
+	}{
+		{
+			name: "WithoutFill",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				Fill:      request.MetricHistoryFillNone,
+			},
+			expected: map[int64]float64{1: 10, 4: 40, 6: 60},
+		},
+		{
+			name: "WithForwardFill",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				Fill:      request.MetricHistoryFillForward,
+			},
+			expected: map[int64]float64{1: 10, 2: 10, 3: 10, 4: 40, 5: 40, 6: 60},
+		},
+		{
+			name: "WithForwardFillAndStepRange",
+			request: request.GetMetricHistoryRequest{
+				RunID:     run.ID,
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](2),
+				EndStep:   common.GetPointer[int64](8),
+				Fill:      request.MetricHistoryFillForward,
+			},
+			expected: map[int64]float64{4: 40, 5: 40, 6: 60, 7: 60, 8: 60},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+				),
+			)
+			values := make(map[int64]float64, len(resp.Metrics))
+			for _, metric := range resp.Metrics {
+				values[metric.Step] = metric.Value.(float64)
+			}
+			s.Len(resp.Metrics, len(tt.expected))
+			s.Equal(tt.expected, values)
+		})
+	}
+}
+
 func (s *GetHistoryTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -104,6 +704,18 @@ func (s *GetHistoryTestSuite) Test_Error() {
 			},
 			error: api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'"),
 		},
+		{
+			name: "StartStepGreaterThanEndStep",
+			request: request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key1",
+				StartStep: common.GetPointer[int64](300),
+				EndStep:   common.GetPointer[int64](200),
+			},
+			error: api.NewInvalidParameterValueError(
+				"'start_step' parameter can't be greater than 'end_step' parameter",
+			),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
@@ -0,0 +1,254 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TransitionModelVersionStageTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTransitionModelVersionStageTestSuite(t *testing.T) {
+	suite.Run(t, new(TransitionModelVersionStageTestSuite))
+}
+
+func (s *TransitionModelVersionStageTestSuite) Test_Ok() {
+	registeredModel := s.createRegisteredModel()
+	s.createModelVersion(registeredModel, 1, models.ModelVersionStageNone)
+	s.createModelVersion(registeredModel, 2, models.ModelVersionStageNone)
+
+	// subtests are run in order, each of them moves one of the versions to the next stage.
+	tests := []struct {
+		name           string
+		request        request.TransitionModelVersionStageRequest
+		expectedStages map[int64]models.ModelVersionStage
+	}{
+		{
+			name: "NoneToStaging",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+				Stage:   "Staging",
+			},
+			expectedStages: map[int64]models.ModelVersionStage{
+				1: models.ModelVersionStageStaging,
+				2: models.ModelVersionStageNone,
+			},
+		},
+		{
+			name: "StagingToProduction",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+				Stage:   "Production",
+			},
+			expectedStages: map[int64]models.ModelVersionStage{
+				1: models.ModelVersionStageProduction,
+				2: models.ModelVersionStageNone,
+			},
+		},
+		{
+			name: "NoneToProductionArchivingExistingVersions",
+			request: request.TransitionModelVersionStageRequest{
+				Name:                    "Model",
+				Version:                 "2",
+				Stage:                   "production",
+				ArchiveExistingVersions: true,
+			},
+			expectedStages: map[int64]models.ModelVersionStage{
+				1: models.ModelVersionStageArchived,
+				2: models.ModelVersionStageProduction,
+			},
+		},
+		{
+			name: "ArchivedToNone",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+				Stage:   "None",
+			},
+			expectedStages: map[int64]models.ModelVersionStage{
+				1: models.ModelVersionStageNone,
+				2: models.ModelVersionStageProduction,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.ModelVersionResponse
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+				),
+			)
+			s.Require().NotNil(resp.ModelVersion)
+			s.Equal("Model", resp.ModelVersion.Name)
+			s.Equal(tt.request.Version, resp.ModelVersion.Version)
+			for version, expectedStage := range tt.expectedStages {
+				if fmt.Sprint(version) == tt.request.Version {
+					s.Equal(string(expectedStage), resp.ModelVersion.CurrentStage)
+				}
+			}
+			// the transition time is recorded as the last update time of the version.
+			s.Greater(resp.ModelVersion.LastUpdatedTimestamp, resp.ModelVersion.CreationTimestamp)
+
+			for version, expectedStage := range tt.expectedStages {
+				modelVersion, err := s.ModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, version)
+				s.Require().Nil(err)
+				s.Equal(expectedStage, modelVersion.CurrentStage)
+			}
+		})
+	}
+}
+
+func (s *TransitionModelVersionStageTestSuite) Test_Error() {
+	registeredModel := s.createRegisteredModel()
+	s.createModelVersion(registeredModel, 1, models.ModelVersionStageProduction)
+
+	tests := []struct {
+		name    string
+		request request.TransitionModelVersionStageRequest
+		error   *api.ErrorResponse
+	}{
+		{
+			name: "MissingName",
+			request: request.TransitionModelVersionStageRequest{
+				Version: "1",
+				Stage:   "Staging",
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+		},
+		{
+			name: "MissingVersion",
+			request: request.TransitionModelVersionStageRequest{
+				Name:  "Model",
+				Stage: "Staging",
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+		},
+		{
+			name: "InvalidVersion",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "first",
+				Stage:   "Staging",
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'version': 'first' is not a positive integer",
+			),
+		},
+		{
+			name: "MissingStage",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+		},
+		{
+			name: "UnsupportedStage",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+				Stage:   "Testing",
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'stage': unsupported stage 'Testing', " +
+					"supported stages are: None, Staging, Production, Archived",
+			),
+		},
+		{
+			name: "NotExistingModel",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "NotExisting",
+				Version: "1",
+				Stage:   "Staging",
+			},
+			error: api.NewResourceDoesNotExistError("registered model(name=NotExisting) not found"),
+		},
+		{
+			name: "NotExistingVersion",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "2",
+				Stage:   "Staging",
+			},
+			error: api.NewResourceDoesNotExistError("model version(name=Model, version=2) not found"),
+		},
+		{
+			name: "NotAllowedTransition",
+			request: request.TransitionModelVersionStageRequest{
+				Name:    "Model",
+				Version: "1",
+				Stage:   "None",
+			},
+			error: api.NewInvalidParameterValueError(
+				"model version(name=Model, version=1) can't be moved from stage 'Production' to stage 'None'",
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+func (s *TransitionModelVersionStageTestSuite) createRegisteredModel() *models.RegisteredModel {
+	registeredModel, err := s.ModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:            "Model",
+		CreationTime:    1,
+		LastUpdatedTime: 1,
+		NamespaceID:     s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	return registeredModel
+}
+
+func (s *TransitionModelVersionStageTestSuite) createModelVersion(
+	registeredModel *models.RegisteredModel, version int64, stage models.ModelVersionStage,
+) {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	_, err = s.ModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		RegisteredModelID: registeredModel.ID,
+		Version:           version,
+		Source:            fmt.Sprintf("%s/model", run.ArtifactURI),
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      stage,
+		CreationTime:      1,
+		LastUpdatedTime:   1,
+		RunID:             run.ID,
+	})
+	s.Require().Nil(err)
+}
@@ -0,0 +1,124 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ArtifactRootTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestArtifactRootTestSuite(t *testing.T) {
+	suite.Run(t, &ArtifactRootTestSuite{
+		helpers.BaseTestSuite{},
+	})
+}
+
+func (s *ArtifactRootTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace1",
+		ArtifactRoot:        "s3://bucket1/team1",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+	namespace2, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "namespace2",
+		ArtifactRoot:        "gs://bucket2",
+		DefaultExperimentID: common.GetPointer(int32(0)),
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name         string
+		namespace    string
+		artifactRoot string
+	}{
+		{
+			name:         "NamespaceWithArtifactRoot",
+			namespace:    namespace1.Code,
+			artifactRoot: "s3://bucket1/team1",
+		},
+		{
+			name:         "AnotherNamespaceWithArtifactRoot",
+			namespace:    namespace2.Code,
+			artifactRoot: "gs://bucket2",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 2. create experiment in the namespace.
+			createExperimentResponse := response.CreateExperimentResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithNamespace(
+					tt.namespace,
+				).WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.CreateExperimentRequest{
+						Name: fmt.Sprintf("Test Experiment %s", tt.namespace),
+					},
+				).WithResponse(
+					&createExperimentResponse,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute,
+				),
+			)
+
+			// 3. check that experiment artifact location is resolved against the namespace root.
+			expectedArtifactLocation, err := url.JoinPath(tt.artifactRoot, createExperimentResponse.ID)
+			s.Require().Nil(err)
+			getExperimentResponse := response.GetExperimentResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithNamespace(
+					tt.namespace,
+				).WithQuery(
+					request.GetExperimentRequest{
+						ID: createExperimentResponse.ID,
+					},
+				).WithResponse(
+					&getExperimentResponse,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsGetRoute,
+				),
+			)
+			s.Equal(expectedArtifactLocation, getExperimentResponse.Experiment.ArtifactLocation)
+
+			// 4. check that artifacts of the experiment runs are located under the namespace root as well.
+			createRunResponse := response.CreateRunResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithNamespace(
+					tt.namespace,
+				).WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.CreateRunRequest{
+						ExperimentID: createExperimentResponse.ID,
+					},
+				).WithResponse(
+					&createRunResponse,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+				),
+			)
+			expectedArtifactURI, err := url.JoinPath(
+				expectedArtifactLocation, createRunResponse.Run.Info.ID, "artifacts",
+			)
+			s.Require().Nil(err)
+			s.Equal(expectedArtifactURI, createRunResponse.Run.Info.ArtifactURI)
+		})
+	}
+}
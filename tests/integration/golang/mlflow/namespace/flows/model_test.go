@@ -0,0 +1,420 @@
+package flows
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ModelFlowTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+// TestModelFlowTestSuite tests the full `model` flow connected to namespace functionality.
+// Flow contains next endpoints:
+// - `POST /registered-models/create`
+// - `GET /registered-models/get`
+// - `POST /model-versions/create`
+func TestModelFlowTestSuite(t *testing.T) {
+	suite.Run(t, &ModelFlowTestSuite{
+		helpers.BaseTestSuite{
+			ResetOnSubTest:             true,
+			SkipCreateDefaultNamespace: true,
+		},
+	})
+}
+
+func (s *ModelFlowTestSuite) Test_Ok() {
+	tests := []struct {
+		name           string
+		setup          func() (*models.Namespace, *models.Namespace)
+		namespace1Code string
+		namespace2Code string
+	}{
+		{
+			name: "TestCustomNamespaces",
+			setup: func() (*models.Namespace, *models.Namespace) {
+				return &models.Namespace{
+						Code:                "namespace-1",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}, &models.Namespace{
+						Code:                "namespace-2",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}
+			},
+			namespace1Code: "namespace-1",
+			namespace2Code: "namespace-2",
+		},
+		{
+			name: "TestExplicitDefaultAndCustomNamespaces",
+			setup: func() (*models.Namespace, *models.Namespace) {
+				return &models.Namespace{
+						Code:                "default",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}, &models.Namespace{
+						Code:                "namespace-1",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}
+			},
+			namespace1Code: "default",
+			namespace2Code: "namespace-1",
+		},
+		{
+			name: "TestImplicitDefaultAndCustomNamespaces",
+			setup: func() (*models.Namespace, *models.Namespace) {
+				return &models.Namespace{
+						Code:                "default",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}, &models.Namespace{
+						Code:                "namespace-1",
+						DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+					}
+			},
+			namespace1Code: "",
+			namespace2Code: "namespace-1",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 1. setup data under the test.
+			namespace1, namespace2 := tt.setup()
+			namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), namespace1)
+			s.Require().Nil(err)
+			namespace2, err = s.NamespaceFixtures.CreateNamespace(context.Background(), namespace2)
+			s.Require().Nil(err)
+
+			experiment1, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             "Experiment1",
+				ArtifactLocation: "/artifact/location/1",
+				LifecycleStage:   models.LifecycleStageActive,
+				NamespaceID:      namespace1.ID,
+			})
+			s.Require().Nil(err)
+
+			experiment2, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             "Experiment2",
+				ArtifactLocation: "/artifact/location/2",
+				LifecycleStage:   models.LifecycleStageActive,
+				NamespaceID:      namespace2.ID,
+			})
+			s.Require().Nil(err)
+
+			// 2. run actual flow test over the test data.
+			s.testModelFlow(tt.namespace1Code, tt.namespace2Code, experiment1, experiment2)
+		})
+	}
+}
+
+func (s *ModelFlowTestSuite) testModelFlow(
+	namespace1Code, namespace2Code string, experiment1, experiment2 *models.Experiment,
+) {
+	run1ID := s.createRun(namespace1Code, &request.CreateRunRequest{
+		Name:         "Run1",
+		ExperimentID: fmt.Sprintf("%d", *experiment1.ID),
+	})
+	run2ID := s.createRun(namespace2Code, &request.CreateRunRequest{
+		Name:         "Run2",
+		ExperimentID: fmt.Sprintf("%d", *experiment2.ID),
+	})
+
+	// test `POST /registered-models/create` endpoint.
+	// check that models with the same name can be registered in different namespaces.
+	s.createRegisteredModel(namespace1Code, &request.CreateRegisteredModelRequest{
+		Name:        "Model",
+		Description: "model of namespace 1",
+	})
+	s.createRegisteredModel(namespace2Code, &request.CreateRegisteredModelRequest{
+		Name: "Model",
+	})
+
+	// check that model name is unique in scope of namespace.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1Code,
+		).WithRequest(
+			request.CreateRegisteredModelRequest{
+				Name: "Model",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+	s.Equal("RESOURCE_ALREADY_EXISTS: registered model(name=Model) already exists", resp.Error())
+	s.Equal(api.ErrorCodeResourceAlreadyExists, string(resp.ErrorCode))
+
+	// test `POST /model-versions/create` endpoint.
+	// check that versions are numbered in scope of registered model and source is resolved against
+	// the run artifact location.
+	s.createModelVersionAndCompare(
+		namespace1Code,
+		&request.CreateModelVersionRequest{
+			Name:   "Model",
+			RunID:  run1ID,
+			Source: fmt.Sprintf("runs:/%s/model", run1ID),
+		},
+		&response.ModelVersionPartialResponse{
+			Name:         "Model",
+			Version:      "1",
+			CurrentStage: string(models.ModelVersionStageNone),
+			Source:       fmt.Sprintf("/artifact/location/1/%s/artifacts/model", run1ID),
+			RunID:        run1ID,
+			Status:       string(models.ModelVersionStatusReady),
+		},
+	)
+	s.createModelVersionAndCompare(
+		namespace1Code,
+		&request.CreateModelVersionRequest{
+			Name:        "Model",
+			RunID:       run1ID,
+			Source:      "checkpoints/best",
+			Description: "best checkpoint",
+		},
+		&response.ModelVersionPartialResponse{
+			Name:         "Model",
+			Version:      "2",
+			CurrentStage: string(models.ModelVersionStageNone),
+			Source:       fmt.Sprintf("/artifact/location/1/%s/artifacts/checkpoints/best", run1ID),
+			RunID:        run1ID,
+			Status:       string(models.ModelVersionStatusReady),
+			Description:  "best checkpoint",
+		},
+	)
+	s.createModelVersionAndCompare(
+		namespace2Code,
+		&request.CreateModelVersionRequest{
+			Name:   "Model",
+			RunID:  run2ID,
+			Source: fmt.Sprintf("/artifact/location/2/%s/artifacts/model", run2ID),
+		},
+		&response.ModelVersionPartialResponse{
+			Name:         "Model",
+			Version:      "1",
+			CurrentStage: string(models.ModelVersionStageNone),
+			Source:       fmt.Sprintf("/artifact/location/2/%s/artifacts/model", run2ID),
+			RunID:        run2ID,
+			Status:       string(models.ModelVersionStatusReady),
+		},
+	)
+
+	// check that run from another namespace can't be referenced.
+	resp = api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace2Code,
+		).WithRequest(
+			request.CreateModelVersionRequest{
+				Name:   "Model",
+				RunID:  run1ID,
+				Source: "model",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+		),
+	)
+	s.Equal(fmt.Sprintf("RESOURCE_DOES_NOT_EXIST: unable to find run '%s'", run1ID), resp.Error())
+	s.Equal(api.ErrorCodeResourceDoesNotExist, string(resp.ErrorCode))
+
+	// check that source outside of the run artifact location is rejected.
+	resp = api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace1Code,
+		).WithRequest(
+			request.CreateModelVersionRequest{
+				Name:   "Model",
+				RunID:  run1ID,
+				Source: "../../other/artifacts/model",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+		),
+	)
+	s.Equal(
+		fmt.Sprintf(
+			"INVALID_PARAMETER_VALUE: source '../../other/artifacts/model' is not located inside "+
+				"artifact location of run '%s'",
+			run1ID,
+		),
+		resp.Error(),
+	)
+	s.Equal(api.ErrorCodeInvalidParameterValue, string(resp.ErrorCode))
+
+	// test `GET /registered-models/get` endpoint.
+	// check that each namespace returns its own model with its own latest version.
+	s.getRegisteredModelAndCompare(
+		namespace1Code,
+		request.GetRegisteredModelRequest{
+			Name: "Model",
+		},
+		&response.RegisteredModelPartialResponse{
+			Name:        "Model",
+			Description: "model of namespace 1",
+			LatestVersions: []*response.ModelVersionPartialResponse{
+				{
+					Name:         "Model",
+					Version:      "2",
+					CurrentStage: string(models.ModelVersionStageNone),
+					Source:       fmt.Sprintf("/artifact/location/1/%s/artifacts/checkpoints/best", run1ID),
+					RunID:        run1ID,
+					Status:       string(models.ModelVersionStatusReady),
+					Description:  "best checkpoint",
+				},
+			},
+		},
+	)
+	s.getRegisteredModelAndCompare(
+		namespace2Code,
+		request.GetRegisteredModelRequest{
+			Name: "Model",
+		},
+		&response.RegisteredModelPartialResponse{
+			Name: "Model",
+			LatestVersions: []*response.ModelVersionPartialResponse{
+				{
+					Name:         "Model",
+					Version:      "1",
+					CurrentStage: string(models.ModelVersionStageNone),
+					Source:       fmt.Sprintf("/artifact/location/2/%s/artifacts/model", run2ID),
+					RunID:        run2ID,
+					Status:       string(models.ModelVersionStatusReady),
+				},
+			},
+		},
+	)
+
+	// check that not existing model can't be found.
+	resp = api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithNamespace(
+			namespace2Code,
+		).WithQuery(
+			request.GetRegisteredModelRequest{
+				Name: "NotExisting",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal("RESOURCE_DOES_NOT_EXIST: registered model(name=NotExisting) not found", resp.Error())
+	s.Equal(api.ErrorCodeResourceDoesNotExist, string(resp.ErrorCode))
+}
+
+func (s *ModelFlowTestSuite) createRun(namespace string, req *request.CreateRunRequest) string {
+	resp := response.CreateRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+		),
+	)
+	return resp.Run.Info.ID
+}
+
+func (s *ModelFlowTestSuite) createRegisteredModel(namespace string, req *request.CreateRegisteredModelRequest) {
+	resp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+	s.Equal(req.Name, resp.RegisteredModel.Name)
+	s.Equal(req.Description, resp.RegisteredModel.Description)
+	s.NotZero(resp.RegisteredModel.CreationTimestamp)
+	s.Empty(resp.RegisteredModel.LatestVersions)
+}
+
+func (s *ModelFlowTestSuite) createModelVersionAndCompare(
+	namespace string, req *request.CreateModelVersionRequest, expectedResponse *response.ModelVersionPartialResponse,
+) {
+	resp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespace,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+		),
+	)
+	s.compareModelVersion(expectedResponse, resp.ModelVersion)
+}
+
+func (s *ModelFlowTestSuite) getRegisteredModelAndCompare(
+	namespace string, req request.GetRegisteredModelRequest, expectedResponse *response.RegisteredModelPartialResponse,
+) {
+	resp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithNamespace(
+			namespace,
+		).WithQuery(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+	s.Equal(expectedResponse.Name, resp.RegisteredModel.Name)
+	s.Equal(expectedResponse.Description, resp.RegisteredModel.Description)
+	s.NotZero(resp.RegisteredModel.CreationTimestamp)
+	s.GreaterOrEqual(resp.RegisteredModel.LastUpdatedTimestamp, resp.RegisteredModel.CreationTimestamp)
+	s.Require().Equal(len(expectedResponse.LatestVersions), len(resp.RegisteredModel.LatestVersions))
+	for i, expectedVersion := range expectedResponse.LatestVersions {
+		s.compareModelVersion(expectedVersion, resp.RegisteredModel.LatestVersions[i])
+	}
+}
+
+func (s *ModelFlowTestSuite) compareModelVersion(expected, actual *response.ModelVersionPartialResponse) {
+	s.Require().NotNil(actual)
+	s.NotZero(actual.CreationTimestamp)
+	s.NotZero(actual.LastUpdatedTimestamp)
+	actualVersion := *actual
+	actualVersion.CreationTimestamp, actualVersion.LastUpdatedTimestamp = 0, 0
+	s.Equal(*expected, actualVersion)
+}
@@ -928,6 +928,12 @@ func (s *RunFlowTestSuite) searchRunsAndCompare(
 	)
 	s.Equal(len(expectedRuns), len(searchResp.Runs))
 	s.Equal("", searchResp.NextPageToken)
+	// creation and last update times are set by the server, so they are only checked to be populated.
+	for _, run := range searchResp.Runs {
+		s.NotZero(run.Info.CreationTime)
+		s.GreaterOrEqual(run.Info.LastUpdateTime, run.Info.CreationTime)
+		run.Info.CreationTime, run.Info.LastUpdateTime = 0, 0
+	}
 	s.Equal(expectedRuns, searchResp.Runs)
 }
 
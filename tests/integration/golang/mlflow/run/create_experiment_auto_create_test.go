@@ -0,0 +1,94 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateRunExperimentAutoCreateTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateRunExperimentAutoCreateTestSuite(t *testing.T) {
+	testSuite := new(CreateRunExperimentAutoCreateTestSuite)
+	testSuite.Config = config.Config{
+		ExperimentAutoCreate: true,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *CreateRunExperimentAutoCreateTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	var experimentIDs []string
+	for _, runName := range []string{"TestRun1", "TestRun2"} {
+		resp := response.CreateRunResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithNamespace(
+				namespace.Code,
+			).WithRequest(
+				request.CreateRunRequest{
+					Name:           runName,
+					ExperimentName: "novel-experiment",
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+			),
+		)
+		s.Equal(runName, resp.Run.Info.Name)
+		experimentIDs = append(experimentIDs, resp.Run.Info.ExperimentID)
+	}
+	// the second run reuses the experiment created by the first one.
+	s.Equal(experimentIDs[0], experimentIDs[1])
+
+	experimentID, err := strconv.ParseInt(experimentIDs[0], 10, 32)
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), namespace.ID, int32(experimentID),
+	)
+	s.Require().Nil(err)
+	s.Equal("novel-experiment", experiment.Name)
+	s.Equal(namespace.ID, experiment.NamespaceID)
+	s.Equal(models.LifecycleStageActive, experiment.LifecycleStage)
+	s.True(strings.HasSuffix(experiment.ArtifactLocation, fmt.Sprintf("/%d", experimentID)))
+
+	// experiments are namespace scoped, so the default namespace gets its own experiment.
+	resp := response.CreateRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRunRequest{
+				Name:           "TestRun3",
+				ExperimentName: "novel-experiment",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+		),
+	)
+	s.NotEqual(experimentIDs[0], resp.Run.Info.ExperimentID)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -75,6 +77,67 @@ func (s *CreateRunTestSuite) Test_CustomNamespaceExperimentZero_Ok() {
 	s.successCases(namespace, experiment, true, int32(0))
 }
 
+func (s *CreateRunTestSuite) Test_NamespaceDefaultExperiment_Ok() {
+	// create test namespace, which has no default experiment yet.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	// the default experiment is created by the first run created without experiment,
+	// and the next runs land in the same experiment.
+	experimentIDs := make([]string, 2)
+	for i := range experimentIDs {
+		resp := response.CreateRunResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithNamespace(
+				namespace.Code,
+			).WithRequest(
+				request.CreateRunRequest{
+					Name: "TestRun",
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+			),
+		)
+		experimentIDs[i] = resp.Run.Info.ExperimentID
+	}
+	s.Equal(experimentIDs[0], experimentIDs[1])
+
+	namespace, err = s.NamespaceFixtures.GetNamespaceByCode(context.Background(), namespace.Code)
+	s.Require().Nil(err)
+	s.Equal(experimentIDs[0], fmt.Sprintf("%d", *namespace.DefaultExperimentID))
+
+	experiment, err := s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), namespace.ID, *namespace.DefaultExperimentID,
+	)
+	s.Require().Nil(err)
+	s.Equal(models.DefaultExperimentName, experiment.Name)
+	s.NotEmpty(experiment.ArtifactLocation)
+
+	// the runs created without experiment in the default namespace land in its default experiment.
+	resp := response.CreateRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateRunRequest{
+				Name: "TestRun",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+		),
+	)
+	s.Equal(fmt.Sprintf("%d", *s.DefaultExperiment.ID), resp.Run.Info.ExperimentID)
+}
+
 func (s *CreateRunTestSuite) successCases(
 	namespace *models.Namespace,
 	experiment *models.Experiment,
@@ -121,6 +184,8 @@ func (s *CreateRunTestSuite) successCases(
 	s.Equal(fmt.Sprintf("%d", *experiment.ID), resp.Run.Info.ExperimentID)
 	s.Equal(int64(1234567890), resp.Run.Info.StartTime)
 	s.Equal(int64(0), resp.Run.Info.EndTime)
+	s.NotZero(resp.Run.Info.CreationTime)
+	s.Equal(resp.Run.Info.CreationTime, resp.Run.Info.LastUpdateTime)
 	s.Equal(string(models.StatusRunning), resp.Run.Info.Status)
 	s.NotEmpty(resp.Run.Info.ArtifactURI)
 	s.Equal(string(models.LifecycleStageActive), resp.Run.Info.LifecycleStage)
@@ -136,6 +201,105 @@ func (s *CreateRunTestSuite) successCases(
 	}, resp.Run.Data.Tags)
 }
 
+func (s *CreateRunTestSuite) Test_IdempotencyKey_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	doCreateRun := func(namespaceCode string, experimentID int32, key string) (response.CreateRunResponse, error) {
+		resp := response.CreateRunResponse{}
+		err := s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithNamespace(
+			namespaceCode,
+		).WithRequest(
+			request.CreateRunRequest{
+				Name:           "TestRun",
+				StartTime:      1234567890,
+				ExperimentID:   fmt.Sprintf("%d", experimentID),
+				IdempotencyKey: key,
+				Tags: []request.RunTagPartialRequest{
+					{
+						Key:   "key1",
+						Value: "value1",
+					},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+		)
+		return resp, err
+	}
+	createRun := func(namespaceCode string, experimentID int32, key string) response.CreateRunResponse {
+		resp, err := doCreateRun(namespaceCode, experimentID, key)
+		s.Require().Nil(err)
+		return resp
+	}
+
+	// the retried request has to return the run created by the first request.
+	first := createRun(s.DefaultNamespace.Code, *s.DefaultExperiment.ID, "training-job-1")
+	second := createRun(s.DefaultNamespace.Code, *s.DefaultExperiment.ID, "training-job-1")
+	s.NotEmpty(first.Run.Info.ID)
+	s.Equal(first.Run.Info.ID, second.Run.Info.ID)
+	s.Equal(first.Run.Info.Name, second.Run.Info.Name)
+	s.ElementsMatch(first.Run.Data.Tags, second.Run.Data.Tags)
+
+	runs, err := s.RunFixtures.GetRuns(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Len(runs, 1)
+
+	// the same key in another namespace creates a new run.
+	other := createRun(namespace.Code, *experiment.ID, "training-job-1")
+	s.NotEqual(first.Run.Info.ID, other.Run.Info.ID)
+
+	runs, err = s.RunFixtures.GetRuns(context.Background(), *experiment.ID)
+	s.Require().Nil(err)
+	s.Len(runs, 1)
+
+	// the key of the deleted run is released, so the retried request creates a new run.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteRunRequest{
+				RunID: first.Run.Info.ID,
+			},
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsDeleteRoute,
+		),
+	)
+	third := createRun(s.DefaultNamespace.Code, *s.DefaultExperiment.ID, "training-job-1")
+	s.NotEqual(first.Run.Info.ID, third.Run.Info.ID)
+	s.Equal(third.Run.Info.ID, createRun(s.DefaultNamespace.Code, *s.DefaultExperiment.ID, "training-job-1").Run.Info.ID)
+
+	// the concurrent requests with the same key get the same run.
+	var wg sync.WaitGroup
+	responses, errs := make([]response.CreateRunResponse, 5), make([]error, 5)
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = doCreateRun(s.DefaultNamespace.Code, *s.DefaultExperiment.ID, "training-job-2")
+		}(i)
+	}
+	wg.Wait()
+	for i := range responses {
+		s.Require().Nil(errs[i])
+		s.NotEmpty(responses[i].Run.Info.ID)
+		s.Equal(responses[0].Run.Info.ID, responses[i].Run.Info.ID)
+	}
+}
+
 func (s *CreateRunTestSuite) Test_Error() {
 	tests := []struct {
 		name      string
@@ -176,6 +340,35 @@ func (s *CreateRunTestSuite) Test_Error() {
 				),
 			),
 		},
+		{
+			name: "CreateRunWithNotExistingExperimentName",
+			request: request.CreateRunRequest{
+				ExperimentName: "not_existing_experiment",
+			},
+			error: api.NewResourceDoesNotExistError(
+				`unable to find experiment with name 'not_existing_experiment'`,
+			),
+		},
+		{
+			name: "CreateRunWithExperimentIDAndExperimentName",
+			request: request.CreateRunRequest{
+				ExperimentID:   fmt.Sprintf("%d", *s.DefaultExperiment.ID),
+				ExperimentName: "experiment",
+			},
+			error: api.NewInvalidParameterValueError(
+				`experiment_id and experiment_name cannot both be specified at the same time`,
+			),
+		},
+		{
+			name: "CreateRunWithTooLongIdempotencyKey",
+			request: request.CreateRunRequest{
+				ExperimentID:   fmt.Sprintf("%d", *s.DefaultExperiment.ID),
+				IdempotencyKey: strings.Repeat("a", 257),
+			},
+			error: api.NewInvalidParameterValueError(
+				`'idempotency_key' parameter can't be longer than 256 characters`,
+			),
+		},
 		{
 			name: "CreateRunWithExistingNamespaceAndNotExistingExperiment",
 			request: request.CreateRunRequest{
@@ -0,0 +1,119 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateRunUniqueNameTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateRunUniqueNameTestSuite(t *testing.T) {
+	testSuite := new(CreateRunUniqueNameTestSuite)
+	testSuite.Config = config.Config{
+		RunNameUnique: true,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *CreateRunUniqueNameTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Another Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// the same name can be used once per experiment.
+	for _, experimentID := range []int32{*s.DefaultExperiment.ID, *experiment.ID} {
+		resp := response.CreateRunResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.CreateRunRequest{
+					Name:         "TestRun",
+					ExperimentID: fmt.Sprintf("%d", experimentID),
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+			),
+		)
+		s.Equal("TestRun", resp.Run.Info.Name)
+		s.Equal(fmt.Sprintf("%d", experimentID), resp.Run.Info.ExperimentID)
+	}
+}
+
+func (s *CreateRunUniqueNameTestSuite) Test_Error() {
+	_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "existing",
+		Name:           "TestRun",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		request request.CreateRunRequest
+	}{
+		{
+			name: "DuplicateName",
+			request: request.CreateRunRequest{
+				Name:         "TestRun",
+				ExperimentID: fmt.Sprintf("%d", *s.DefaultExperiment.ID),
+			},
+		},
+		{
+			name: "DuplicateNameWithIdempotencyKey",
+			request: request.CreateRunRequest{
+				Name:           "TestRun",
+				ExperimentID:   fmt.Sprintf("%d", *s.DefaultExperiment.ID),
+				IdempotencyKey: "key",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			client := s.MlflowClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCreateRoute,
+				),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+			s.Equal(
+				api.NewResourceAlreadyExistsError("run(name=TestRun) already exists in the experiment").Error(),
+				resp.Error(),
+			)
+		})
+	}
+
+	runs, err := s.RunFixtures.GetRuns(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Len(runs, 1)
+}
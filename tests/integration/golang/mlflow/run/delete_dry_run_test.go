@@ -0,0 +1,67 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteRunDryRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteRunDryRunTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteRunDryRunTestSuite))
+}
+
+func (s *DeleteRunDryRunTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.RunFixtures.CreateMetrics(context.Background(), run, 3))
+
+	// 2. make actual API call.
+	resp := response.DeleteRunDryRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithQuery(
+			map[any]any{"dry_run": true},
+		).WithRequest(
+			request.DeleteRunRequest{RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsDeleteRoute,
+		),
+	)
+
+	// 3. check actual API response.
+	s.Equal(response.DeleteRunDryRunResponse{
+		DryRun:  true,
+		Runs:    1,
+		Metrics: 9,
+	}, resp)
+
+	// 4. check that the run is still active.
+	run, err = s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageActive, run.LifecycleStage)
+}
@@ -98,20 +98,12 @@ func (s *DeleteRunTagTestSuite) Test_Ok() {
 	}, tags)
 }
 
-func (s *DeleteRunTagTestSuite) Test_Error() {
+func (s *DeleteRunTagTestSuite) Test_Idempotent_Ok() {
 	// create test run.
 	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
-		ID:     strings.ReplaceAll(uuid.New().String(), "-", ""),
-		Name:   "TestRun",
-		Status: models.StatusRunning,
-		StartTime: sql.NullInt64{
-			Int64: 1234567890,
-			Valid: true,
-		},
-		EndTime: sql.NullInt64{
-			Int64: 1234567899,
-			Valid: true,
-		},
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
 		SourceType:     "JOB",
 		ArtifactURI:    "artifact_uri",
 		ExperimentID:   *s.DefaultExperiment.ID,
@@ -119,6 +111,65 @@ func (s *DeleteRunTagTestSuite) Test_Error() {
 	})
 	s.Require().Nil(err)
 
+	// set the tag through the API.
+	resp := fiber.Map{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRunTagRequest{
+				RunID: run.ID,
+				Key:   "tag1",
+				Value: "value1",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSetTagRoute,
+		),
+	)
+	tags, err := s.TagFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal([]models.Tag{{Key: "tag1", Value: "value1", RunID: run.ID}}, tags)
+
+	// delete the tag twice, the second deletion of the already absent tag succeeds as well.
+	for i := 0; i < 2; i++ {
+		resp := fiber.Map{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.DeleteRunTagRequest{
+					RunID: run.ID,
+					Key:   "tag1",
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsDeleteTagRoute,
+			),
+		)
+		s.Equal(fiber.Map{}, resp)
+
+		tags, err := s.TagFixtures.GetByRunID(context.Background(), run.ID)
+		s.Require().Nil(err)
+		s.Empty(tags)
+	}
+}
+
+func (s *DeleteRunTagTestSuite) Test_Error() {
+	// create deleted test run.
+	deletedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestDeletedRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ArtifactURI:    "artifact_uri",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
 	tests := []struct {
 		name    string
 		error   *api.ErrorResponse
@@ -137,12 +188,12 @@ func (s *DeleteRunTagTestSuite) Test_Error() {
 			error: api.NewResourceDoesNotExistError("Run 'id' not found"),
 		},
 		{
-			name: "NotFoundTag",
+			name: "DeletedRun",
 			request: request.DeleteRunTagRequest{
-				Key:   "not_found_tag",
-				RunID: run.ID,
+				Key:   "tag1",
+				RunID: deletedRun.ID,
 			},
-			error: api.NewResourceDoesNotExistError("No tag with name: not_found_tag"),
+			error: api.NewResourceDoesNotExistError("Run '%s' not found", deletedRun.ID),
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,164 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type FinalizeRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestFinalizeRunTestSuite(t *testing.T) {
+	suite.Run(t, new(FinalizeRunTestSuite))
+}
+
+func (s *FinalizeRunTestSuite) Test_Ok() {
+	// create test running run without end time.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:     strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:   "TestRun",
+		Status: models.StatusRunning,
+		StartTime: sql.NullInt64{
+			Int64: 1234567890,
+			Valid: true,
+		},
+		SourceType:     "JOB",
+		ArtifactURI:    "artifact_uri",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "existing",
+		Value: "old",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+
+	run, err = s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.False(run.EndTime.Valid)
+
+	resp := response.FinalizeRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.FinalizeRunRequest{
+				RunID:   run.ID,
+				Status:  string(models.StatusFinished),
+				EndTime: 1234567899,
+				Tags: []request.RunTagPartialRequest{
+					{Key: "existing", Value: "new"},
+					{Key: "result", Value: "success"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsFinalizeRoute,
+		),
+	)
+	s.Equal(run.ID, resp.RunInfo.ID)
+	s.Equal(string(models.StatusFinished), resp.RunInfo.Status)
+	s.Equal(int64(1234567899), resp.RunInfo.EndTime)
+
+	// check that all the changes landed in the database.
+	run, err = s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(models.StatusFinished, run.Status)
+	s.Equal(sql.NullInt64{Int64: 1234567899, Valid: true}, run.EndTime)
+
+	tags, err := s.TagFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	values := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		values[tag.Key] = tag.Value
+	}
+	s.Equal(map[string]string{"existing": "new", "result": "success"}, values)
+}
+
+func (s *FinalizeRunTestSuite) Test_Error() {
+	// create deleted test run.
+	deletedRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestDeletedRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ArtifactURI:    "artifact_uri",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.FinalizeRunRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			request: request.FinalizeRunRequest{},
+			error: api.NewInvalidParameterValueError(
+				"Missing value for required parameter 'run_id'",
+			),
+		},
+		{
+			name: "NotTerminalStatus",
+			request: request.FinalizeRunRequest{
+				RunID:  "id",
+				Status: string(models.StatusScheduled),
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'status': 'SCHEDULED' is not a terminal run status, " +
+					"supported values are: FINISHED, FAILED, KILLED",
+			),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.FinalizeRunRequest{
+				RunID: "id",
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+		{
+			name: "DeletedRun",
+			request: request.FinalizeRunRequest{
+				RunID:  deletedRun.ID,
+				Status: string(models.StatusFinished),
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run '%s'", deletedRun.ID),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsFinalizeRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -119,6 +119,87 @@ func (s *GetRunTestSuite) Test_Ok() {
 	}, resp.Run.Data.Params)
 }
 
+func (s *GetRunTestSuite) Test_Fields_Ok() {
+	// create test run with tags, metrics and params.
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ArtifactURI:    "artifact_uri",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "tag1",
+		Value: "value1",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:       "metric1",
+		Value:     1.1,
+		Timestamp: 1234567890,
+		RunID:     run.ID,
+		Step:      1,
+	})
+	s.Require().Nil(err)
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:      "param1",
+		ValueStr: common.GetPointer("value1"),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name            string
+		fields          string
+		expectedTags    int
+		expectedMetrics int
+		expectedParams  int
+	}{
+		{
+			name:   "OnlyInfo",
+			fields: "info",
+		},
+		{
+			name:           "InfoAndParams",
+			fields:         "info, params",
+			expectedParams: 1,
+		},
+		{
+			name:            "TagsAndMetrics",
+			fields:          "tags,metrics",
+			expectedTags:    1,
+			expectedMetrics: 1,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetRunResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					request.GetRunRequest{
+						RunID:  run.ID,
+						Fields: tt.fields,
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+				),
+			)
+			s.Equal(run.ID, resp.Run.Info.ID)
+			s.Equal("TestRun", resp.Run.Info.Name)
+			s.Equal(string(models.StatusRunning), resp.Run.Info.Status)
+			s.Len(resp.Run.Data.Tags, tt.expectedTags)
+			s.Len(resp.Run.Data.Metrics, tt.expectedMetrics)
+			s.Len(resp.Run.Data.Params, tt.expectedParams)
+		})
+	}
+}
+
 func (s *GetRunTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -139,6 +220,17 @@ func (s *GetRunTestSuite) Test_Error() {
 			},
 			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
 		},
+		{
+			name: "UnsupportedField",
+			request: request.GetRunRequest{
+				RunID:  "id",
+				Fields: "info,artifacts",
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'fields': unsupported field 'artifacts', " +
+					"supported fields are: info, params, tags, metrics",
+			),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
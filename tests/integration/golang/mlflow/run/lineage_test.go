@@ -0,0 +1,141 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunLineageTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunLineageTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunLineageTestSuite))
+}
+
+func (s *GetRunLineageTestSuite) Test_Ok() {
+	// create the tree of nested runs: root -> parent -> (child1, child2).
+	for _, run := range []struct {
+		id          string
+		parentRunID string
+	}{
+		{id: "root"},
+		{id: "parent", parentRunID: "root"},
+		{id: "child2", parentRunID: "parent"},
+		{id: "child1", parentRunID: "parent"},
+	} {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             run.id,
+			Name:           run.id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if run.parentRunID != "" {
+			_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+				Key:   "mlflow.parentRunId",
+				Value: run.parentRunID,
+				RunID: run.id,
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		request  request.GetRunLineageRequest
+		response response.GetRunLineageResponse
+	}{
+		{
+			name:    "RootRun",
+			request: request.GetRunLineageRequest{RunID: "root"},
+			response: response.GetRunLineageResponse{
+				RunID:    "root",
+				Parents:  []string{},
+				Children: []string{"parent"},
+			},
+		},
+		{
+			name:    "ParentRun",
+			request: request.GetRunLineageRequest{RunID: "parent"},
+			response: response.GetRunLineageResponse{
+				RunID:    "parent",
+				Parents:  []string{"root"},
+				Children: []string{"child1", "child2"},
+			},
+		},
+		{
+			name:    "ChildRun",
+			request: request.GetRunLineageRequest{RunUUID: "child1"},
+			response: response.GetRunLineageResponse{
+				RunID:    "child1",
+				Parents:  []string{"parent", "root"},
+				Children: []string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetRunLineageResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLineageRoute,
+				),
+			)
+			s.Equal(tt.response, resp)
+		})
+	}
+}
+
+func (s *GetRunLineageTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetRunLineageRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.GetRunLineageRequest{},
+			error: api.NewInvalidParameterValueError(
+				"Missing value for required parameter 'run_id'",
+			),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.GetRunLineageRequest{
+				RunID: "id",
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLineageRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
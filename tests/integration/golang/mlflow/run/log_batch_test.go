@@ -259,7 +259,7 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 						Key:       "key1",
 						Value:     1.1,
 						Timestamp: 1687325991,
-						Step:      1,
+						Step:      2,
 						Context: map[string]any{
 							"key1": "value1",
 							"key2": 2,
@@ -269,7 +269,7 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 						Key:       "key2",
 						Value:     1.2,
 						Timestamp: 1687325991,
-						Step:      1,
+						Step:      2,
 						Context: map[string]any{
 							"key3": "value3",
 						},
@@ -278,7 +278,7 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 						Key:       "key1",
 						Value:     1.3,
 						Timestamp: 1687325991,
-						Step:      1,
+						Step:      3,
 						Context: map[string]any{
 							"key1": "value1",
 							"key2": 2,
@@ -317,7 +317,7 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 				},
 			},
 			latestMetricIteration: map[string]int64{
-				"key3": 2,
+				"key3": 1,
 			},
 			latestMetricKeyCount: map[string]int{
 				"key3": 1,
@@ -360,15 +360,15 @@ func (s *LogBatchTestSuite) TestMetrics_Ok() {
 			request: &request.LogBatchRequest{
 				RunID: run.ID,
 				Metrics: func() []request.MetricPartialRequest {
-					metrics := make([]request.MetricPartialRequest, 10000*10)
-					for k := 0; k < 10000; k++ {
+					metrics := make([]request.MetricPartialRequest, 100*10)
+					for k := 0; k < 100; k++ {
 						key := fmt.Sprintf("many%d", k)
 						for i := 0; i < 10; i++ {
 							metrics[k*10+i] = request.MetricPartialRequest{
 								Key:       key,
 								Value:     float64(i) + 0.1,
 								Timestamp: 1687325991,
-								Step:      1,
+								Step:      int64(i),
 								Context: map[string]any{
 									"key1": "value1",
 								},
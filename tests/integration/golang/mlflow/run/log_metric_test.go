@@ -13,8 +13,10 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -23,7 +25,13 @@ type LogMetricTestSuite struct {
 }
 
 func TestLogMetricTestSuite(t *testing.T) {
-	suite.Run(t, new(LogMetricTestSuite))
+	suite.Run(t, &LogMetricTestSuite{
+		helpers.BaseTestSuite{
+			Config: config.Config{
+				RunStrictSteps: true,
+			},
+		},
+	})
 }
 
 func (s *LogMetricTestSuite) Test_Ok() {
@@ -69,13 +77,13 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Key:       "key1",
 				Value:     "NaN",
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      2,
 			},
 			expectedMetric: &models.LatestMetric{
 				Key:       "key1",
 				Value:     0,
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      2,
 				IsNan:     true,
 				RunID:     run.ID,
 				LastIter:  2,
@@ -89,13 +97,13 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Key:       "key1",
 				Value:     "Infinity",
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      3,
 			},
 			expectedMetric: &models.LatestMetric{
 				Key:       "key1",
 				Value:     math.MaxFloat64,
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      3,
 				RunID:     run.ID,
 				LastIter:  3,
 				Context:   models.DefaultContext,
@@ -108,13 +116,13 @@ func (s *LogMetricTestSuite) Test_Ok() {
 				Key:       "key1",
 				Value:     "-Infinity",
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      4,
 			},
 			expectedMetric: &models.LatestMetric{
 				Key:       "key1",
 				Value:     -math.MaxFloat64,
 				Timestamp: 1234567890,
-				Step:      1,
+				Step:      4,
 				RunID:     run.ID,
 				LastIter:  4,
 				Context:   models.DefaultContext,
@@ -152,6 +160,217 @@ func (s *LogMetricTestSuite) Test_Ok() {
 	}
 }
 
+func (s *LogMetricTestSuite) Test_SystemMetrics() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name         string
+		request      *request.LogMetricRequest
+		expectedKind models.MetricKind
+	}{
+		{
+			name: "LogUserMetric",
+			request: &request.LogMetricRequest{
+				RunID:     run.ID,
+				Key:       "loss",
+				Value:     1.1,
+				Timestamp: 1234567890,
+			},
+			expectedKind: models.MetricKindUser,
+		},
+		{
+			name: "LogSystemMetricByKeyPrefix",
+			request: &request.LogMetricRequest{
+				RunID:     run.ID,
+				Key:       "__system__cpu",
+				Value:     1.1,
+				Timestamp: 1234567890,
+			},
+			expectedKind: models.MetricKindSystem,
+		},
+		{
+			name: "LogSystemMetricByFlag",
+			request: &request.LogMetricRequest{
+				RunID:     run.ID,
+				Key:       "gpu",
+				Value:     1.1,
+				Timestamp: 1234567890,
+				System:    true,
+			},
+			expectedKind: models.MetricKindSystem,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := fiber.Map{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute,
+				),
+			)
+			s.Empty(resp)
+
+			// makes sure that the kind has been stored for both the metric and the latest metric.
+			metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+			s.Require().Nil(err)
+			for _, metric := range metrics {
+				if metric.Key == tt.request.Key {
+					s.Equal(tt.expectedKind, metric.Kind)
+				}
+			}
+
+			latestMetric, err := s.MetricFixtures.GetLatestMetricByKey(context.Background(), tt.request.Key)
+			s.Require().Nil(err)
+			s.Equal(tt.expectedKind, latestMetric.Kind)
+		})
+	}
+}
+
+func (s *LogMetricTestSuite) Test_Duplicate() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// the same point is logged twice, as a retried client call would do, the retry
+	// with a fresh timestamp, and then another value is logged for the same step.
+	// Only the first logged point of the step is kept.
+	for _, point := range []struct {
+		value     any
+		timestamp int64
+	}{
+		{value: 1.1, timestamp: 1234567890},
+		{value: 1.1, timestamp: 1234567891},
+		{value: 2.2, timestamp: 1234567892},
+	} {
+		resp := fiber.Map{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				&request.LogMetricRequest{
+					RunID:     run.ID,
+					Key:       "key1",
+					Value:     point.value,
+					Timestamp: point.timestamp,
+					Step:      1,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(metrics, 1)
+	s.Equal(1.1, metrics[0].Value)
+	s.Equal(int64(1234567890), metrics[0].Timestamp)
+	s.Equal(int64(1), metrics[0].Iter)
+
+	latestMetric, err := s.MetricFixtures.GetLatestMetricByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(1.1, latestMetric.Value)
+	s.Equal(int64(1), latestMetric.LastIter)
+}
+
+func (s *LogMetricTestSuite) Test_StrictSteps() {
+	tests := []struct {
+		name        string
+		strictSteps bool
+	}{
+		{
+			name: "OutOfOrderStepWithoutStrictMode",
+		},
+		{
+			name:        "OutOfOrderStepWithStrictMode",
+			strictSteps: true,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+				ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+				ExperimentID:   *s.DefaultExperiment.ID,
+				SourceType:     "JOB",
+				LifecycleStage: models.LifecycleStageActive,
+				Status:         models.StatusRunning,
+			})
+			s.Require().Nil(err)
+			if tt.strictSteps {
+				_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+					Key:   convertors.TagKeyStrictSteps,
+					Value: "true",
+					RunID: run.ID,
+				})
+				s.Require().Nil(err)
+			}
+
+			for _, step := range []int64{5, 3} {
+				resp := api.ErrorResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithRequest(
+						&request.LogMetricRequest{
+							RunID:     run.ID,
+							Key:       "key1",
+							Value:     1.1,
+							Timestamp: 1234567890,
+							Step:      step,
+						},
+					).WithResponse(
+						&resp,
+					).DoRequest(
+						"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute,
+					),
+				)
+				if step == 3 && tt.strictSteps {
+					s.Equal(
+						api.NewInvalidParameterValueError(
+							"unable to log metric 'key1' for run '%s': step 3 of metric(key=key1) is less than "+
+								"its last logged step 5, which strict step mode rejects",
+							run.ID,
+						).Error(),
+						resp.Error(),
+					)
+				} else {
+					s.Empty(resp.ErrorCode)
+				}
+			}
+
+			// makes sure that the rejected point has not been stored.
+			metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+			s.Require().Nil(err)
+			if tt.strictSteps {
+				s.Len(metrics, 1)
+			} else {
+				s.Len(metrics, 2)
+			}
+		})
+	}
+}
+
 func (s *LogMetricTestSuite) Test_Error() {
 	tests := []struct {
 		name          string
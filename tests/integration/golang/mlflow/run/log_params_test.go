@@ -0,0 +1,189 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogParamsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogParamsTestSuite))
+}
+
+func (s *LogParamsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.NewString(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	params := make([]request.ParamPartialRequest, 100)
+	for i := range params {
+		params[i] = request.ParamPartialRequest{
+			Key:      fmt.Sprintf("key%d", i),
+			ValueStr: common.GetPointer(fmt.Sprintf("value%d", i)),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		request request.LogParamsRequest
+	}{
+		{
+			name: "LogNewParams",
+			request: request.LogParamsRequest{
+				RunID:  run.ID,
+				Params: params,
+			},
+		},
+		{
+			name: "LogDuplicateParamsWithSameValues",
+			request: request.LogParamsRequest{
+				RunID:  run.ID,
+				Params: params,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := map[string]any{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogParamsRoute,
+				),
+			)
+			s.Empty(resp)
+
+			storedParams, err := s.ParamFixtures.GetParamsByRunID(context.Background(), run.ID)
+			s.Require().Nil(err)
+			s.Require().Len(storedParams, len(params))
+			values := make(map[string]string, len(storedParams))
+			for _, param := range storedParams {
+				values[param.Key] = *param.ValueStr
+			}
+			for _, param := range params {
+				s.Equal(*param.ValueStr, values[param.Key])
+			}
+		})
+	}
+}
+
+func (s *LogParamsTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.NewString(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:      "key1",
+		ValueStr: common.GetPointer("value1"),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogParamsRequest
+	}{
+		{
+			name: "MissingRunID",
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'run_id'",
+				StatusCode: http.StatusBadRequest,
+			},
+			request: request.LogParamsRequest{},
+		},
+		{
+			name: "MissingParams",
+			error: &api.ErrorResponse{
+				Message:    "Missing value for required parameter 'params'",
+				StatusCode: http.StatusBadRequest,
+			},
+			request: request.LogParamsRequest{
+				RunID: run.ID,
+			},
+		},
+		{
+			name: "NotFoundRun",
+			error: &api.ErrorResponse{
+				Message:    "Run 'unknown' not found",
+				StatusCode: http.StatusNotFound,
+			},
+			request: request.LogParamsRequest{
+				RunID: "unknown",
+				Params: []request.ParamPartialRequest{
+					{Key: "key1", ValueStr: common.GetPointer("value1")},
+				},
+			},
+		},
+		{
+			name: "ConflictingParam",
+			error: &api.ErrorResponse{
+				Message:    fmt.Sprintf("unable to insert params for run '%s'", run.ID),
+				StatusCode: http.StatusBadRequest,
+			},
+			request: request.LogParamsRequest{
+				RunID: run.ID,
+				Params: []request.ParamPartialRequest{
+					{Key: "key2", ValueStr: common.GetPointer("value2")},
+					{Key: "key1", ValueStr: common.GetPointer("value2")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogParamsRoute,
+				),
+			)
+			s.Contains(resp.Message, tt.error.Message)
+			s.Equal(tt.error.StatusCode, resp.StatusCode)
+		})
+	}
+
+	// the conflicting request is applied in one transaction, so nothing should be stored.
+	params, err := s.ParamFixtures.GetParamsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(params, 1)
+	s.Equal("key1", params[0].Key)
+	s.Equal("value1", *params[0].ValueStr)
+}
@@ -0,0 +1,164 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/services/run"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type PurgeDeletedTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestPurgeDeletedTestSuite(t *testing.T) {
+	suite.Run(t, new(PurgeDeletedTestSuite))
+}
+
+func (s *PurgeDeletedTestSuite) Test_Ok() {
+	expired := time.Now().Add(-2 * time.Hour).UTC().UnixMilli()
+	fresh := time.Now().Add(-time.Minute).UTC().UnixMilli()
+
+	// 1. prepare database with test data.
+	activeRun := s.createRun(*s.DefaultExperiment.ID, models.LifecycleStageActive, sql.NullInt64{})
+	expiredRun := s.createRun(
+		*s.DefaultExperiment.ID, models.LifecycleStageDeleted, sql.NullInt64{Int64: expired, Valid: true},
+	)
+	freshRun := s.createRun(
+		*s.DefaultExperiment.ID, models.LifecycleStageDeleted, sql.NullInt64{Int64: fresh, Valid: true},
+	)
+
+	expiredExperiment := s.createExperiment("Expired Experiment", expired)
+	expiredExperimentRun := s.createRun(
+		*expiredExperiment.ID, models.LifecycleStageDeleted, sql.NullInt64{Int64: expired, Valid: true},
+	)
+	freshExperiment := s.createExperiment("Fresh Experiment", fresh)
+	freshExperimentRun := s.createRun(
+		*freshExperiment.ID, models.LifecycleStageDeleted, sql.NullInt64{Int64: fresh, Valid: true},
+	)
+
+	// 2. run the purge.
+	numberOfExperiments, numberOfRuns, err := run.NewDeletedPurger(
+		context.Background(),
+		&config.Config{PurgeDeletedTTL: time.Hour},
+		repositories.NewRunRepository(s.GetDB()),
+		repositories.NewExperimentRepository(s.GetDB()),
+	).Purge(context.Background())
+	s.Require().Nil(err)
+	s.Equal(int64(1), numberOfExperiments)
+	s.Equal(int64(1), numberOfRuns)
+
+	// 3. check that expired entities are gone together with their data.
+	_, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *expiredExperiment.ID,
+	)
+	s.Require().NotNil(err)
+	for _, runID := range []string{expiredRun.ID, expiredExperimentRun.ID} {
+		_, err = s.RunFixtures.GetRun(context.Background(), runID)
+		s.Require().NotNil(err)
+
+		metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), runID)
+		s.Require().Nil(err)
+		s.Empty(metrics)
+		params, err := s.ParamFixtures.GetParamsByRunID(context.Background(), runID)
+		s.Require().Nil(err)
+		s.Empty(params)
+		tags, err := s.TagFixtures.GetByRunID(context.Background(), runID)
+		s.Require().Nil(err)
+		s.Empty(tags)
+	}
+
+	// 4. check that fresh deletes and active entities remain.
+	_, err = s.ExperimentFixtures.GetByNamespaceIDAndExperimentID(
+		context.Background(), s.DefaultNamespace.ID, *freshExperiment.ID,
+	)
+	s.Require().Nil(err)
+	for _, runID := range []string{activeRun.ID, freshRun.ID, freshExperimentRun.ID} {
+		_, err = s.RunFixtures.GetRun(context.Background(), runID)
+		s.Require().Nil(err)
+
+		metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), runID)
+		s.Require().Nil(err)
+		s.Len(metrics, 1)
+	}
+
+	// 5. check that the remaining runs were renumbered.
+	minRowNum, maxRowNum, err := s.RunFixtures.FindMinMaxRowNums(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Equal(int64(0), minRowNum)
+	s.Equal(int64(1), maxRowNum)
+
+	// 6. check that the purge is recorded in the audit log.
+	auditLogs, err := repositories.NewAuditLogRepository(s.GetDB()).List(
+		context.Background(), repositories.AuditLogFilter{Action: models.AuditLogActionPurge},
+	)
+	s.Require().Nil(err)
+	purged := make(map[models.AuditLogEntityType][]string, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		s.Equal(s.DefaultNamespace.ID, auditLog.NamespaceID)
+		s.Equal(models.AuditLogActorSystem, auditLog.Actor)
+		purged[auditLog.EntityType] = append(purged[auditLog.EntityType], auditLog.EntityID)
+	}
+	s.Equal(map[models.AuditLogEntityType][]string{
+		models.AuditLogEntityTypeExperiment: {fmt.Sprintf("%d", *expiredExperiment.ID)},
+		models.AuditLogEntityTypeRun:        {expiredRun.ID},
+	}, purged)
+}
+
+func (s *PurgeDeletedTestSuite) createExperiment(name string, deletedTime int64) *models.Experiment {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           name,
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+		DeletedTime:    sql.NullInt64{Int64: deletedTime, Valid: true},
+	})
+	s.Require().Nil(err)
+	return experiment
+}
+
+func (s *PurgeDeletedTestSuite) createRun(
+	experimentID int32, lifecycleStage models.LifecycleStage, deletedTime sql.NullInt64,
+) *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.NewString(), "-", ""),
+		ExperimentID:   experimentID,
+		SourceType:     "JOB",
+		LifecycleStage: lifecycleStage,
+		Status:         models.StatusFinished,
+		DeletedTime:    deletedTime,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+		Key:       "key",
+		Value:     1.0,
+		Timestamp: 1,
+		RunID:     run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:      "key",
+		ValueStr: common.GetPointer("value"),
+		RunID:    run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "key",
+		Value: "value",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	return run
+}
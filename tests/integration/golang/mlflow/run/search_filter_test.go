@@ -0,0 +1,83 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/server"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchFilterTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchFilterTestSuite(t *testing.T) {
+	suite.Run(t, &SearchFilterTestSuite{
+		helpers.BaseTestSuite{
+			ServerOptions: []func(options *server.Options){
+				server.WithSearchRunsFilter(repositories.SearchRunsFilterFunc(
+					func(ctx context.Context, tx *gorm.DB) *gorm.DB {
+						return tx.Where(
+							"runs.run_uuid NOT IN (SELECT run_uuid FROM tags WHERE key = ? AND value = ?)",
+							"visibility", "private",
+						)
+					},
+				)),
+			},
+		},
+	})
+}
+
+func (s *SearchFilterTestSuite) Test_Ok() {
+	for i, visibility := range []string{"public", "private", ""} {
+		r, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("id%d", i),
+			Name:           fmt.Sprintf("TestRun%d", i),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if visibility != "" {
+			s.Require().Nil(s.RunFixtures.CreateTag(context.Background(), models.Tag{
+				Key:   "visibility",
+				Value: visibility,
+				RunID: r.ID,
+			}))
+		}
+	}
+
+	resp := response.SearchRunsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SearchRunsRequest{
+				ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+		),
+	)
+
+	ids := make([]string, len(resp.Runs))
+	for i, r := range resp.Runs {
+		ids[i] = r.Info.ID
+	}
+	s.ElementsMatch([]string{"id0", "id2"}, ids)
+}
@@ -0,0 +1,92 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchLimitTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchLimitTestSuite(t *testing.T) {
+	testSuite := new(SearchLimitTestSuite)
+	testSuite.Config = config.Config{
+		SearchMaxResults:      2,
+		SearchMaxResultsLimit: 3,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *SearchLimitTestSuite) Test_Ok() {
+	for i := 0; i < 5; i++ {
+		_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         fmt.Sprintf("id%d", i),
+			Name:       fmt.Sprintf("TestRun%d", i),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name          string
+		maxResults    int32
+		expectedCount int
+	}{
+		{
+			name:          "NoMaxResultsUsesConfiguredDefault",
+			expectedCount: 2,
+		},
+		{
+			name:          "MaxResultsBelowLimit",
+			maxResults:    1,
+			expectedCount: 1,
+		},
+		{
+			name:          "MaxResultsAboveLimitIsClamped",
+			maxResults:    10,
+			expectedCount: 3,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			client := s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.SearchRunsRequest{
+					ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+					MaxResults:    tt.maxResults,
+				},
+			).WithResponse(
+				&resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute))
+			s.Equal(http.StatusOK, client.GetStatusCode())
+			s.Len(resp.Runs, tt.expectedCount)
+			// there are more runs than a single page can hold, so the client can continue paging.
+			s.NotEmpty(resp.NextPageToken)
+		})
+	}
+}
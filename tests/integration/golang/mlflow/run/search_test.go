@@ -17,6 +17,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/dao/types"
 	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
 )
 
@@ -2938,3 +2939,173 @@ func (s *SearchTestSuite) testCases(
 		})
 	}
 }
+
+func (s *SearchTestSuite) Test_PaginationWithIdenticalSortKeys_Ok() {
+	// create runs sharing the same `start_time` and the same metric value, so that
+	// the primary sort keys tie and only the tiebreaker defines the order.
+	expectedIDs := make([]string, 10)
+	for i := range expectedIDs {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         fmt.Sprintf("id%d", i),
+			Name:       fmt.Sprintf("TestRun%d", i),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "accuracy",
+			Value:     0.5,
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+		})
+		s.Require().Nil(err)
+		expectedIDs[i] = run.ID
+	}
+
+	tests := []struct {
+		name    string
+		orderBy []string
+	}{
+		{
+			name: "TestDefaultOrder",
+		},
+		{
+			name:    "TestOrderByStartTime",
+			orderBy: []string{"attribute.start_time ASC"},
+		},
+		{
+			name:    "TestOrderByMetric",
+			orderBy: []string{"metric.accuracy DESC"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var actualIDs []string
+			pageToken := ""
+			for {
+				resp := response.SearchRunsResponse{}
+				s.Require().Nil(
+					s.MlflowClient().WithMethod(
+						http.MethodPost,
+					).WithRequest(
+						request.SearchRunsRequest{
+							ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+							OrderBy:       tt.orderBy,
+							MaxResults:    3,
+							PageToken:     pageToken,
+						},
+					).WithResponse(
+						&resp,
+					).DoRequest(
+						"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+					),
+				)
+				for _, run := range resp.Runs {
+					actualIDs = append(actualIDs, run.Info.ID)
+				}
+				if resp.NextPageToken == "" {
+					break
+				}
+				pageToken = resp.NextPageToken
+			}
+			// every run has to be returned exactly once across all the pages.
+			s.Equal(expectedIDs, actualIDs)
+		})
+	}
+}
+
+func (s *SearchTestSuite) Test_OrderByMetric_Ok() {
+	// run `id1` and `id3` tie, run `id4` has no metric at all
+	// and run `id5` has the metric logged with two different contexts.
+	metrics := map[string]map[string]float64{
+		"id1": {`{}`: 0.9},
+		"id2": {`{}`: 0.7},
+		"id3": {`{}`: 0.9},
+		"id4": {},
+		"id5": {`{"subset": "train"}`: 0.95, `{"subset": "validation"}`: 0.6},
+	}
+	for _, id := range []string{"id1", "id2", "id3", "id4", "id5"} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:         id,
+			Name:       fmt.Sprintf("TestRun%s", id),
+			UserID:     "1",
+			Status:     models.StatusRunning,
+			SourceType: "JOB",
+			StartTime: sql.NullInt64{
+				Int64: 123456789,
+				Valid: true,
+			},
+			ExperimentID:   *s.DefaultExperiment.ID,
+			ArtifactURI:    "artifact_uri",
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		for metricContext, value := range metrics[id] {
+			_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+				Key:       "accuracy",
+				Value:     value,
+				Timestamp: 1234567890,
+				RunID:     run.ID,
+				Context: models.Context{
+					Json: types.JSONB(metricContext),
+				},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		orderBy     []string
+		expectedIDs []string
+	}{
+		{
+			name:        "TestOrderByMetricDesc",
+			orderBy:     []string{"metrics.accuracy DESC"},
+			expectedIDs: []string{"id5", "id1", "id3", "id2", "id4"},
+		},
+		{
+			name:        "TestOrderByMetricAsc",
+			orderBy:     []string{"metrics.accuracy ASC"},
+			expectedIDs: []string{"id5", "id2", "id1", "id3", "id4"},
+		},
+		{
+			name:        "TestOrderByMetricWithLimit",
+			orderBy:     []string{"metric.`accuracy` DESC"},
+			expectedIDs: []string{"id5", "id1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+						OrderBy:       tt.orderBy,
+						MaxResults:    int32(len(tt.expectedIDs)),
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			actualIDs := make([]string, len(resp.Runs))
+			for i, run := range resp.Runs {
+				actualIDs[i] = run.Info.ID
+			}
+			s.Equal(tt.expectedIDs, actualIDs)
+		})
+	}
+}
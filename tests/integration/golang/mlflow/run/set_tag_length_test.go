@@ -0,0 +1,125 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SetRunTagLengthTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSetRunTagLengthTestSuite(t *testing.T) {
+	testSuite := new(SetRunTagLengthTestSuite)
+	testSuite.Config = config.Config{
+		TagKeyMaxLength:   10,
+		TagValueMaxLength: 20,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *SetRunTagLengthTestSuite) Test_Ok() {
+	run := s.createRun()
+
+	key, value := strings.Repeat("k", 10), strings.Repeat("v", 20)
+	resp := map[string]any{}
+	client := s.MlflowClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetRunTagRequest{
+				RunID: run.ID,
+				Key:   key,
+				Value: value,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSetTagRoute,
+		),
+	)
+	s.Equal(http.StatusOK, client.GetStatusCode())
+
+	tags, err := s.TagFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(tags, 1)
+	s.Equal(key, tags[0].Key)
+	s.Equal(value, tags[0].Value)
+}
+
+func (s *SetRunTagLengthTestSuite) Test_Error() {
+	run := s.createRun()
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetRunTagRequest
+	}{
+		{
+			name:  "KeyIsTooLong",
+			error: api.NewInvalidParameterValueError("'key' parameter can't be longer than 10 characters"),
+			request: request.SetRunTagRequest{
+				RunID: run.ID,
+				Key:   strings.Repeat("k", 11),
+				Value: strings.Repeat("v", 20),
+			},
+		},
+		{
+			name:  "ValueIsTooLong",
+			error: api.NewInvalidParameterValueError("'value' parameter can't be longer than 20 characters"),
+			request: request.SetRunTagRequest{
+				RunID: run.ID,
+				Key:   strings.Repeat("k", 10),
+				Value: strings.Repeat("v", 21),
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			client := s.MlflowClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSetTagRoute,
+				),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	tags, err := s.TagFixtures.GetByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Empty(tags)
+}
+
+func (s *SetRunTagLengthTestSuite) createRun() *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "TestRun",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	return run
+}
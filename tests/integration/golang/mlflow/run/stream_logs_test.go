@@ -0,0 +1,167 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/api/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type StreamRunLogsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestStreamRunLogsTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamRunLogsTestSuite))
+}
+
+func (s *StreamRunLogsTestSuite) Test_Ok() {
+	run, runArtifactDir := s.createRunWithLogArtifact()
+
+	// 1. without follow the log artifact is streamed as is.
+	resp := new(bytes.Buffer)
+	s.Require().Nil(s.MlflowClient().WithQuery(
+		request.StreamRunLogsRequest{
+			RunID: run.ID,
+		},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	).DoRequest(
+		"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogsStreamRoute,
+	))
+	s.Equal("line 1\n", resp.String())
+
+	// 2. in follow mode the bytes appended to the log artifact are streamed until the run is terminated.
+	appendErr := make(chan error, 1)
+	go func() {
+		appendErr <- func() error {
+			time.Sleep(500 * time.Millisecond)
+			file, err := os.OpenFile(filepath.Join(runArtifactDir, "stdout.log"), os.O_APPEND|os.O_WRONLY, 0)
+			if err != nil {
+				return err
+			}
+			if _, err := file.WriteString("line 2\n"); err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			run.Status = models.StatusFinished
+			return s.RunFixtures.UpdateRun(context.Background(), run)
+		}()
+	}()
+
+	resp = new(bytes.Buffer)
+	s.Require().Nil(s.MlflowClient().WithQuery(
+		request.StreamRunLogsRequest{
+			RunID:  run.ID,
+			Follow: true,
+		},
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).WithResponse(
+		resp,
+	).DoRequest(
+		"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogsStreamRoute,
+	))
+	s.Require().Nil(<-appendErr)
+	s.Equal("line 1\nline 2\n", resp.String())
+}
+
+func (s *StreamRunLogsTestSuite) Test_Error() {
+	run, _ := s.createRunWithLogArtifact()
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.StreamRunLogsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.StreamRunLogsRequest{},
+		},
+		{
+			name:  "NotFoundRun",
+			error: api.NewResourceDoesNotExistError("unable to find run 'not-existing-run'"),
+			request: request.StreamRunLogsRequest{
+				RunID: "not-existing-run",
+			},
+		},
+		{
+			name: "NotFoundLogArtifact",
+			error: api.NewResourceDoesNotExistError(
+				"error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, "stderr.log"),
+			),
+			request: request.StreamRunLogsRequest{
+				RunID: run.ID,
+				Path:  "stderr.log",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogsStreamRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// createRunWithLogArtifact creates a running test run with the designated log artifact in the local storage.
+func (s *StreamRunLogsTestSuite) createRunWithLogArtifact() (*models.Run, string) {
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   convertors.TagKeyLogArtifact,
+		Value: "stdout.log",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "stdout.log"), []byte("line 1\n"), fs.ModePerm))
+	return run, runArtifactDir
+}
@@ -41,6 +41,14 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 			Int64: 1234567899,
 			Valid: true,
 		},
+		CreationTime: sql.NullInt64{
+			Int64: 1234567890,
+			Valid: true,
+		},
+		LastUpdateTime: sql.NullInt64{
+			Int64: 1234567899,
+			Valid: true,
+		},
 		SourceType:     "JOB",
 		ArtifactURI:    "artifact_uri",
 		ExperimentID:   *s.DefaultExperiment.ID,
@@ -60,6 +68,14 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 			Int64: 1234567899,
 			Valid: true,
 		},
+		CreationTime: sql.NullInt64{
+			Int64: 1234567890,
+			Valid: true,
+		},
+		LastUpdateTime: sql.NullInt64{
+			Int64: 1234567899,
+			Valid: true,
+		},
 		SourceType:     "JOB",
 		ArtifactURI:    "artifact_uri",
 		ExperimentID:   *s.DefaultExperiment.ID,
@@ -89,6 +105,7 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 					ArtifactURI:    run.ArtifactURI,
 					Status:         string(models.StatusScheduled),
 					StartTime:      1234567890,
+					CreationTime:   1234567890,
 					EndTime:        1111111111,
 					LifecycleStage: string(models.LifecycleStageActive),
 				},
@@ -110,6 +127,7 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 					ArtifactURI:    finishedRun.ArtifactURI,
 					Status:         string(models.StatusRunning),
 					StartTime:      1234567890,
+					CreationTime:   1234567890,
 					EndTime:        0,
 					LifecycleStage: string(models.LifecycleStageActive),
 				},
@@ -130,6 +148,9 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsUpdateRoute,
 				),
 			)
+			// last update time is set by the server, so it only has to advance.
+			s.Greater(resp.RunInfo.LastUpdateTime, int64(1234567899))
+			tt.expectedRunInfo.RunInfo.LastUpdateTime = resp.RunInfo.LastUpdateTime
 			s.Equal(tt.expectedRunInfo, resp)
 
 			// check that run has been updated in database.
@@ -138,6 +159,8 @@ func (s *UpdateRunTestSuite) Test_Ok() {
 			s.Equal(tt.expectedRunInfo.RunInfo.Name, run.Name)
 			s.Equal(tt.expectedRunInfo.RunInfo.Status, string(run.Status))
 			s.Equal(tt.expectedRunInfo.RunInfo.EndTime, run.EndTime.Int64)
+			s.Equal(tt.expectedRunInfo.RunInfo.CreationTime, run.CreationTime.Int64)
+			s.Equal(resp.RunInfo.LastUpdateTime, run.LastUpdateTime.Int64)
 		})
 	}
 }
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common"
+	"github.com/G-Research/fasttrackml/pkg/common/api"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+const rateLimitBurst = 5
+
+type NamespaceRateLimitTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestNamespaceRateLimitTestSuite(t *testing.T) {
+	testSuite := new(NamespaceRateLimitTestSuite)
+	testSuite.Config = config.Config{
+		// a tiny refill rate makes sure that no tokens are added back while the test is running.
+		RateLimitRPS:   0.001,
+		RateLimitBurst: rateLimitBurst,
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *NamespaceRateLimitTestSuite) Test_Ok() {
+	namespace1, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "namespace1",
+		Description:         "Test namespace 1",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	namespace2, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  3,
+		Code:                "namespace2",
+		Description:         "Test namespace 2",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	// flood namespace1 until the whole burst is consumed.
+	for i := 0; i < rateLimitBurst; i++ {
+		client := s.MlflowClient().WithNamespace(namespace1.Code)
+		s.Require().Nil(
+			client.WithQuery(
+				request.SearchExperimentsRequest{},
+			).WithResponse(
+				&response.SearchExperimentsResponse{},
+			).DoRequest(
+				"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+			),
+		)
+		s.Equal(http.StatusOK, client.GetStatusCode())
+	}
+
+	// the next request to namespace1 has to be throttled.
+	client := s.MlflowClient().WithNamespace(namespace1.Code)
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		client.WithQuery(
+			request.SearchExperimentsRequest{},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+		),
+	)
+	s.Equal(http.StatusTooManyRequests, client.GetStatusCode())
+	s.Equal(api.ErrorCodeRequestLimitExceeded, string(resp.ErrorCode))
+	s.Equal("rate limit exceeded for namespace with code: namespace1", resp.Message)
+	s.NotEmpty(client.GetResponseHeader(fiber.HeaderRetryAfter))
+
+	// namespace2 has its own bucket and is not affected.
+	for i := 0; i < rateLimitBurst; i++ {
+		client := s.MlflowClient().WithNamespace(namespace2.Code)
+		s.Require().Nil(
+			client.WithQuery(
+				request.SearchExperimentsRequest{},
+			).WithResponse(
+				&response.SearchExperimentsResponse{},
+			).DoRequest(
+				"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+			),
+		)
+		s.Equal(http.StatusOK, client.GetStatusCode())
+	}
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/config"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ReloadRateLimitTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestReloadRateLimitTestSuite(t *testing.T) {
+	testSuite := new(ReloadRateLimitTestSuite)
+	testSuite.Config = config.Config{
+		// rate limiting is disabled on startup.
+		Reloadable: config.NewReloadableConfigHolder(&config.ReloadableConfig{}),
+	}
+	suite.Run(t, testSuite)
+}
+
+func (s *ReloadRateLimitTestSuite) Test_Ok() {
+	// without rate limits all the requests are served.
+	s.Equal([]int{http.StatusOK, http.StatusOK, http.StatusOK}, s.doRequests(3))
+
+	// enabling rate limits is applied by the running server.
+	s.Config.Reloadable.Set(&config.ReloadableConfig{
+		// a tiny refill rate makes sure that no tokens are added back while the test is running.
+		RateLimitRPS:   0.001,
+		RateLimitBurst: 2,
+	})
+	s.Equal([]int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, s.doRequests(3))
+
+	// and so is disabling them.
+	s.Config.Reloadable.Set(&config.ReloadableConfig{})
+	s.Equal([]int{http.StatusOK, http.StatusOK, http.StatusOK}, s.doRequests(3))
+}
+
+// doRequests makes the amount of requests to the default namespace and returns their status codes.
+func (s *ReloadRateLimitTestSuite) doRequests(amount int) []int {
+	statusCodes := make([]int, amount)
+	for i := range statusCodes {
+		client := s.MlflowClient()
+		s.Require().Nil(
+			client.WithQuery(
+				request.SearchExperimentsRequest{},
+			).DoRequest(
+				"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+			),
+		)
+		statusCodes[i] = client.GetStatusCode()
+	}
+	return statusCodes
+}
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type AimSearchTracingTestSuite struct {
+	helpers.BaseTestSuite
+	recorder           *tracetest.SpanRecorder
+	prevTracerProvider trace.TracerProvider
+	prevPropagator     propagation.TextMapPropagator
+}
+
+func TestAimSearchTracingTestSuite(t *testing.T) {
+	suite.Run(t, new(AimSearchTracingTestSuite))
+}
+
+func (s *AimSearchTracingTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+	s.recorder = tracetest.NewSpanRecorder()
+	s.prevTracerProvider = otel.GetTracerProvider()
+	s.prevPropagator = otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(s.recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+func (s *AimSearchTracingTestSuite) TearDownTest() {
+	otel.SetTracerProvider(s.prevTracerProvider)
+	otel.SetTextMapPropagator(s.prevPropagator)
+	s.BaseTestSuite.TearDownTest()
+}
+
+func (s *AimSearchTracingTestSuite) Test_Ok() {
+	_, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:         "id1",
+		Name:       "TestRun1",
+		Status:     models.StatusRunning,
+		RowNum:     1,
+		SourceType: "JOB",
+		StartTime: sql.NullInt64{
+			Int64: 123456789,
+			Valid: true,
+		},
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    "artifact_uri",
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.AIMClient().WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithHeaders(map[string]string{
+			"Content-Type": "application/json",
+			"traceparent":  "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		}).WithQuery(
+			request.SearchRunsRequest{
+				Query: `run.name == "TestRun1"`,
+			},
+		).WithResponse(
+			resp,
+		).DoRequest("/runs/search/run"),
+	)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range s.recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	// the request span continues the propagated trace.
+	requestSpan, ok := spans["GET /aim/api/runs/search/run/"]
+	s.Require().True(ok)
+	s.Equal("4bf92f3577b34da6a3ce929d0e0e4736", requestSpan.SpanContext().TraceID().String())
+	s.Equal("00f067aa0ba902b7", requestSpan.Parent().SpanID().String())
+
+	// the parse span is a child of the request span.
+	parseSpan, ok := spans["QueryParser.Parse"]
+	s.Require().True(ok)
+	s.Equal(requestSpan.SpanContext().SpanID(), parseSpan.Parent().SpanID())
+	s.Equal(requestSpan.SpanContext().TraceID(), parseSpan.SpanContext().TraceID())
+
+	// the filter and database calls are traced in scope of the same request as well.
+	filterSpan, ok := spans["ParsedQuery.Filter"]
+	s.Require().True(ok)
+	s.Equal(requestSpan.SpanContext().TraceID(), filterSpan.SpanContext().TraceID())
+	querySpan, ok := spans["gorm.query"]
+	s.Require().True(ok)
+	s.Equal(requestSpan.SpanContext().TraceID(), querySpan.SpanContext().TraceID())
+}